@@ -0,0 +1,14 @@
+data "git_archive" "lambda" {
+  ref         = "v1.0.0"
+  directory   = "lambda"
+  output_path = "${path.module}/build/lambda.zip"
+}
+
+resource "aws_lambda_function" "example" {
+  function_name    = "example"
+  filename         = data.git_archive.lambda.output_path
+  source_code_hash = filebase64sha256(data.git_archive.lambda.output_path)
+  handler          = "index.handler"
+  runtime          = "nodejs18.x"
+  role             = "arn:aws:iam::123456789012:role/example"
+}
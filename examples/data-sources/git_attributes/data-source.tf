@@ -0,0 +1,9 @@
+data "git_attributes" "example" {
+  path  = "./some-git-repository"
+  paths = ["assets/logo.png", "docs/index.md"]
+  names = ["filter", "export-ignore"]
+}
+
+output "lfs_tracked" {
+  value = [for p, attrs in data.git_attributes.example.attributes : p if lookup(attrs, "filter", "") == "lfs"]
+}
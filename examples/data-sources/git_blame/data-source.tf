@@ -0,0 +1,8 @@
+data "git_blame" "example" {
+  path = "./some-git-repository"
+  file = "main.tf"
+}
+
+output "owners" {
+  value = distinct([for l in data.git_blame.example.lines : l.author_email])
+}
@@ -0,0 +1,9 @@
+data "git_blob_hash" "example" {
+  path = "./some-git-repository"
+  file = "config/app.yaml"
+}
+
+# Replace the deployment only when the committed configuration changes.
+resource "terraform_data" "deployment" {
+  triggers_replace = [data.git_blob_hash.example.hash]
+}
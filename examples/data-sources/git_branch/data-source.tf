@@ -0,0 +1,8 @@
+data "git_branch" "example" {
+  path = "./some-git-repository"
+  name = "main"
+}
+
+output "example" {
+  value = data.git_branch.example
+}
@@ -0,0 +1,9 @@
+data "git_branch_slug" "current" {
+  max_length = 40
+}
+
+resource "kubernetes_namespace" "preview" {
+  metadata {
+    name = "preview-${data.git_branch_slug.current.slug}"
+  }
+}
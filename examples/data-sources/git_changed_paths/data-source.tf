@@ -0,0 +1,13 @@
+variable "last_deployed_commit" {
+  type = string
+}
+
+data "git_changed_paths" "stacks" {
+  path     = "./some-git-repository"
+  from_ref = var.last_deployed_commit
+  paths    = ["stacks/network", "stacks/app", "modules"]
+}
+
+output "stacks_to_roll_out" {
+  value = data.git_changed_paths.stacks.changed_paths
+}
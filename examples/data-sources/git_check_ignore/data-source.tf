@@ -0,0 +1,18 @@
+data "git_check_ignore" "secrets" {
+  paths = [
+    "secrets/terraform.tfvars",
+    ".terraform/",
+  ]
+}
+
+resource "local_sensitive_file" "tfvars" {
+  filename = "${path.module}/secrets/terraform.tfvars"
+  content  = "api_token = \"${var.api_token}\""
+
+  lifecycle {
+    precondition {
+      condition     = data.git_check_ignore.secrets.all_ignored
+      error_message = "secrets/terraform.tfvars must be ignored by git."
+    }
+  }
+}
@@ -0,0 +1,14 @@
+data "git_cherry" "example" {
+  path     = "./some-git-repository"
+  upstream = "release/1.x"
+  head     = "main"
+}
+
+resource "terraform_data" "promote" {
+  lifecycle {
+    precondition {
+      condition     = data.git_cherry.example.unmerged_count == 0
+      error_message = "main has commits that are not back-ported to release/1.x"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "git_ci" "example" {
+  path = "./some-git-repository"
+}
+
+locals {
+  labels = {
+    "ci-system"    = data.git_ci.example.system
+    "ci-run"       = data.git_ci.example.run_id
+    "pull-request" = data.git_ci.example.is_pull_request ? tostring(data.git_ci.example.pull_request) : "none"
+  }
+}
@@ -0,0 +1,9 @@
+# Build number of the api service, only counting mainline commits touching it
+data "git_commit_count" "api" {
+  include_paths = ["services/api/**"]
+  first_parent  = true
+}
+
+output "build_number" {
+  value = data.git_commit_count.api.commit_count
+}
@@ -0,0 +1,12 @@
+data "git_commit_files" "example" {
+  path = "./some-git-repository"
+  ref  = "HEAD"
+}
+
+# Services touched by the deploy commit, ie. services/<name>/...
+output "services" {
+  value = distinct([
+    for file in data.git_commit_files.example.files : split("/", file.path)[1]
+    if startswith(file.path, "services/")
+  ])
+}
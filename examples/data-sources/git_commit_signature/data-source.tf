@@ -0,0 +1,8 @@
+data "git_commit_signature" "release" {
+  ref             = "v1.0.0"
+  allowed_signers = file("${path.module}/allowed_signers")
+}
+
+data "git_commit_signature" "head" {
+  keyring = file("${path.module}/release-keys.asc")
+}
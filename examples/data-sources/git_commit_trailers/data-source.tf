@@ -0,0 +1,13 @@
+data "git_commit_trailers" "example" {
+  path = "./some-git-repository"
+  keys = ["Reviewed-by"]
+}
+
+resource "terraform_data" "deploy" {
+  lifecycle {
+    precondition {
+      condition     = length(lookup(data.git_commit_trailers.example.values, "reviewed-by", [])) > 0
+      error_message = "the deployed commit must carry a Reviewed-by trailer"
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "git_contributors" "example" {
+  path = "./some-git-repository"
+}
+
+locals {
+  owner = data.git_contributors.example.contributors[0].email
+}
@@ -0,0 +1,13 @@
+data "git_conventional_commits" "example" {
+  path     = "./some-git-repository"
+  from_ref = "v1.2.0"
+}
+
+locals {
+  features = [for c in data.git_conventional_commits.example.commits : c.subject if c.type == "feat"]
+  bump     = data.git_conventional_commits.example.breaking ? "major" : length(local.features) > 0 ? "minor" : "patch"
+}
+
+output "bump" {
+  value = local.bump
+}
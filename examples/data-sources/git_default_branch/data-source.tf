@@ -0,0 +1,10 @@
+data "git_default_branch" "this" {}
+
+data "git_repository" "this" {}
+
+locals {
+  current_branch = trimprefix(data.git_repository.this.branch, "refs/heads/")
+
+  # deployments from the default branch drop the branch suffix
+  environment = local.current_branch == data.git_default_branch.this.name ? "production" : "preview-${local.current_branch}"
+}
@@ -0,0 +1,11 @@
+data "git_describe" "example" {
+  path         = "./some-git-repository"
+  match        = ["v*"]
+  exclude      = ["*-rc*"]
+  first_parent = true
+  always       = true
+}
+
+output "version" {
+  value = data.git_describe.example.describe
+}
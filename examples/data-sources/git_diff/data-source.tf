@@ -0,0 +1,13 @@
+data "git_diff" "example" {
+  path     = "./some-git-repository"
+  from_ref = "v1.0.0"
+  to_ref   = "main"
+}
+
+output "changed_files" {
+  value = [for f in data.git_diff.example.files : f.path if f.change_type != "deleted"]
+}
+
+output "api_change" {
+  value = lookup(data.git_diff.example.files_by_path, "services/api/main.tf", null)
+}
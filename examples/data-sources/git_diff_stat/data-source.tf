@@ -0,0 +1,22 @@
+data "git_diff_stat" "example" {
+  path     = "./some-git-repository"
+  from_ref = "v1.0.0"
+  to_ref   = "main"
+}
+
+resource "terraform_data" "deploy" {
+  lifecycle {
+    precondition {
+      condition     = data.git_diff_stat.example.insertions + data.git_diff_stat.example.deletions <= 500
+      error_message = "More than 500 lines changed since v1.0.0, deploy manually."
+    }
+  }
+}
+
+# Files with the largest changes since v1.0.0
+output "largest_changes" {
+  value = [
+    for file in data.git_diff_stat.example.files : file.path
+    if file.insertions + file.deletions > 100
+  ]
+}
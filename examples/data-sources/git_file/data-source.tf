@@ -0,0 +1,9 @@
+data "git_file" "example" {
+  path = "./some-git-repository"
+  file = "config/settings.yaml"
+  ref  = "v1.2.0"
+}
+
+output "settings" {
+  value = yamldecode(data.git_file.example.content)
+}
@@ -0,0 +1,9 @@
+data "git_file_history" "example" {
+  path      = "./some-git-repository"
+  file      = "modules/vpc"
+  max_count = 1
+}
+
+output "vpc_last_changed" {
+  value = data.git_file_history.example.commits[0].date
+}
@@ -0,0 +1,12 @@
+data "git_files" "templates" {
+  ref     = "v1.2.0"
+  files   = ["templates/**/*.tpl"]
+  exclude = ["templates/examples"]
+}
+
+resource "local_file" "rendered" {
+  for_each = data.git_files.templates.contents
+
+  filename = "${path.module}/rendered/${trimsuffix(each.key, ".tpl")}"
+  content  = each.value.content
+}
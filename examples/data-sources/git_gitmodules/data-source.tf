@@ -0,0 +1,8 @@
+data "git_gitmodules" "example" {
+  path = "./some-bare-repository.git"
+  ref  = "v1.2.0"
+}
+
+output "submodule_urls" {
+  value = { for s in data.git_gitmodules.example.submodules : s.path => s.url }
+}
@@ -0,0 +1,14 @@
+data "git_grep" "example" {
+  path          = "./some-git-repository"
+  pattern       = "BEGIN (RSA|OPENSSH) PRIVATE KEY"
+  exclude_paths = ["testdata/**"]
+}
+
+resource "terraform_data" "deploy" {
+  lifecycle {
+    precondition {
+      condition     = data.git_grep.example.match_count == 0
+      error_message = "private keys are committed in: ${join(", ", data.git_grep.example.files)}"
+    }
+  }
+}
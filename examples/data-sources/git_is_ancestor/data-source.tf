@@ -0,0 +1,13 @@
+data "git_is_ancestor" "security_fix" {
+  ancestor_ref   = "3f2a9c1d4e5b6a7980f1e2d3c4b5a69788796a5b"
+  descendant_ref = "v2.3.0"
+}
+
+resource "terraform_data" "deploy" {
+  lifecycle {
+    precondition {
+      condition     = data.git_is_ancestor.security_fix.is_ancestor
+      error_message = "v2.3.0 does not include the security fix."
+    }
+  }
+}
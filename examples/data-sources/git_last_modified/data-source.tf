@@ -0,0 +1,11 @@
+data "git_last_modified" "example" {
+  path  = "./some-git-repository"
+  paths = ["modules/vpc", "modules/eks"]
+}
+
+locals {
+  vpc_tags = {
+    "git-commit"        = data.git_last_modified.example.files["modules/vpc"].commit
+    "git-last-modified" = data.git_last_modified.example.files["modules/vpc"].date
+  }
+}
@@ -0,0 +1,10 @@
+# Highest released version of the api component, tagged like api/v1.2.3
+data "git_latest_semver_tag" "api" {
+  path       = "./some-git-repository"
+  prefix     = "api/"
+  constraint = ">= 1.0, < 2.0"
+}
+
+output "api_version" {
+  value = data.git_latest_semver_tag.api.exists ? data.git_latest_semver_tag.api.version : "0.0.0"
+}
@@ -0,0 +1,8 @@
+data "git_mailmap_authors" "example" {
+  path     = "./some-git-repository"
+  from_ref = "v1.0.0"
+}
+
+output "owners" {
+  value = [for a in data.git_mailmap_authors.example.authors : a.email if a.commit_count >= 10]
+}
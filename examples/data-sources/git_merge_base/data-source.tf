@@ -0,0 +1,12 @@
+data "git_merge_base" "hotfix" {
+  path     = "./some-git-repository"
+  from_ref = "hotfix/1.2.1"
+  to_ref   = "main"
+}
+
+check "hotfix_merged" {
+  assert {
+    condition     = data.git_merge_base.hotfix.is_ancestor
+    error_message = "The hotfix branch has not been merged into main yet."
+  }
+}
@@ -0,0 +1,12 @@
+data "git_merge_check" "promotion" {
+  path       = "./some-git-repository"
+  target_ref = "production"
+  source_ref = "staging"
+}
+
+check "promotion" {
+  assert {
+    condition     = !data.git_merge_check.promotion.has_conflicts
+    error_message = "Promoting staging would conflict on: ${join(", ", data.git_merge_check.promotion.conflicting_paths)}"
+  }
+}
@@ -0,0 +1,9 @@
+# Version of the service living in services/service-a, tagged like service-a/v1.2.3
+data "git_monorepo_version" "service_a" {
+  path      = "./some-git-repository"
+  directory = "services/service-a"
+}
+
+output "service_a_version" {
+  value = data.git_monorepo_version.service_a.version
+}
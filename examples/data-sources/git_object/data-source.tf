@@ -0,0 +1,15 @@
+variable "pinned_commit" {
+  type = string
+}
+
+data "git_object" "example" {
+  path = "./some-git-repository"
+  hash = var.pinned_commit
+
+  lifecycle {
+    postcondition {
+      condition     = self.exists && self.type == "commit"
+      error_message = "The pinned commit does not exist in the repository."
+    }
+  }
+}
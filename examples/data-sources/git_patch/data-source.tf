@@ -0,0 +1,20 @@
+# Patch of the last commit
+data "git_patch" "head" {
+  path = "./some-git-repository"
+}
+
+# Patches of every commit of a branch, written to a directory
+data "git_patch" "branch" {
+  path             = "./some-git-repository"
+  from_ref         = "main"
+  ref              = "feature/login"
+  output_directory = "${path.module}/patches"
+}
+
+output "patch" {
+  value = data.git_patch.head.patch
+}
+
+output "patch_files" {
+  value = [for patch in data.git_patch.branch.patches : patch.file_name]
+}
@@ -0,0 +1,16 @@
+variable "last_deployed_commit" {
+  type = string
+}
+
+data "git_path_changed" "network" {
+  path     = "./some-git-repository"
+  from_ref = var.last_deployed_commit
+  pathspec = "stacks/network"
+}
+
+# Only roll out the network stack when it changed
+resource "terraform_data" "network" {
+  count = data.git_path_changed.network.changed ? 1 : 0
+
+  input = data.git_path_changed.network.to_commit
+}
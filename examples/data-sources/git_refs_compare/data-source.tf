@@ -0,0 +1,8 @@
+data "git_refs_compare" "mirror" {
+  source = "https://github.com/ekristen/terraform-provider-git.git"
+  target = "https://git.example.com/mirrors/terraform-provider-git.git"
+}
+
+output "mirror_in_sync" {
+  value = data.git_refs_compare.mirror.in_sync
+}
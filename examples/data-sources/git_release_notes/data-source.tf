@@ -0,0 +1,20 @@
+data "git_semver" "example" {
+  path = "./some-git-repository"
+}
+
+data "git_release_notes" "example" {
+  path     = "./some-git-repository"
+  from_ref = data.git_semver.example.current_version
+  version  = data.git_semver.example.next_version
+
+  sections = [
+    { type = "feat", title = "Features" },
+    { type = "fix", title = "Bug Fixes" },
+    { type = "docs", title = "Documentation" },
+  ]
+  commit_template = "- {scope_prefix}{subject} ([{short}](https://github.com/example/repo/commit/{commit}))"
+}
+
+output "release_notes" {
+  value = data.git_release_notes.example.notes
+}
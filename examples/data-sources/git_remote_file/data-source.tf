@@ -0,0 +1,12 @@
+data "git_remote_file" "example" {
+  url  = "https://git.example.com/platform/config.git"
+  ref  = "v1.4.0"
+  file = "environments/production.yaml"
+
+  username = "terraform"
+  password = var.git_token
+}
+
+locals {
+  production = yamldecode(data.git_remote_file.example.content)
+}
@@ -0,0 +1,14 @@
+data "git_remote_files" "example" {
+  urls = [
+    "https://git.example.com/services/billing.git",
+    "https://git.example.com/services/checkout.git",
+  ]
+  file = "service.yaml"
+  ref  = "v*"
+}
+
+output "services" {
+  value = {
+    for url, repo in data.git_remote_files.example.repositories : url => yamldecode(repo.content) if repo.exists
+  }
+}
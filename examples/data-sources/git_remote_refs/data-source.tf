@@ -0,0 +1,23 @@
+data "git_remote_refs" "example" {
+  url = "https://github.com/ekristen/terraform-provider-git.git"
+}
+
+output "main" {
+  value = data.git_remote_refs.example.branches["main"]
+}
+
+# Replace a resource exactly when the main branch of the remote moves
+data "git_remote_refs" "upstream" {
+  url   = "https://github.com/ekristen/terraform-provider-git.git"
+  watch = "main"
+}
+
+resource "terraform_data" "upstream" {
+  input = data.git_remote_refs.upstream.trigger
+}
+
+resource "terraform_data" "build" {
+  lifecycle {
+    replace_triggered_by = [terraform_data.upstream]
+  }
+}
@@ -0,0 +1,8 @@
+data "git_remote_tag" "example" {
+  url        = "https://github.com/ekristen/terraform-provider-git.git"
+  constraint = ">= 0.1, < 1.0"
+}
+
+output "latest" {
+  value = "${data.git_remote_tag.example.tag} (${data.git_remote_tag.example.commit})"
+}
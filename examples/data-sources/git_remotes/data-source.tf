@@ -0,0 +1,14 @@
+data "git_remotes" "example" {
+  path = "./some-git-repository"
+
+  lifecycle {
+    postcondition {
+      condition     = self.remotes["origin"].fetch_url == "git@github.com:example/infrastructure.git"
+      error_message = "origin does not point at the blessed repository."
+    }
+  }
+}
+
+output "mirrors" {
+  value = [for name in data.git_remotes.example.names : name if name != "origin"]
+}
@@ -0,0 +1,13 @@
+data "git_rev_parse" "example" {
+  path = "./some-git-repository"
+  rev  = "main@{u}"
+}
+
+data "git_rev_parse" "release" {
+  path = "./some-git-repository"
+  rev  = "v1.2.0^{commit}"
+}
+
+output "upstream_is_released" {
+  value = data.git_rev_parse.example.commit == data.git_rev_parse.release.commit
+}
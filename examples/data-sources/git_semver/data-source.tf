@@ -0,0 +1,5 @@
+data "git_semver" "release" {}
+
+output "next_version" {
+  value = data.git_semver.release.bump == "none" ? null : data.git_semver.release.next_version
+}
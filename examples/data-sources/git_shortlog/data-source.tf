@@ -0,0 +1,10 @@
+data "git_shortlog" "example" {
+  path        = "./some-git-repository"
+  from_ref    = "v1.0.0"
+  email       = true
+  use_mailmap = true
+}
+
+output "maintainers" {
+  value = [for e in data.git_shortlog.example.entries : "${e.name} <${e.email}>" if e.commit_count >= 10]
+}
@@ -0,0 +1,14 @@
+data "git_submodules" "example" {
+  path = "./some-git-repository"
+
+  lifecycle {
+    postcondition {
+      condition     = self.in_sync && !self.is_dirty
+      error_message = "Submodules are not checked out at the recorded commits."
+    }
+  }
+}
+
+output "example" {
+  value = data.git_submodules.example.submodules
+}
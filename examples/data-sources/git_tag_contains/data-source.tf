@@ -0,0 +1,9 @@
+data "git_tag_contains" "example" {
+  path        = "./some-git-repository"
+  ref         = "4f1c2a9"
+  semver_only = true
+}
+
+output "first_release" {
+  value = data.git_tag_contains.example.first_tag
+}
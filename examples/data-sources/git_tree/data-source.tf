@@ -0,0 +1,9 @@
+data "git_tree" "example" {
+  path      = "./some-git-repository"
+  ref       = "main"
+  directory = "services"
+}
+
+output "services" {
+  value = [for e in data.git_tree.example.entries : e.name if e.type == "tree"]
+}
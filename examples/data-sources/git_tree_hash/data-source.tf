@@ -0,0 +1,9 @@
+data "git_tree_hash" "example" {
+  path      = "./some-git-repository"
+  directory = "services/api"
+}
+
+# Rebuild the image only when a file under services/api changes.
+resource "terraform_data" "image" {
+  triggers_replace = [data.git_tree_hash.example.hash]
+}
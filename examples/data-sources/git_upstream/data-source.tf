@@ -0,0 +1,14 @@
+# Upstream of the branch currently checked out
+data "git_upstream" "current" {
+  path = "./some-git-repository"
+}
+
+# Upstream of a named branch
+data "git_upstream" "feature" {
+  path   = "./some-git-repository"
+  branch = "feature/login"
+}
+
+output "push_target" {
+  value = data.git_upstream.current.exists ? data.git_upstream.current.upstream : "origin/${data.git_upstream.current.branch}"
+}
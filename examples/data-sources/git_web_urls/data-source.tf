@@ -0,0 +1,13 @@
+data "git_web_urls" "release" {
+  from_ref = "v1.0.0"
+  ref      = "v1.1.0"
+  file     = "CHANGELOG.md"
+}
+
+# Self-hosted instances can pick a built-in forge or set their own templates
+data "git_web_urls" "gitea" {
+  remote_url           = "https://git.example.com/team/app.git"
+  commit_url_template  = "{base_url}/commit/{commit}"
+  file_url_template    = "{base_url}/src/commit/{commit}/{file}"
+  compare_url_template = "{base_url}/compare/{from}...{to}"
+}
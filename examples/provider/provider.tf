@@ -1,3 +1,32 @@
 provider "git" {
-  # example configuration here
+  # Used by data sources that omit `path`, may also be set with the
+  # GIT_PROVIDER_DEFAULT_PATH environment variable.
+  default_path = path.root
+
+  # Resolve relative paths against the root module directory, or against the
+  # directory Terraform was invoked from with "cwd", ie. before -chdir.
+  base = "root"
+
+  # Read repositories owned by another user, ie. mounted into a container,
+  # may also be set with the GIT_PROVIDER_ALLOW_UNSAFE_OWNERSHIP environment
+  # variable.
+  allow_unsafe_ownership = false
+}
+
+provider "git" {
+  alias = "platform"
+
+  # Named repositories, the names can be used in place of `path` (and
+  # `default_path`) and in place of the URL of remote data sources.
+  repositories = {
+    infra = {
+      path = "../infra"
+    }
+    charts = {
+      url      = "https://github.com/example/charts.git"
+      username = "x-access-token"
+      password = var.github_token
+    }
+  }
+  default_path = "infra"
 }
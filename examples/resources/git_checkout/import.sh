@@ -0,0 +1,2 @@
+# Adopts whatever is checked out at the path
+terraform import git_checkout.example /srv/builds/app
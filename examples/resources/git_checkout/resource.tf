@@ -0,0 +1,4 @@
+resource "git_checkout" "example" {
+  path = "/srv/builds/app"
+  ref  = "release/1.2"
+}
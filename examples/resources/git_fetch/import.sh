@@ -0,0 +1,2 @@
+# Fetches are imported with the path of the repository
+terraform import git_fetch.origin /srv/monorepo
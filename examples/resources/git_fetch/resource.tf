@@ -0,0 +1,12 @@
+resource "git_fetch" "origin" {
+  path  = "/srv/monorepo"
+  prune = true
+  tags  = "all"
+}
+
+# Read once the remote-tracking references are fetched
+data "git_rev_parse" "main" {
+  path       = git_fetch.origin.path
+  rev        = "origin/main"
+  depends_on = [git_fetch.origin]
+}
@@ -0,0 +1,12 @@
+resource "git_file" "example" {
+  path    = "./deployments.git"
+  branch  = "main"
+  file    = "environments/production/version.txt"
+  content = "1.2.0\n"
+
+  commit_message = "Deploy 1.2.0 to production"
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+
+  remote = "origin"
+}
@@ -0,0 +1,15 @@
+resource "git_files" "example" {
+  path   = "./deployments.git"
+  branch = "main"
+
+  files = {
+    "environments/production/version.txt"  = "1.2.0\n"
+    "environments/production/replicas.txt" = "3\n"
+  }
+
+  commit_message = "Deploy 1.2.0 to production"
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+
+  remote = "origin"
+}
@@ -0,0 +1,2 @@
+# Hooks are imported with <path>:<name>
+terraform import git_hook.example ./my-repo:pre-commit
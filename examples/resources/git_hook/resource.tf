@@ -0,0 +1,9 @@
+resource "git_hook" "example" {
+  path = "./my-repo"
+  name = "pre-commit"
+
+  content = <<-EOT
+    #!/bin/sh
+    exec terraform fmt -check -recursive
+  EOT
+}
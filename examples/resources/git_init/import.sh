@@ -0,0 +1 @@
+terraform import git_init.artifacts /srv/git/artifacts.git
@@ -0,0 +1,6 @@
+resource "git_init" "artifacts" {
+  path           = "/srv/git/artifacts.git"
+  bare           = true
+  initial_branch = "main"
+  initial_commit = true
+}
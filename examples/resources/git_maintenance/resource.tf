@@ -0,0 +1,5 @@
+resource "git_maintenance" "monorepo" {
+  path                    = "/srv/monorepo"
+  loose_objects_threshold = 1000
+  prune_expire_days       = 7
+}
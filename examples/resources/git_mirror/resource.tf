@@ -0,0 +1,8 @@
+resource "git_mirror" "github" {
+  source_url           = "https://git.example.com/platform/infrastructure.git"
+  destination_url      = "https://github.com/example/infrastructure.git"
+  destination_username = "git"
+  destination_password = var.github_token
+  exclude              = ["refs/pull", "refs/merge-requests"]
+  cache_path           = "/var/cache/terraform/infrastructure.git"
+}
@@ -0,0 +1,8 @@
+resource "git_pull" "deploy" {
+  path   = "/srv/deploy"
+  branch = "main"
+  mode   = "rebase"
+
+  author_name  = "Build Host"
+  author_email = "build@example.com"
+}
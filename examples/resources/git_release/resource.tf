@@ -0,0 +1,15 @@
+resource "git_release" "this" {
+  path        = "/srv/infrastructure"
+  name        = "v1.2.0"
+  ref         = "main"
+  remote      = "origin"
+  signing_key = var.release_signing_key
+}
+
+# Attach the notes to the released commit instead of the tag
+resource "git_release" "notes" {
+  path      = "/srv/platform"
+  name      = "v2.0.0"
+  notes_ref = "refs/notes/commits"
+  remote    = "origin"
+}
@@ -0,0 +1,2 @@
+# Remotes are imported with <path>:<name>
+terraform import git_remote.upstream /srv/builds/app:upstream
@@ -0,0 +1,8 @@
+resource "git_remote" "upstream" {
+  path = "/srv/builds/app"
+  name = "upstream"
+  url  = "https://github.com/example/app.git"
+
+  # Never push to upstream by accident
+  push_urls = ["no-push"]
+}
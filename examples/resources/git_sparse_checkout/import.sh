@@ -0,0 +1,2 @@
+# Sparse checkouts are imported with the path of the repository
+terraform import git_sparse_checkout.api /srv/monorepo
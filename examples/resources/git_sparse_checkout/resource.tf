@@ -0,0 +1,4 @@
+resource "git_sparse_checkout" "api" {
+  path     = "/srv/monorepo"
+  patterns = ["services/api", "libs/common"]
+}
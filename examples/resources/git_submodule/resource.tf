@@ -0,0 +1,12 @@
+resource "git_submodule" "example" {
+  path           = "./platform.git"
+  branch         = "main"
+  submodule_path = "vendor/modules"
+  url            = "https://github.com/example/terraform-modules.git"
+  commit         = "9fceb02d0ae598e95dc970b74767f19372d61af8"
+
+  author_name  = "Terraform"
+  author_email = "terraform@example.com"
+
+  remote = "origin"
+}
@@ -0,0 +1,2 @@
+# Working trees are imported with their worktree_path
+terraform import git_worktree.production /srv/stacks/production
@@ -0,0 +1,5 @@
+resource "git_worktree" "production" {
+  path          = "/srv/infrastructure"
+  worktree_path = "/srv/stacks/production"
+  ref           = "release/production"
+}
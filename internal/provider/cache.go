@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// readCache memoizes expensive computations shared by data sources. It lives
+// as long as the provider process, which Terraform starts for every
+// operation, so identical reads within a plan or an apply are only computed
+// once. Keys must capture everything the result depends on.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+}
+
+type readCacheEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+	// users counts the callers of share holding the entry, guarded by the
+	// mutex of the cache.
+	users int
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: map[string]*readCacheEntry{}}
+}
+
+// get returns the value cached for key, calling fn to compute it on the first
+// call. Concurrent callers of the same key wait for the first one. Errors are
+// returned to the callers waiting for them but not cached, the next call
+// computes the value again. A nil cache always calls fn.
+func (c *readCache) get(key string, fn func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fn()
+	}
+
+	return c.compute(key, c.entry(key, 0), fn)
+}
+
+// share returns the value cached for key like get, along with the function
+// releasing it. Once every caller sharing the value released it, the entry is
+// dropped from the cache, so large values do not outlive their readers. A nil
+// cache always calls fn.
+func (c *readCache) share(key string, fn func() (interface{}, error)) (interface{}, func(), error) {
+	if c == nil {
+		value, err := fn()
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, func() {}, nil
+	}
+
+	entry := c.entry(key, 1)
+	value, err := c.compute(key, entry, fn)
+
+	done := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		entry.users--
+		if entry.users == 0 && c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+	}
+
+	if err != nil {
+		done()
+		return nil, nil, err
+	}
+	return value, done, nil
+}
+
+// entry returns the entry of key, created when missing, adding users to the
+// callers sharing it.
+func (c *readCache) entry(key string, users int) *readCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &readCacheEntry{}
+		c.entries[key] = entry
+	}
+	entry.users += users
+
+	return entry
+}
+
+// compute calls fn to compute the value of entry, the entry of key, on the
+// first call, dropping the entry from the cache when it fails.
+func (c *readCache) compute(key string, entry *readCacheEntry, fn func() (interface{}, error)) (interface{}, error) {
+	entry.once.Do(func() {
+		entry.value, entry.err = fn()
+		if entry.err != nil {
+			c.mu.Lock()
+			if c.entries[key] == entry {
+				delete(c.entries, key)
+			}
+			c.mu.Unlock()
+		}
+	})
+
+	return entry.value, entry.err
+}
+
+// cache returns the read cache of the provider, nil when it has not been
+// configured.
+func (p *GitProviderData) cache() *readCache {
+	if p == nil {
+		return nil
+	}
+	return p.readCache
+}
+
+// tagsFingerprint summarizes the tags of repo so cached results depending on
+// them are invalidated when a tag is created, moved or deleted.
+func tagsFingerprint(repo *git.Repository) (string, error) {
+	iter, err := repo.Tags()
+	if err != nil {
+		return "", err
+	}
+
+	var tags []string
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		tags = append(tags, fmt.Sprintf("%s %s", ref.Name().String(), ref.Hash().String()))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sort.Strings(tags)
+	sum := sha1.Sum([]byte(strings.Join(tags, "\n")))
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCache(t *testing.T) {
+	cache := newReadCache()
+
+	calls := 0
+	compute := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	value, err := cache.get("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	value, err = cache.get("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	value, err = cache.get("b", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, value)
+
+	_, err = cache.get("c", func() (interface{}, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	assert.EqualError(t, err, "failed")
+
+	// errors are not cached
+	value, err = cache.get("c", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, value)
+
+	// a nil cache computes every time
+	var none *readCache
+	value, err = none.get("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, value)
+}
+
+func TestReadCacheShare(t *testing.T) {
+	cache := newReadCache()
+
+	calls := 0
+	compute := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	value, done1, err := cache.share("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	value, done2, err := cache.share("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	// the value is kept until every user is done
+	done1()
+	value, done3, err := cache.share("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+	done2()
+	done3()
+
+	// and computed again afterwards
+	value, done4, err := cache.share("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, value)
+	done4()
+
+	_, _, err = cache.share("b", func() (interface{}, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Empty(t, cache.entries)
+
+	// a nil cache computes every time
+	var none *readCache
+	value, done5, err := none.share("a", compute)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, value)
+	done5()
+}
+
+func TestTagsFingerprint(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	before, err := tagsFingerprint(repo)
+	assert.NoError(t, err)
+
+	_, err = repo.CreateTag("v1.0.1", *hash, nil)
+	assert.NoError(t, err)
+
+	after, err := tagsFingerprint(repo)
+	assert.NoError(t, err)
+	assert.NotEqual(t, before, after)
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// branchCommit describes a commit resources create on a branch, and the
+// remote the branch is kept in sync with, if any.
+type branchCommit struct {
+	Branch   string
+	Remote   string
+	Auth     transport.AuthMethod
+	Message  string
+	Identity *commitIdentity
+}
+
+// commitIdentityConfig is the configuration of the identity, and signing, of
+// the commits or tags a resource creates.
+type commitIdentityConfig struct {
+	AuthorName           types.String
+	AuthorEmail          types.String
+	CommitterName        types.String
+	CommitterEmail       types.String
+	Sign                 types.Bool
+	SigningKey           types.String
+	SigningKeyPassphrase types.String
+}
+
+// commitIdentity is the identity commits or tags are created with.
+type commitIdentity struct {
+	Author    *object.Signature
+	Committer *object.Signature
+	// SignKey is nil when they are not signed.
+	SignKey *openpgp.Entity
+}
+
+// commitIdentity returns the identity configured by cfg: the author falls back
+// to user.name and user.email of the git config, the committer to the author
+// and the signing key of the resource to the one of the provider, unless sign
+// is false.
+func (p *GitProviderData) commitIdentity(repo *git.Repository, cfg commitIdentityConfig) (*commitIdentity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	author, err := commitSignature(repo, cfg.AuthorName, cfg.AuthorEmail)
+	if err != nil {
+		diags.AddAttributeError(path.Root("author_name"), "unable to determine author", err.Error())
+		return nil, diags
+	}
+
+	committer := *author
+	if cfg.CommitterName.ValueString() != "" {
+		committer.Name = cfg.CommitterName.ValueString()
+	}
+	if cfg.CommitterEmail.ValueString() != "" {
+		committer.Email = cfg.CommitterEmail.ValueString()
+	}
+
+	identity := &commitIdentity{Author: author, Committer: &committer}
+	if !cfg.Sign.IsNull() && !cfg.Sign.ValueBool() {
+		return identity, diags
+	}
+
+	key, passphrase := cfg.SigningKey.ValueString(), cfg.SigningKeyPassphrase.ValueString()
+	if key == "" && p != nil {
+		key, passphrase = p.SigningKey, p.SigningKeyPassphrase
+	}
+	if key == "" {
+		if cfg.Sign.ValueBool() {
+			diags.AddAttributeError(path.Root("sign"), "unable to sign",
+				"sign is set but neither the resource nor the provider set a signing_key")
+		}
+		return identity, diags
+	}
+
+	identity.SignKey, err = readSigningKey(key, passphrase)
+	if err != nil {
+		diags.AddAttributeError(path.Root("signing_key"), "unable to read signing key", err.Error())
+		return nil, diags
+	}
+
+	return identity, diags
+}
+
+// commitSignature returns the signature of commits created by resources, name
+// and email falling back to user.name and user.email of the git config.
+func commitSignature(repo *git.Repository, name types.String, email types.String) (*object.Signature, error) {
+	sig := &object.Signature{
+		Name:  name.ValueString(),
+		Email: email.ValueString(),
+		When:  time.Now(),
+	}
+
+	if sig.Name == "" || sig.Email == "" {
+		cfg, err := repo.ConfigScoped(config.SystemScope)
+		if err != nil {
+			return nil, err
+		}
+		if sig.Name == "" {
+			sig.Name = cfg.User.Name
+		}
+		if sig.Email == "" {
+			sig.Email = cfg.User.Email
+		}
+	}
+
+	if sig.Name == "" || sig.Email == "" {
+		return nil, fmt.Errorf("author_name and author_email must be set when user.name and user.email are not configured")
+	}
+
+	return sig, nil
+}
+
+// openBranchCommit opens the repository at repoPath for commits described by
+// opts, setting their identity according to cfg.
+func (p *GitProviderData) openBranchCommit(repoPath string, opts *branchCommit, cfg commitIdentityConfig) (*git.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	repo, err := p.openRepository(repoPath)
+	if err != nil {
+		diags.AddError("unable to open git repository", err.Error())
+		return nil, diags
+	}
+
+	opts.Identity, diags = p.commitIdentity(repo, cfg)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return repo, diags
+}
+
+// readSigningKey returns the first key of the armored keyring key, decrypted
+// with passphrase when encrypted.
+func readSigningKey(key string, passphrase string) (*openpgp.Entity, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 || entities[0].PrivateKey == nil {
+		return nil, fmt.Errorf("no private key found")
+	}
+
+	entity := entities[0]
+	if entity.PrivateKey.Encrypted {
+		if err := entity.PrivateKey.Decrypt([]byte(passphrase)); err != nil {
+			return nil, err
+		}
+	}
+	for _, subkey := range entity.Subkeys {
+		if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+			if err := subkey.PrivateKey.Decrypt([]byte(passphrase)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return entity, nil
+}
+
+// writeCommit writes commit to repo, signed with key unless it is nil, and
+// returns its hash.
+func writeCommit(repo *git.Repository, commit *object.Commit, key *openpgp.Entity) (plumbing.Hash, error) {
+	if key != nil {
+		if err := gitutils.SignCommit(commit, key); err != nil {
+			return plumbing.ZeroHash, err
+		}
+	}
+
+	obj := repo.Storer.NewEncodedObject()
+	if err := commit.Encode(obj); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	hash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("unable to write commit: %v", err)
+	}
+
+	return hash, nil
+}
+
+// commitBranch commits the changes returned by changes, called with the tip of
+// the branch, on top of it. When a remote is set the branch is first fast
+// forwarded to the remote branch, refusing to go on when they have diverged,
+// and pushed afterwards, the commit being undone when the push is rejected
+// because the remote branch moved in the meantime. A checked out branch also
+// updates the working tree, which must not have local changes. changes may set
+// the message of opts according to the tip, which is returned as is when there
+// is nothing to commit. The references changed are returned as well, the
+// remote branch last when it was pushed.
+func commitBranch(ctx context.Context, repo *git.Repository, opts *branchCommit, changes func(*object.Commit) (map[string]*gitutils.TreeChange, error)) (*object.Commit, []refUpdate, error) {
+	snapshot, err := snapshotRefs(repo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit, pushed, err := commitBranchChanges(ctx, repo, opts, changes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updates, err := snapshot.updates(repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pushed != nil {
+		updates = append(updates, *pushed)
+	}
+
+	return commit, updates, nil
+}
+
+// commitBranchChanges does the work of commitBranch, returning the update of
+// the remote branch when it pushed it.
+func commitBranchChanges(ctx context.Context, repo *git.Repository, opts *branchCommit, changes func(*object.Commit) (map[string]*gitutils.TreeChange, error)) (*object.Commit, *refUpdate, error) {
+	if opts.Remote != "" {
+		if err := syncBranch(ctx, repo, opts); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ref, err := repo.Reference(plumbing.NewBranchReferenceName(opts.Branch), true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find branch %q: %w", opts.Branch, err)
+	}
+
+	parent, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	treeChanges, err := changes(parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(treeChanges) == 0 {
+		return parent, nil, nil
+	}
+
+	tree, err := parent.Tree()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	treeHash, err := gitutils.WriteTree(repo.Storer, tree, treeChanges)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to write tree: %v", err)
+	}
+	if treeHash == parent.TreeHash {
+		return parent, nil, nil
+	}
+
+	hash, err := writeCommit(repo, &object.Commit{
+		Author:       *opts.Identity.Author,
+		Committer:    *opts.Identity.Committer,
+		Message:      opts.Message,
+		TreeHash:     treeHash,
+		ParentHashes: []plumbing.Hash{parent.Hash},
+	}, opts.Identity.SignKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := setBranch(repo, opts.Branch, hash); err != nil {
+		return nil, nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("committed %s on %s", hash.String(), opts.Branch))
+
+	var pushed *refUpdate
+	if opts.Remote != "" {
+		// The remote tracking branch is where the remote branch was fetched
+		// at, until the push moves it.
+		pushed = &refUpdate{Remote: opts.Remote, Name: plumbing.NewBranchReferenceName(opts.Branch), New: hash}
+		if ref, err := repo.Reference(plumbing.NewRemoteReferenceName(opts.Remote, opts.Branch), true); err == nil {
+			pushed.Old = ref.Hash()
+		}
+
+		err := repo.PushContext(ctx, &git.PushOptions{
+			RemoteName: opts.Remote,
+			RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("refs/heads/%[1]s:refs/heads/%[1]s", opts.Branch))},
+			Auth:       opts.Auth,
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			if resetErr := setBranch(repo, opts.Branch, parent.Hash); resetErr != nil {
+				tflog.Warn(ctx, fmt.Sprintf("unable to undo commit %s: %v", hash.String(), resetErr))
+			}
+			return nil, nil, fmt.Errorf("unable to push %s to %s, the remote branch may have changed: %v", opts.Branch, opts.Remote, err)
+		}
+	}
+
+	commit, err := repo.CommitObject(hash)
+	return commit, pushed, err
+}
+
+// syncBranch fetches the branch from the remote, fast forwarding or creating
+// the local branch to match it. A local branch ahead of the remote one is left
+// alone to be pushed, one that has diverged is an error.
+func syncBranch(ctx context.Context, repo *git.Repository, opts *branchCommit) error {
+	remoteName, err := fetchBranch(ctx, repo, opts.Remote, opts.Branch, opts.Auth)
+	if err != nil {
+		return fmt.Errorf("unable to fetch %s from %s: %v", opts.Branch, opts.Remote, err)
+	}
+
+	remoteRef, err := repo.Reference(remoteName, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		// The branch does not exist on the remote yet, pushing creates it.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	localRef, err := repo.Reference(plumbing.NewBranchReferenceName(opts.Branch), true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(opts.Branch), remoteRef.Hash()))
+	}
+	if err != nil {
+		return err
+	}
+	if localRef.Hash() == remoteRef.Hash() {
+		return nil
+	}
+
+	local, err := repo.CommitObject(localRef.Hash())
+	if err != nil {
+		return err
+	}
+	remote, err := repo.CommitObject(remoteRef.Hash())
+	if err != nil {
+		return err
+	}
+
+	if ok, err := local.IsAncestor(remote); err != nil {
+		return err
+	} else if ok {
+		tflog.Trace(ctx, fmt.Sprintf("fast forwarding %s to %s", opts.Branch, remote.Hash.String()))
+		return setBranch(repo, opts.Branch, remote.Hash)
+	}
+
+	if ok, err := remote.IsAncestor(local); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	return fmt.Errorf("branch %s has diverged from %s", opts.Branch, remoteName.Short())
+}
+
+// setBranch points branch at hash, updating the index and working tree as
+// well when the branch is checked out.
+func setBranch(repo *git.Repository, branch string, hash plumbing.Hash) error {
+	name := plumbing.NewBranchReferenceName(branch)
+
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err == nil && head.Type() == plumbing.SymbolicReference && head.Target() == name {
+		wt, err := repo.Worktree()
+		if err == nil {
+			if err := wt.Reset(&git.ResetOptions{Commit: hash, Mode: git.MergeReset}); err != nil {
+				return fmt.Errorf("unable to update the working tree of %s: %v", branch, err)
+			}
+			return nil
+		}
+		if !errors.Is(err, git.ErrIsBareRepository) {
+			return err
+		}
+	}
+
+	return repo.Storer.SetReference(plumbing.NewHashReference(name, hash))
+}
+
+// fetchBranch fetches branch from remote, returning its remote tracking
+// reference, which is removed when the branch does not exist on the remote.
+func fetchBranch(ctx context.Context, repo *git.Repository, remote string, branch string, auth transport.AuthMethod) (plumbing.ReferenceName, error) {
+	name := plumbing.NewRemoteReferenceName(remote, branch)
+
+	err := repo.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: remote,
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("+refs/heads/%s:%s", branch, name))},
+		Auth:       auth,
+	})
+	if errors.Is(err, git.NoMatchingRefSpecError{}) {
+		return name, repo.Storer.RemoveReference(name)
+	}
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return name, err
+	}
+
+	return name, nil
+}
+
+// branchFileEntry returns the tree entry of file in the commit name points at,
+// nil when either does not exist.
+func branchFileEntry(repo *git.Repository, name plumbing.ReferenceName, file string) (*object.TreeEntry, error) {
+	commit, err := branchTip(repo, name)
+	if commit == nil || err != nil {
+		return nil, err
+	}
+
+	entry, err := commitTreeEntry(commit, file)
+	if entry == nil || err != nil {
+		return nil, err
+	}
+	if entry.Mode == filemode.Dir || entry.Mode == filemode.Submodule {
+		return nil, nil
+	}
+
+	return entry, nil
+}
+
+// branchTip returns the commit name points at, nil when it does not exist.
+func branchTip(repo *git.Repository, name plumbing.ReferenceName) (*object.Commit, error) {
+	ref, err := repo.Reference(name, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.CommitObject(ref.Hash())
+}
+
+// commitTreeEntry returns the tree entry at name in commit, nil when it does
+// not exist.
+func commitTreeEntry(commit *object.Commit, name string) (*object.TreeEntry, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := tree.FindEntry(name)
+	if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
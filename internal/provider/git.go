@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// openRepository opens the repository at path like git.PlainOpen, also looking
+// up objects in the repositories listed in objects/info/alternates. Linked
+// working trees share the refs and objects of their common git directory.
+func openRepository(path string) (*git.Repository, error) {
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{EnableDotGitCommonDir: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gitutils.UseAlternates(repo); err != nil {
+		return nil, fmt.Errorf("unable to read alternates: %v", err)
+	}
+
+	return repo, nil
+}
+
+// resolveCommit resolves a revision (branch, tag, hash or expression such as
+// HEAD~1) to the commit it points at, defaulting to HEAD.
+func resolveCommit(repo *git.Repository, rev string) (*object.Commit, error) {
+	if rev == "" {
+		rev = "HEAD"
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve revision %q: %v", rev, err)
+	}
+
+	return repo.CommitObject(*hash)
+}
+
+// parseSignature describes the raw signature of a commit or tag, returning nil
+// when it is not signed. A signature that cannot be parsed is still reported,
+// with an unknown type, as the object is signed all the same.
+func parseSignature(ctx context.Context, armored string) *gitutils.Signature {
+	if armored == "" {
+		return nil
+	}
+
+	sig, err := gitutils.ParseSignature(armored)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to parse signature: %v", err))
+		return &gitutils.Signature{Type: "unknown"}
+	}
+
+	return sig
+}
+
+// walkRange calls fn for every commit reachable from to but not from from, the
+// equivalent of `git log from..to`. A nil from walks the whole history of to.
+func walkRange(from *object.Commit, to *object.Commit, fn func(*object.Commit) error) error {
+	excluded := map[plumbing.Hash]bool{}
+	if from != nil {
+		if err := object.NewCommitPreorderIter(from, nil, nil).ForEach(func(c *object.Commit) error {
+			excluded[c.Hash] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return object.NewCommitPreorderIter(to, excluded, nil).ForEach(fn)
+}
+
+// walkFirstParentRange is walkRange only following the first parent of merge
+// commits from to, the equivalent of `git log --first-parent from..to`.
+func walkFirstParentRange(from *object.Commit, to *object.Commit, fn func(*object.Commit) error) error {
+	excluded := map[plumbing.Hash]bool{}
+	if from != nil {
+		if err := object.NewCommitPreorderIter(from, nil, nil).ForEach(func(c *object.Commit) error {
+			excluded[c.Hash] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	for c := to; !excluded[c.Hash]; {
+		if err := fn(c); err != nil {
+			if err == storer.ErrStop {
+				return nil
+			}
+			return err
+		}
+		if c.NumParents() == 0 {
+			return nil
+		}
+
+		parent, err := c.Parent(0)
+		if err != nil {
+			return err
+		}
+		c = parent
+	}
+
+	return nil
+}
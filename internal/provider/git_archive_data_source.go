@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitArchive{}
+
+func NewGitArchive() datasource.DataSource {
+	return &GitArchive{}
+}
+
+// GitArchive defines the data source implementation.
+type GitArchive struct {
+	provider *GitProviderData
+}
+
+// GitArchiveModel describes the data source data model.
+type GitArchiveModel struct {
+	Id           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Reference    types.String `tfsdk:"ref"`
+	Directory    types.String `tfsdk:"directory"`
+	OutputPath   types.String `tfsdk:"output_path"`
+	Format       types.String `tfsdk:"format"`
+	Prefix       types.String `tfsdk:"prefix"`
+	Commit       types.String `tfsdk:"commit"`
+	OutputSHA256 types.String `tfsdk:"output_sha256"`
+	OutputSHA512 types.String `tfsdk:"output_sha512"`
+	OutputSize   types.Int64  `tfsdk:"output_size"`
+}
+
+func (d *GitArchive) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_archive"
+}
+
+func (d *GitArchive) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Archive data source, writes an archive of the tree at a reference to disk like `git archive`. Paths with the `export-ignore` attribute are left out. Archives are reproducible, entries are sorted and carry the commit date and root ownership so the same commit always produces a byte-identical archive",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to archive, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "Only archive this directory, relative to the root of the repository, its content is placed at the root of the archive (default: the root)",
+				Optional:            true,
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Path to write the archive to, parent directories are created as needed",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of the archive, one of `tar`, `tar.gz` or `zip` (default: inferred from the extension of `output_path`, `tar` otherwise)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix prepended to every path in the archive (ie. `app/`)",
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"output_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA256 checksum of the archive, hex encoded",
+				Computed:            true,
+			},
+			"output_sha512": schema.StringAttribute{
+				MarkdownDescription: "SHA512 checksum of the archive, hex encoded",
+				Computed:            true,
+			},
+			"output_size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the archive in bytes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitArchive) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitArchive) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitArchiveModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.Format.ValueString() == "" {
+		data.Format = types.StringValue(gitutils.ArchiveFormat(data.OutputPath.ValueString()))
+	}
+	if !containsString(gitutils.ArchiveFormats, data.Format.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("format"), "invalid format", fmt.Sprintf("format must be one of: %s", strings.Join(gitutils.ArchiveFormats, ", ")))
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	exportIgnore, err := gitutils.NewExportIgnore(tree)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read gitattributes", err.Error())
+		return
+	}
+
+	directory := filepath.ToSlash(filepath.Clean(data.Directory.ValueString()))
+	if directory != "." && directory != "/" {
+		tree, err = tree.Tree(directory)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("directory"), "unable to find directory", fmt.Sprintf("%s at %s: %s", directory, commit.Hash.String(), err.Error()))
+			return
+		}
+	} else {
+		directory = ""
+	}
+
+	output, err := writeArchiveFile(data.OutputPath.ValueString(), tree, gitutils.ArchiveOptions{
+		Format:  data.Format.ValueString(),
+		Prefix:  data.Prefix.ValueString(),
+		ModTime: commit.Committer.When,
+		Exclude: func(name string) bool {
+			return exportIgnore.Match(joinTreePath(directory, name))
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("output_path"), "unable to write archive", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("archive: %s sha256: %s size: %d", data.OutputPath.ValueString(), output.sha256, output.size))
+
+	data.Id = types.StringValue(output.sha256)
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.OutputSHA256 = types.StringValue(output.sha256)
+	data.OutputSHA512 = types.StringValue(output.sha512)
+	data.OutputSize = types.Int64Value(output.size)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// archiveOutput describes an archive written to disk, the checksums are hex
+// encoded.
+type archiveOutput struct {
+	sha256 string
+	sha512 string
+	size   int64
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// writeArchiveFile writes the archive of tree to name, computing its checksums
+// and size on the way. The archive is written to a temporary file first so
+// name is never left truncated.
+func writeArchiveFile(name string, tree *object.Tree, opts gitutils.ArchiveOptions) (*archiveOutput, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(name), ".terraform-provider-git-")
+	if err != nil {
+		return nil, err
+	}
+	//noinspection GoUnhandledErrorResult
+	defer os.Remove(f.Name())
+
+	sum256 := sha256.New()
+	sum512 := sha512.New()
+	counter := &countingWriter{}
+	if err := gitutils.WriteArchive(io.MultiWriter(f, sum256, sum512, counter), tree, opts); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(f.Name(), name); err != nil {
+		return nil, err
+	}
+
+	return &archiveOutput{
+		sha256: hex.EncodeToString(sum256.Sum(nil)),
+		sha512: hex.EncodeToString(sum512.Sum(nil)),
+		size:   counter.n,
+	}, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
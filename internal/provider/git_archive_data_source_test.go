@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitArchiveDataSourceConfig(path string, outputPath string, directory string, prefix string) string {
+	return fmt.Sprintf(`
+data "git_archive" "test" {
+  path        = %[1]q
+  output_path = %[2]q
+  directory   = %[3]q
+  prefix      = %[4]q
+}
+`, path, outputPath, directory, prefix)
+}
+
+// testCheckArchiveOutput checks the checksums and size match the archive on
+// disk.
+func testCheckArchiveOutput(name string, outputPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		content, err := os.ReadFile(outputPath)
+		if err != nil {
+			return err
+		}
+		sum256 := sha256.Sum256(content)
+		sum512 := sha512.Sum512(content)
+		return resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr(name, "output_sha256", hex.EncodeToString(sum256[:])),
+			resource.TestCheckResourceAttr(name, "output_sha512", hex.EncodeToString(sum512[:])),
+			resource.TestCheckResourceAttr(name, "output_size", fmt.Sprintf("%d", len(content))),
+		)(s)
+	}
+}
+
+func TestAccGitArchiveDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	repoDir := filepath.Join(tempDir, "repo")
+	assert.NoError(t, os.MkdirAll(repoDir, 0755))
+
+	_, err = testSetupGit(repoDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(repoDir, "lambda", "lib"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(repoDir, "lambda", "index.js"), []byte("exports.handler = () => {}"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(repoDir, "lambda", "lib", "run.sh"), []byte("#!/bin/sh"), 0755))
+	hash, err := testCommitAll(repoDir, "lambda")
+	assert.NoError(t, err)
+
+	zipPath := filepath.Join(tempDir, "out", "lambda.zip")
+	tarPath := filepath.Join(tempDir, "out", "repo.tar")
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitArchiveDataSourceConfig(repoDir, zipPath, "lambda", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_archive.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_archive.test", "format", "zip"),
+					testCheckArchiveOutput("data.git_archive.test", zipPath),
+					func(s *terraform.State) error {
+						r, err := zip.OpenReader(zipPath)
+						if err != nil {
+							return err
+						}
+						//noinspection GoUnhandledErrorResult
+						defer r.Close()
+
+						var names []string
+						for _, f := range r.File {
+							names = append(names, f.Name)
+						}
+						assert.Equal(t, []string{"index.js", "lib/", "lib/run.sh"}, names)
+						assert.Equal(t, os.FileMode(0755), r.File[2].Mode())
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccGitArchiveDataSourceConfig(repoDir, tarPath, "", "app/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_archive.test", "format", "tar"),
+					testCheckArchiveOutput("data.git_archive.test", tarPath),
+					func(s *terraform.State) error {
+						f, err := os.Open(tarPath)
+						if err != nil {
+							return err
+						}
+						//noinspection GoUnhandledErrorResult
+						defer f.Close()
+
+						var names []string
+						tr := tar.NewReader(f)
+						for {
+							header, err := tr.Next()
+							if err == io.EOF {
+								break
+							}
+							if err != nil {
+								return err
+							}
+							names = append(names, header.Name)
+						}
+						assert.Equal(t, []string{"app/README.md", "app/lambda/", "app/lambda/index.js", "app/lambda/lib/", "app/lambda/lib/run.sh"}, names)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccGitArchiveDataSourceConfigTwice(path string, first string, second string) string {
+	return fmt.Sprintf(`
+data "git_archive" "first" {
+  path        = %[1]q
+  output_path = %[2]q
+}
+
+data "git_archive" "second" {
+  path        = %[1]q
+  output_path = %[3]q
+}
+`, path, first, second)
+}
+
+func TestAccGitArchiveDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	repoDir := filepath.Join(tempDir, "repo")
+	assert.NoError(t, os.MkdirAll(repoDir, 0755))
+
+	hash, err := testSetupGit(repoDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(repoDir)
+	assert.NoError(t, err)
+	commit, err := repo.CommitObject(*hash)
+	assert.NoError(t, err)
+
+	first := filepath.Join(tempDir, "first.tar.gz")
+	second := filepath.Join(tempDir, "second.tgz")
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitArchiveDataSourceConfigTwice(repoDir, first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_archive.first", "format", "tar.gz"),
+					resource.TestCheckResourceAttr("data.git_archive.second", "format", "tar.gz"),
+					resource.TestCheckResourceAttrPair("data.git_archive.first", "output_sha256", "data.git_archive.second", "output_sha256"),
+					testCheckArchiveOutput("data.git_archive.first", first),
+					func(s *terraform.State) error {
+						f, err := os.Open(first)
+						if err != nil {
+							return err
+						}
+						//noinspection GoUnhandledErrorResult
+						defer f.Close()
+
+						gr, err := gzip.NewReader(f)
+						if err != nil {
+							return err
+						}
+						assert.True(t, gr.ModTime.IsZero())
+
+						header, err := tar.NewReader(gr).Next()
+						if err != nil {
+							return err
+						}
+						assert.Equal(t, "README.md", header.Name)
+						assert.Equal(t, 0, header.Uid)
+						assert.Equal(t, "root", header.Uname)
+						assert.Equal(t, commit.Committer.When.Truncate(time.Second).Unix(), header.ModTime.Unix())
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
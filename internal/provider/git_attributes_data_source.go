@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitAttributes{}
+
+func NewGitAttributes() datasource.DataSource {
+	return &GitAttributes{}
+}
+
+// GitAttributes defines the data source implementation.
+type GitAttributes struct {
+	provider *GitProviderData
+}
+
+// GitAttributesModel describes the data source data model.
+type GitAttributesModel struct {
+	Id         types.String                       `tfsdk:"id"`
+	Path       types.String                       `tfsdk:"path"`
+	Reference  types.String                       `tfsdk:"ref"`
+	Paths      []types.String                     `tfsdk:"paths"`
+	Names      []types.String                     `tfsdk:"names"`
+	Commit     types.String                       `tfsdk:"commit"`
+	Attributes map[string]map[string]types.String `tfsdk:"attributes"`
+}
+
+func (d *GitAttributes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attributes"
+}
+
+func (d *GitAttributes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Attributes data source, evaluates the `.gitattributes` files committed at a reference " +
+			"for paths like `git check-attr --all --source`, ie. to predict which files archives leave out " +
+			"(`export-ignore`) or store with LFS (`filter=lfs`)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read the `.gitattributes` files at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "Paths to evaluate, relative to the root of the repository, they do not need to exist",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Only return these attributes (ie. `[\"filter\", \"eol\"]`), by default every specified attribute is returned",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"attributes": schema.MapAttribute{
+				MarkdownDescription: "Attributes of each path keyed by path then attribute name. Values are `set`, `unset` " +
+					"(`-name`) or the assigned value (ie. `lfs` for `filter=lfs`), unspecified attributes are left out",
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *GitAttributes) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitAttributes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitAttributesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	attributes, err := gitutils.NewAttributes(tree)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read gitattributes", err.Error())
+		return
+	}
+
+	names := toStrings(data.Names)
+
+	data.Attributes = map[string]map[string]types.String{}
+	for _, p := range toStrings(data.Paths) {
+		values := map[string]types.String{}
+		for name, value := range attributes.Match(p) {
+			if len(names) > 0 && !containsString(names, name) {
+				continue
+			}
+			values[name] = types.StringValue(value)
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("path: %s attributes: %d", p, len(values)))
+
+		data.Attributes[p] = values
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
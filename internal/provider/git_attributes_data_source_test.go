@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitAttributesDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_attributes" "test" {
+  path  = %[1]q
+  paths = ["assets/logo.bin", "logo.png", "docs/guide/index.md", "run.sh", "scripts/run.sh", "other.txt"]
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitAttributesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".gitattributes"), []byte(`*.bin filter=lfs diff=lfs merge=lfs -text
+*.png binary
+docs/** export-ignore
+*.sh text eol=lf
+`), 0644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "scripts"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "scripts", ".gitattributes"), []byte("*.sh eol=crlf\n"), 0644))
+	hash, err := testCommitAll(tempDir, "attributes")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitAttributesDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_attributes.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.%", "6"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.assets/logo.bin.%", "4"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.assets/logo.bin.filter", "lfs"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.assets/logo.bin.text", "unset"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.logo.png.%", "4"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.logo.png.binary", "set"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.logo.png.diff", "unset"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.docs/guide/index.md.export-ignore", "set"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.run.sh.eol", "lf"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.scripts/run.sh.text", "set"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.scripts/run.sh.eol", "crlf"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.other.txt.%", "0"),
+				),
+			},
+			{
+				Config: testAccGitAttributesDataSourceConfig(tempDir, `names = ["eol"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.scripts/run.sh.%", "1"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.scripts/run.sh.eol", "crlf"),
+					resource.TestCheckResourceAttr("data.git_attributes.test", "attributes.logo.png.%", "0"),
+				),
+			},
+		},
+	})
+}
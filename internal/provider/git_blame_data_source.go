@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitBlame{}
+
+func NewGitBlame() datasource.DataSource {
+	return &GitBlame{}
+}
+
+// GitBlame defines the data source implementation.
+type GitBlame struct {
+	provider *GitProviderData
+}
+
+// GitBlameModel describes the data source data model.
+type GitBlameModel struct {
+	Id        types.String        `tfsdk:"id"`
+	Path      types.String        `tfsdk:"path"`
+	File      types.String        `tfsdk:"file"`
+	Reference types.String        `tfsdk:"ref"`
+	Commit    types.String        `tfsdk:"commit"`
+	Lines     []GitBlameLineModel `tfsdk:"lines"`
+}
+
+// GitBlameLineModel describes the blame information of a single line.
+type GitBlameLineModel struct {
+	Number      types.Int64  `tfsdk:"number"`
+	Commit      types.String `tfsdk:"commit"`
+	AuthorName  types.String `tfsdk:"author_name"`
+	AuthorEmail types.String `tfsdk:"author_email"`
+	Date        types.String `tfsdk:"date"`
+	DateUnix    types.Int64  `tfsdk:"date_unix"`
+	Text        types.String `tfsdk:"text"`
+}
+
+func (d *GitBlame) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blame"
+}
+
+func (d *GitBlame) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Blame data source, attributes every line of a file at a reference to the commit that last changed it",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file relative to the root of the repository",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to blame the file at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"lines": schema.ListNestedAttribute{
+				MarkdownDescription: "Blame information for every line of the file",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"number": schema.Int64Attribute{
+							MarkdownDescription: "Line number, starting at 1",
+							Computed:            true,
+						},
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Commit that last changed the line",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name of the commit that last changed the line",
+							Computed:            true,
+						},
+						"author_email": schema.StringAttribute{
+							MarkdownDescription: "Author email of the commit that last changed the line",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Author date of the commit that last changed the line (RFC3339)",
+							Computed:            true,
+						},
+						"date_unix": schema.Int64Attribute{
+							MarkdownDescription: "Author date of the commit that last changed the line (Unix timestamp)",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "Content of the line",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitBlame) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitBlame) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitBlameModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	result, err := gitutils.Blame(commit, data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "unable to blame file", fmt.Sprintf("%s at %s: %s", data.File.ValueString(), commit.Hash.String(), err.Error()))
+		return
+	}
+
+	commits := map[plumbing.Hash]*object.Commit{}
+
+	data.Lines = []GitBlameLineModel{}
+	for i, line := range result {
+		c, ok := commits[line.Hash]
+		if !ok {
+			c, err = repo.CommitObject(line.Hash)
+			if err != nil {
+				resp.Diagnostics.AddError("unable to read blamed commit", err.Error())
+				return
+			}
+			commits[line.Hash] = c
+		}
+
+		data.Lines = append(data.Lines, GitBlameLineModel{
+			Number:      types.Int64Value(int64(i + 1)),
+			Commit:      types.StringValue(line.Hash.String()),
+			AuthorName:  types.StringValue(c.Author.Name),
+			AuthorEmail: types.StringValue(c.Author.Email),
+			Date:        types.StringValue(c.Author.When.Format(time.RFC3339)),
+			DateUnix:    types.Int64Value(c.Author.When.Unix()),
+			Text:        types.StringValue(line.Text),
+		})
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("blame: %s lines: %d commits: %d", data.File.ValueString(), len(data.Lines), len(commits)))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", commit.Hash.String(), data.File.ValueString()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitBlameDataSourceConfig(path string, file string) string {
+	return fmt.Sprintf(`
+data "git_blame" "test" {
+  path = %[1]q
+  file = %[2]q
+}
+`, path, file)
+}
+
+func TestAccGitBlameDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("one\ntwo\n"), 0644))
+	first, err := testCommitAll(tempDir, "first")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("one\nthree\n"), 0644))
+	second, err := testCommitAll(tempDir, "second")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBlameDataSourceConfig(tempDir, "main.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_blame.test", "commit", second.String()),
+					resource.TestCheckResourceAttr("data.git_blame.test", "lines.#", "2"),
+					resource.TestCheckResourceAttr("data.git_blame.test", "lines.0.number", "1"),
+					resource.TestCheckResourceAttr("data.git_blame.test", "lines.0.commit", first.String()),
+					resource.TestCheckResourceAttr("data.git_blame.test", "lines.0.text", "one"),
+					resource.TestCheckResourceAttr("data.git_blame.test", "lines.1.commit", second.String()),
+					resource.TestCheckResourceAttr("data.git_blame.test", "lines.1.text", "three"),
+					resource.TestCheckResourceAttrSet("data.git_blame.test", "lines.1.author_name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitBlobHash{}
+
+func NewGitBlobHash() datasource.DataSource {
+	return &GitBlobHash{}
+}
+
+// GitBlobHash defines the data source implementation.
+type GitBlobHash struct {
+	provider *GitProviderData
+}
+
+// GitBlobHashModel describes the data source data model.
+type GitBlobHashModel struct {
+	Id        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Reference types.String `tfsdk:"ref"`
+	File      types.String `tfsdk:"file"`
+	Commit    types.String `tfsdk:"commit"`
+	Exists    types.Bool   `tfsdk:"exists"`
+	Hash      types.String `tfsdk:"hash"`
+	Size      types.Int64  `tfsdk:"size"`
+	Mode      types.String `tfsdk:"mode"`
+}
+
+func (d *GitBlobHash) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blob_hash"
+}
+
+func (d *GitBlobHash) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Blob Hash data source, returns the hash of the blob object of a file at a reference like " +
+			"`git rev-parse <ref>:<file>`, without reading its content. The hash only changes with the committed content " +
+			"of the file, regardless of the working tree",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read the file at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file relative to the root of the repository",
+				Required:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the file exists at the reference",
+				Computed:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the blob holding the file content, empty when it does not exist",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the file in bytes, 0 when it does not exist",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Git file mode of the file (ie. `0100644`), empty when it does not exist",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitBlobHash) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitBlobHash) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitBlobHashModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	data.Exists = types.BoolValue(false)
+	data.Hash = types.StringValue("")
+	data.Size = types.Int64Value(0)
+	data.Mode = types.StringValue("")
+
+	file := filepath.ToSlash(filepath.Clean(data.File.ValueString()))
+
+	entry, err := tree.FindEntry(file)
+	switch {
+	case err == object.ErrDirectoryNotFound || err == object.ErrEntryNotFound:
+		// a missing file is not an error, exists is false
+	case err != nil:
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	case !entry.Mode.IsFile():
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "invalid file", fmt.Sprintf("%s at %s is not a file", file, commit.Hash.String()))
+		return
+	default:
+		size, err := repo.Storer.EncodedObjectSize(entry.Hash)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read blob", err.Error())
+			return
+		}
+
+		data.Exists = types.BoolValue(true)
+		data.Hash = types.StringValue(entry.Hash.String())
+		data.Size = types.Int64Value(size)
+		data.Mode = types.StringValue(entry.Mode.String())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("file: %s blob: %s", file, data.Hash.ValueString()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", commit.Hash.String(), file))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
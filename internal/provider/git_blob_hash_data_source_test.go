@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitBlobHashDataSourceConfig(path string, file string) string {
+	return fmt.Sprintf(`
+data "git_blob_hash" "test" {
+  path = %[1]q
+  file = %[2]q
+}
+`, path, file)
+}
+
+func TestAccGitBlobHashDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "config"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config", "app.yaml"), []byte("testing"), 0755))
+	hash, err := testCommitAll(tempDir, "config")
+	assert.NoError(t, err)
+
+	// Changes to the working tree are not seen.
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config", "app.yaml"), []byte("dirty"), 0755))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBlobHashDataSourceConfig(tempDir, "config/app.yaml"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "hash", "9a2c7732fab5bcd73ea3ed52d2d9599a4cc47666"),
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "size", "7"),
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "mode", "0100755"),
+				),
+			},
+			{
+				Config: testAccGitBlobHashDataSourceConfig(tempDir, "config/missing.yaml"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "exists", "false"),
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "hash", ""),
+					resource.TestCheckResourceAttr("data.git_blob_hash.test", "size", "0"),
+				),
+			},
+			{
+				Config:      testAccGitBlobHashDataSourceConfig(tempDir, "config"),
+				ExpectError: regexp.MustCompile("invalid file"),
+			},
+		},
+	})
+}
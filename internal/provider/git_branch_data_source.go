@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitBranch{}
+
+func NewGitBranch() datasource.DataSource {
+	return &GitBranch{}
+}
+
+// GitBranch defines the data source implementation.
+type GitBranch struct {
+	provider *GitProviderData
+}
+
+// GitBranchModel describes the data source data model.
+type GitBranchModel struct {
+	Id                types.String `tfsdk:"id"`
+	Path              types.String `tfsdk:"path"`
+	Name              types.String `tfsdk:"name"`
+	Reference         types.String `tfsdk:"ref"`
+	Commit            types.String `tfsdk:"commit"`
+	IsHead            types.Bool   `tfsdk:"is_head"`
+	Upstream          types.String `tfsdk:"upstream"`
+	UpstreamRemote    types.String `tfsdk:"upstream_remote"`
+	UpstreamBranch    types.String `tfsdk:"upstream_branch"`
+	CommitMessage     types.String `tfsdk:"commit_message"`
+	CommitAuthorName  types.String `tfsdk:"commit_author_name"`
+	CommitAuthorEmail types.String `tfsdk:"commit_author_email"`
+	CommitDate        types.String `tfsdk:"commit_date"`
+	CommitDateUnix    types.Int64  `tfsdk:"commit_date_unix"`
+	CommitSigned      types.Bool   `tfsdk:"commit_signed"`
+	CommitSignature   types.String `tfsdk:"commit_signature_type"`
+	CommitKeyID       types.String `tfsdk:"commit_signature_key_id"`
+	CommitFingerprint types.String `tfsdk:"commit_signature_fingerprint"`
+	CommitSignerEmail types.String `tfsdk:"commit_signer_email"`
+}
+
+func (d *GitBranch) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch"
+}
+
+func (d *GitBranch) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Branch data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the local branch",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Full reference name of the branch",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Hash of the commit at the tip of the branch",
+				Computed:            true,
+			},
+			"is_head": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the branch is currently checked out",
+				Computed:            true,
+			},
+			"upstream": schema.StringAttribute{
+				MarkdownDescription: "Upstream tracking branch (ie. `origin/main`), empty when none is configured",
+				Computed:            true,
+			},
+			"upstream_remote": schema.StringAttribute{
+				MarkdownDescription: "Remote of the upstream tracking branch",
+				Computed:            true,
+			},
+			"upstream_branch": schema.StringAttribute{
+				MarkdownDescription: "Reference merged from the upstream remote (ie. `refs/heads/main`)",
+				Computed:            true,
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the commit at the tip of the branch",
+				Computed:            true,
+			},
+			"commit_author_name": schema.StringAttribute{
+				MarkdownDescription: "Author name of the commit at the tip of the branch",
+				Computed:            true,
+			},
+			"commit_author_email": schema.StringAttribute{
+				MarkdownDescription: "Author email of the commit at the tip of the branch",
+				Computed:            true,
+			},
+			"commit_date": schema.StringAttribute{
+				MarkdownDescription: "Author date of the commit at the tip of the branch (RFC3339)",
+				Computed:            true,
+			},
+			"commit_date_unix": schema.Int64Attribute{
+				MarkdownDescription: "Author date of the commit at the tip of the branch (Unix timestamp)",
+				Computed:            true,
+			},
+			"commit_signed": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the commit at the tip of the branch carries a signature, the signature is not verified",
+				Computed:            true,
+			},
+			"commit_signature_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the commit signature, one of `gpg`, `ssh`, `x509` or `unknown`, empty when unsigned",
+				Computed:            true,
+			},
+			"commit_signature_key_id": schema.StringAttribute{
+				MarkdownDescription: "Key ID the commit claims to be signed with, the long key ID for `gpg` and the SHA256 fingerprint for `ssh`",
+				Computed:            true,
+			},
+			"commit_signature_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Fingerprint of the key the commit claims to be signed with, when the signature includes it",
+				Computed:            true,
+			},
+			"commit_signer_email": schema.StringAttribute{
+				MarkdownDescription: "Signer email embedded in the commit signature, when the signature includes it",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitBranch) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitBranch) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitBranchModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	refName := plumbing.NewBranchReferenceName(data.Name.ValueString())
+
+	ref, err := repo.Reference(refName, true)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to find branch", fmt.Sprintf("%s: %s", refName.String(), err.Error()))
+		return
+	}
+
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read branch commit", err.Error())
+		return
+	}
+
+	data.IsHead = types.BoolValue(false) // default
+
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git head reference", err.Error())
+		return
+	}
+	if head.Type() == plumbing.SymbolicReference && head.Target() == refName {
+		data.IsHead = types.BoolValue(true)
+	}
+
+	data.Upstream = types.StringValue("")
+	data.UpstreamRemote = types.StringValue("")
+	data.UpstreamBranch = types.StringValue("")
+
+	branch, err := repo.Branch(data.Name.ValueString())
+	if err != nil && err != git.ErrBranchNotFound {
+		resp.Diagnostics.AddError("unable to read branch configuration", err.Error())
+		return
+	}
+	if branch != nil && branch.Remote != "" && branch.Merge != "" {
+		data.UpstreamRemote = types.StringValue(branch.Remote)
+		data.UpstreamBranch = types.StringValue(branch.Merge.String())
+
+		if branch.Remote == "." {
+			data.Upstream = types.StringValue(branch.Merge.Short())
+		} else {
+			data.Upstream = types.StringValue(fmt.Sprintf("%s/%s", branch.Remote, branch.Merge.Short()))
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("branch: %s", refName.String()))
+	tflog.Trace(ctx, fmt.Sprintf("upstream: %s", data.Upstream.ValueString()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), refName.String()))
+	data.Reference = types.StringValue(refName.String())
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.CommitMessage = types.StringValue(commit.Message)
+	data.CommitAuthorName = types.StringValue(commit.Author.Name)
+	data.CommitAuthorEmail = types.StringValue(commit.Author.Email)
+	data.CommitDate = types.StringValue(commit.Author.When.Format(time.RFC3339))
+	data.CommitDateUnix = types.Int64Value(commit.Author.When.Unix())
+	data.CommitSigned = types.BoolValue(false)
+	data.CommitSignature = types.StringValue("")
+	data.CommitKeyID = types.StringValue("")
+	data.CommitFingerprint = types.StringValue("")
+	data.CommitSignerEmail = types.StringValue("")
+
+	if sig := parseSignature(ctx, commit.PGPSignature); sig != nil {
+		data.CommitSigned = types.BoolValue(true)
+		data.CommitSignature = types.StringValue(sig.Type)
+		data.CommitKeyID = types.StringValue(sig.KeyID)
+		data.CommitFingerprint = types.StringValue(sig.Fingerprint)
+		data.CommitSignerEmail = types.StringValue(sig.SignerEmail)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
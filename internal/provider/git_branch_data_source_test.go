@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitBranchDataSourceConfig(path string, name string) string {
+	return fmt.Sprintf(`
+data "git_branch" "test" {
+  path = %[1]q
+  name = %[2]q
+}
+`, path, name)
+}
+
+func TestAccGitBranchDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBranchDataSourceConfig(tempDir, "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch.test", "ref", "refs/heads/master"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_branch.test", "is_head", "true"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "upstream", ""),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_message", "tests"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signed", "false"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_type", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitBranchDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("feature"), *hash)))
+	assert.NoError(t, repo.CreateBranch(&config.Branch{
+		Name:   "feature",
+		Remote: "origin",
+		Merge:  plumbing.NewBranchReferenceName("main"),
+	}))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBranchDataSourceConfig(tempDir, "feature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_branch.test", "is_head", "false"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "upstream", "origin/main"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "upstream_remote", "origin"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "upstream_branch", "refs/heads/main"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitBranchDataSource3(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	reg, err := regexp.Compile("unable to find branch")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitBranchDataSourceConfig(tempDir, "missing"),
+				ExpectError: reg,
+			},
+		},
+	})
+}
+
+func TestAccGitBranchDataSource4(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	entity, err := openpgp.NewEntity("Signer", "", "signer@example.com", nil)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("# main"), 0644))
+	_, err = wt.Add("main.tf")
+	assert.NoError(t, err)
+	_, err = wt.Commit("signed", &git.CommitOptions{
+		SignKey: entity,
+	})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBranchDataSourceConfig(tempDir, "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signed", "true"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_type", "gpg"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_key_id", entity.PrimaryKey.KeyIdString()),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_fingerprint", fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitBranchDataSource5(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	signature, fingerprint, err := testSSHSignature()
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	// go-git cannot sign with SSH keys, attach the signature to a copy of the
+	// commit instead, nothing verifies it.
+	commit, err := repo.CommitObject(*hash)
+	assert.NoError(t, err)
+	commit.PGPSignature = signature
+
+	obj := repo.Storer.NewEncodedObject()
+	assert.NoError(t, commit.Encode(obj))
+	signed, err := repo.Storer.SetEncodedObject(obj)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("ssh"), signed)))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBranchDataSourceConfig(tempDir, "ssh"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit", signed.String()),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signed", "true"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_type", "ssh"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_key_id", fingerprint),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signature_fingerprint", fingerprint),
+					resource.TestCheckResourceAttr("data.git_branch.test", "commit_signer_email", ""),
+				),
+			},
+		},
+	})
+}
+
+// testSSHSignature returns an armored SSHSIG blob for a new ed25519 key along
+// with the fingerprint of the key, the signature itself is not valid.
+func testSSHSignature() (string, string, error) {
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := ssh.NewPublicKey(public)
+	if err != nil {
+		return "", "", err
+	}
+
+	blob := []byte("SSHSIG")
+	blob = binary.BigEndian.AppendUint32(blob, 1)
+	for _, field := range [][]byte{key.Marshal(), []byte("git"), {}, []byte("sha512"), []byte("signature")} {
+		blob = binary.BigEndian.AppendUint32(blob, uint32(len(field)))
+		blob = append(blob, field...)
+	}
+
+	armored := pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob})
+
+	return string(armored), ssh.FingerprintSHA256(key), nil
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitBranchSlug{}
+
+func NewGitBranchSlug() datasource.DataSource {
+	return &GitBranchSlug{}
+}
+
+// GitBranchSlug defines the data source implementation.
+type GitBranchSlug struct {
+	provider *GitProviderData
+}
+
+// GitBranchSlugModel describes the data source data model.
+type GitBranchSlugModel struct {
+	Id        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Branch    types.String `tfsdk:"branch"`
+	MaxLength types.Int64  `tfsdk:"max_length"`
+	Slug      types.String `tfsdk:"slug"`
+	Truncated types.Bool   `tfsdk:"truncated"`
+}
+
+func (d *GitBranchSlug) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_slug"
+}
+
+func (d *GitBranchSlug) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Branch Slug data source, converts a branch name into a slug safe for DNS labels and resource names: " +
+			"lowercase, every run of characters other than letters and digits replaced by `-` and at most `max_length` " +
+			"characters. Truncated slugs end with a hash of the branch name so they stay unique and stable",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, only read when `branch` is omitted",
+				Optional:            true,
+				Computed:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch name to convert, `refs/heads/` is dropped (default: the branch checked out in the repository)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max_length": schema.Int64Attribute{
+				MarkdownDescription: "Maximum length of the slug (default: 63, the length limit of DNS labels)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Slug of the branch name (ie. `feature-jira-12-login`)",
+				Computed:            true,
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the slug was truncated to `max_length` and ends with a hash",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitBranchSlug) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitBranchSlug) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitBranchSlugModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MaxLength.IsNull() || data.MaxLength.IsUnknown() {
+		data.MaxLength = types.Int64Value(63)
+	}
+	if data.MaxLength.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_length"), "invalid max_length", "max_length must be at least 1")
+		return
+	}
+
+	if data.Branch.ValueString() == "" {
+		repoPath, err := d.provider.repositoryPath(data.Path)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+			return
+		}
+		data.Path = types.StringValue(repoPath)
+
+		repo, err := d.provider.openRepository(data.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to open git repository", err.Error())
+			return
+		}
+
+		head, err := repo.Head()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read git head reference", err.Error())
+			return
+		}
+		if !head.Name().IsBranch() {
+			resp.Diagnostics.AddAttributeError(path.Root("branch"), "unable to determine branch", "HEAD is detached, set branch")
+			return
+		}
+		data.Branch = types.StringValue(head.Name().Short())
+	}
+
+	slug, truncated := gitutils.Slugify(data.Branch.ValueString(), int(data.MaxLength.ValueInt64()))
+	if slug == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("branch"), "invalid format", fmt.Sprintf("%q has no letters or digits", data.Branch.ValueString()))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("branch: %s slug: %s", data.Branch.ValueString(), slug))
+
+	data.Id = types.StringValue(slug)
+	data.Slug = types.StringValue(slug)
+	data.Truncated = types.BoolValue(truncated)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
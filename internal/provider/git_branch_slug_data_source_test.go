@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitBranchSlugDataSourceConfig(options string) string {
+	return fmt.Sprintf(`
+data "git_branch_slug" "test" {
+  %[1]s
+}
+`, options)
+}
+
+func TestAccGitBranchSlugDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitBranchSlugDataSourceConfig(fmt.Sprintf("path = %q", tempDir)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch_slug.test", "branch", "master"),
+					resource.TestCheckResourceAttr("data.git_branch_slug.test", "slug", "master"),
+					resource.TestCheckResourceAttr("data.git_branch_slug.test", "truncated", "false"),
+				),
+			},
+			{
+				Config: testAccGitBranchSlugDataSourceConfig(`branch = "refs/heads/Feature/JIRA-12__Login!"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch_slug.test", "slug", "feature-jira-12-login"),
+				),
+			},
+			{
+				Config: testAccGitBranchSlugDataSourceConfig(`branch = "feature/a-very-long-branch-name-that-goes-on-and-on"
+  max_length = 20`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_branch_slug.test", "slug", "feature-a-v-44de6524"),
+					resource.TestCheckResourceAttr("data.git_branch_slug.test", "truncated", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitChangedPaths{}
+
+func NewGitChangedPaths() datasource.DataSource {
+	return &GitChangedPaths{}
+}
+
+// GitChangedPaths defines the data source implementation.
+type GitChangedPaths struct {
+	provider *GitProviderData
+}
+
+// GitChangedPathsModel describes the data source data model.
+type GitChangedPathsModel struct {
+	Id           types.String          `tfsdk:"id"`
+	Path         types.String          `tfsdk:"path"`
+	FromRef      types.String          `tfsdk:"from_ref"`
+	ToRef        types.String          `tfsdk:"to_ref"`
+	Paths        []types.String        `tfsdk:"paths"`
+	FromCommit   types.String          `tfsdk:"from_commit"`
+	ToCommit     types.String          `tfsdk:"to_commit"`
+	Changed      map[string]types.Bool `tfsdk:"changed"`
+	ChangedPaths []types.String        `tfsdk:"changed_paths"`
+	Files        []types.String        `tfsdk:"files"`
+	HasChanges   types.Bool            `tfsdk:"has_changes"`
+}
+
+func (d *GitChangedPaths) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_changed_paths"
+}
+
+func (d *GitChangedPaths) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Changed Paths data source, reports which of a set of paths changed between two references, " +
+			"ie. which stacks of a monorepo need to be rolled out",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare from, a branch, tag or commit",
+				Required:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare to, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "Path prefixes or globs to check (ie. `stacks/network` or `stacks/*/main.tf`), " +
+					"a prefix matches every file below it",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"from_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `from_ref` resolved to",
+				Computed:            true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"changed": schema.MapAttribute{
+				MarkdownDescription: "Map of each entry of `paths` to whether or not a file matching it changed",
+				ElementType:         types.BoolType,
+				Computed:            true,
+			},
+			"changed_paths": schema.ListAttribute{
+				MarkdownDescription: "Entries of `paths` a changed file matches, in the order of `paths`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"files": schema.ListAttribute{
+				MarkdownDescription: "Changed files matching any entry of `paths`, sorted, renames list both the old and new path",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"has_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not any file matching `paths` changed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitChangedPaths) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitChangedPaths) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitChangedPathsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	from, err := resolveCommit(repo, data.FromRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	changes, err := diffCommits(ctx, from, to)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to diff references", err.Error())
+		return
+	}
+
+	patterns := toStrings(data.Paths)
+	changed := map[string]bool{}
+	files := map[string]bool{}
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			if name == "" {
+				continue
+			}
+
+			for _, pattern := range patterns {
+				if gitutils.MatchPath(pattern, name) {
+					changed[pattern] = true
+					files[name] = true
+				}
+			}
+		}
+	}
+
+	data.Changed = map[string]types.Bool{}
+	data.ChangedPaths = []types.String{}
+	for _, pattern := range patterns {
+		tflog.Trace(ctx, fmt.Sprintf("path: %s changed: %t", pattern, changed[pattern]))
+
+		data.Changed[pattern] = types.BoolValue(changed[pattern])
+		if changed[pattern] {
+			data.ChangedPaths = append(data.ChangedPaths, types.StringValue(pattern))
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data.Files = []types.String{}
+	for _, name := range names {
+		data.Files = append(data.Files, types.StringValue(name))
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), from.Hash.String(), to.Hash.String()))
+	data.FromCommit = types.StringValue(from.Hash.String())
+	data.ToCommit = types.StringValue(to.Hash.String())
+	data.HasChanges = types.BoolValue(len(data.ChangedPaths) > 0)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitChangedPathsDataSourceConfig(path string, fromRef string) string {
+	return fmt.Sprintf(`
+data "git_changed_paths" "test" {
+  path     = %[1]q
+  from_ref = %[2]q
+  paths    = ["stacks/network", "stacks/app", "stacks/*/versions.tf"]
+}
+`, path, fromRef)
+}
+
+func TestAccGitChangedPathsDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	for _, stack := range []string{"network", "app"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "stacks", stack), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "stacks", stack, "main.tf"), []byte("# "+stack), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "stacks", stack, "versions.tf"), []byte("# versions"), 0644))
+	}
+	_, err = testCommitAll(tempDir, "stacks")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "stacks", "network", "main.tf"), []byte("# network changed"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("changed"), 0644))
+	_, err = testCommitAll(tempDir, "network")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitChangedPathsDataSourceConfig(tempDir, "HEAD~1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "changed.stacks/network", "true"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "changed.stacks/app", "false"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "changed.stacks/*/versions.tf", "false"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "changed_paths.#", "1"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "changed_paths.0", "stacks/network"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "files.0", "stacks/network/main.tf"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "has_changes", "true"),
+				),
+			},
+			{
+				Config: testAccGitChangedPathsDataSourceConfig(tempDir, "HEAD~2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "changed_paths.#", "3"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "files.#", "4"),
+				),
+			},
+			{
+				Config: testAccGitChangedPathsDataSourceConfig(tempDir, "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "has_changes", "false"),
+					resource.TestCheckResourceAttr("data.git_changed_paths.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCheckIgnore{}
+
+func NewGitCheckIgnore() datasource.DataSource {
+	return &GitCheckIgnore{}
+}
+
+// GitCheckIgnore defines the data source implementation.
+type GitCheckIgnore struct {
+	provider *GitProviderData
+}
+
+// GitCheckIgnoreModel describes the data source data model.
+type GitCheckIgnoreModel struct {
+	Id         types.String                    `tfsdk:"id"`
+	Path       types.String                    `tfsdk:"path"`
+	Paths      []types.String                  `tfsdk:"paths"`
+	Results    map[string]GitCheckIgnoreResult `tfsdk:"results"`
+	Ignored    []types.String                  `tfsdk:"ignored"`
+	AllIgnored types.Bool                      `tfsdk:"all_ignored"`
+}
+
+// GitCheckIgnoreResult describes the outcome for a single path.
+type GitCheckIgnoreResult struct {
+	Ignored types.Bool   `tfsdk:"ignored"`
+	Source  types.String `tfsdk:"source"`
+	Line    types.Int64  `tfsdk:"line"`
+	Pattern types.String `tfsdk:"pattern"`
+}
+
+func (d *GitCheckIgnore) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_ignore"
+}
+
+func (d *GitCheckIgnore) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Check Ignore data source, checks whether paths are ignored by the `.gitignore` files " +
+			"and `.git/info/exclude` of the repository like `git check-ignore --verbose`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "Paths to check, relative to the root of the repository, they do not need to exist. " +
+					"A trailing `/` checks the path as a directory, as do existing directories",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"results": schema.MapNestedAttribute{
+				MarkdownDescription: "Outcome of the check, keyed by path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ignored": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the path is ignored",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "File of the rule deciding the outcome, relative to the root of the repository, empty when no rule matches",
+							Computed:            true,
+						},
+						"line": schema.Int64Attribute{
+							MarkdownDescription: "Line number of the rule in `source`, 0 when no rule matches",
+							Computed:            true,
+						},
+						"pattern": schema.StringAttribute{
+							MarkdownDescription: "Rule deciding the outcome, a negated rule (`!pattern`) when the path is explicitly not ignored",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ignored": schema.ListAttribute{
+				MarkdownDescription: "Paths that are ignored, in the order of `paths`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"all_ignored": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not every path is ignored",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitCheckIgnore) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCheckIgnore) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCheckIgnoreModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	rules, err := gitutils.ReadIgnoreRules(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read ignore rules", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("ignore rules: %d", len(rules)))
+
+	data.Results = map[string]GitCheckIgnoreResult{}
+	data.Ignored = []types.String{}
+	data.AllIgnored = types.BoolValue(true)
+
+	for _, p := range toStrings(data.Paths) {
+		name := filepath.ToSlash(p)
+		if name == "" || strings.HasPrefix(name, "/") || name == ".." || strings.HasPrefix(name, "../") {
+			resp.Diagnostics.AddAttributeError(path.Root("paths"), "invalid path", fmt.Sprintf("%q is not relative to the root of the repository", p))
+			return
+		}
+
+		isDir := strings.HasSuffix(name, "/")
+		if info, err := os.Stat(filepath.Join(data.Path.ValueString(), filepath.FromSlash(name))); err == nil && info.IsDir() {
+			isDir = true
+		}
+
+		ignored, rule := gitutils.CheckIgnore(rules, name, isDir)
+
+		result := GitCheckIgnoreResult{
+			Ignored: types.BoolValue(ignored),
+			Source:  types.StringValue(""),
+			Line:    types.Int64Value(0),
+			Pattern: types.StringValue(""),
+		}
+		if rule != nil {
+			tflog.Trace(ctx, fmt.Sprintf("path: %s ignored: %t rule: %s:%d:%s", name, ignored, rule.Source, rule.Line, rule.Pattern))
+
+			result.Source = types.StringValue(rule.Source)
+			result.Line = types.Int64Value(int64(rule.Line))
+			result.Pattern = types.StringValue(rule.Pattern)
+		}
+
+		data.Results[p] = result
+		if ignored {
+			data.Ignored = append(data.Ignored, types.StringValue(p))
+		} else {
+			data.AllIgnored = types.BoolValue(false)
+		}
+	}
+
+	data.Id = types.StringValue(data.Path.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCheckIgnoreDataSourceConfig(path string) string {
+	return fmt.Sprintf(`
+data "git_check_ignore" "test" {
+  path  = %[1]q
+  paths = [
+    "secrets/prod.tfvars",
+    "public.tfvars",
+    "build/output/app",
+    "docs/draft.md",
+    "draft.md",
+    "local.txt",
+  ]
+}
+
+data "git_check_ignore" "all" {
+  path  = %[1]q
+  paths = ["secrets/prod.tfvars", "local.txt"]
+}
+`, path)
+}
+
+func TestAccGitCheckIgnoreDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".gitignore"), []byte("# generated\n*.tfvars\n!public.tfvars\nbuild/\n"), 0644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "docs"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "docs", ".gitignore"), []byte("draft.md\n"), 0644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, ".git", "info"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".git", "info", "exclude"), []byte("local.txt\n"), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCheckIgnoreDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.secrets/prod.tfvars.ignored", "true"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.secrets/prod.tfvars.source", ".gitignore"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.secrets/prod.tfvars.line", "2"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.secrets/prod.tfvars.pattern", "*.tfvars"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.public.tfvars.ignored", "false"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.public.tfvars.pattern", "!public.tfvars"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.build/output/app.ignored", "true"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.build/output/app.pattern", "build/"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.docs/draft.md.source", "docs/.gitignore"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.draft.md.ignored", "false"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.draft.md.source", ""),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "results.local.txt.source", ".git/info/exclude"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "ignored.#", "4"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.test", "all_ignored", "false"),
+					resource.TestCheckResourceAttr("data.git_check_ignore.all", "all_ignored", "true"),
+				),
+			},
+		},
+	})
+}
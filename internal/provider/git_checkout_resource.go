@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitCheckout{}
+var _ resource.ResourceWithImportState = &GitCheckout{}
+var _ resource.ResourceWithModifyPlan = &GitCheckout{}
+
+func NewGitCheckout() resource.Resource {
+	return &GitCheckout{}
+}
+
+// GitCheckout defines the resource implementation.
+type GitCheckout struct {
+	provider *GitProviderData
+}
+
+// GitCheckoutModel describes the resource data model.
+type GitCheckoutModel struct {
+	Id        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Serialize types.Bool   `tfsdk:"serialize"`
+	Reference types.String `tfsdk:"ref"`
+	Remote    types.String `tfsdk:"remote"`
+	Fetch     types.Bool   `tfsdk:"fetch"`
+	Force     types.Bool   `tfsdk:"force"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	Branch    types.String `tfsdk:"branch"`
+	Commit    types.String `tfsdk:"commit"`
+}
+
+func (r *GitCheckout) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout"
+}
+
+func (r *GitCheckout) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Checkout resource, keeps the working tree of an existing clone checked out at a branch, tag " +
+			"or commit, fetching from `remote` when the reference cannot be resolved locally. A HEAD moved outside of " +
+			"Terraform is reported as drift and checked out again on the next apply. With `fetch`, a local branch is fast " +
+			"forwarded to its `remote` branch and the checkout fails when they have diverged. Destroying the resource " +
+			"leaves the working tree as it is",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to check out, a branch, tag or commit. Branches are checked out as such, " +
+					"created from the `remote` branch of the same name when missing locally, anything else detaches HEAD",
+				Required: true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote to fetch from (default: origin)",
+				Optional:            true,
+			},
+			"fetch": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to always fetch from `remote` before checking out, otherwise only " +
+					"references that cannot be resolved locally are fetched (default: false)",
+				Optional: true,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to discard local changes to tracked files, otherwise the checkout " +
+					"fails when the working tree has any (default: false)",
+				Optional: true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch checked out, empty when HEAD is detached",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit checked out",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GitCheckout) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitCheckout) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying or creating.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data GitCheckoutModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Commit.IsUnknown() || data.Branch.IsUnknown() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	remote := data.Remote.ValueString()
+	if remote == "" {
+		remote = "origin"
+	}
+
+	// The state holds what the last refresh found checked out, which is
+	// checked out again when it is no longer what ref resolves to.
+	branch, hash, ok := checkoutTarget(repo, data.Reference.ValueString(), remote)
+	if !ok || branch != data.Branch.ValueString() || hash.String() != data.Commit.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("branch"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("commit"), types.StringUnknown())...)
+	}
+}
+
+func (r *GitCheckout) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitCheckoutModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(repoPath)
+
+	resp.Diagnostics.Append(r.checkout(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitCheckout) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitCheckoutModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		tflog.Warn(ctx, fmt.Sprintf("repository %s no longer exists, removing it from state", data.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read HEAD", err.Error())
+		return
+	}
+
+	branch := ""
+	if head.Name().IsBranch() {
+		branch = head.Name().Short()
+	}
+
+	// Imported resources adopt whatever is checked out.
+	if data.Reference.IsNull() {
+		data.Reference = types.StringValue(branch)
+		if branch == "" {
+			data.Reference = types.StringValue(head.Hash().String())
+		}
+	}
+
+	// A HEAD moved outside of Terraform is planned to be checked out again.
+	if head.Hash().String() != data.Commit.ValueString() || branch != data.Branch.ValueString() {
+		tflog.Debug(ctx, fmt.Sprintf("HEAD of %s moved to %s", data.Path.ValueString(), head.Hash().String()))
+	}
+	data.Branch = types.StringValue(branch)
+	data.Commit = types.StringValue(head.Hash().String())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitCheckout) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitCheckoutModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkout(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitCheckout) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The working tree is left checked out where it is.
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *GitCheckout) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("path"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// checkout checks out the reference of data, setting the branch and commit
+// checked out.
+func (r *GitCheckout) checkout(ctx context.Context, data *GitCheckoutModel) (diags diag.Diagnostics) {
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		diags.AddError("unable to open git repository", err.Error())
+		return diags
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		diags.AddError("unable to lock repository", err.Error())
+		return diags
+	}
+	defer unlock()
+
+	remote := data.Remote.ValueString()
+	if remote == "" {
+		remote = "origin"
+	}
+
+	fetched := false
+	fetch := func() error {
+		fetched = true
+		tflog.Trace(ctx, fmt.Sprintf("fetching %s", remote))
+		err := repo.FetchContext(ctx, &git.FetchOptions{
+			RemoteName: remote,
+			Auth:       r.provider.repositoryAuth(data.Path, data.Username, data.Password),
+			Tags:       git.AllTags,
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return err
+		}
+		return nil
+	}
+
+	if data.Fetch.ValueBool() {
+		if err := fetch(); err != nil {
+			diags.AddAttributeError(path.Root("remote"), "unable to fetch", err.Error())
+			return diags
+		}
+	}
+
+	opts, err := checkoutOptions(repo, data.Reference.ValueString(), remote)
+	if err != nil && !fetched {
+		if _, remoteErr := repo.Remote(remote); remoteErr == nil {
+			if err := fetch(); err != nil {
+				diags.AddAttributeError(path.Root("remote"), "unable to fetch", err.Error())
+				return diags
+			}
+			opts, err = checkoutOptions(repo, data.Reference.ValueString(), remote)
+		}
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return diags
+	}
+	opts.Force = data.Force.ValueBool()
+
+	if fetched && opts.Branch.IsBranch() && !opts.Create {
+		if !opts.Force {
+			if err := checkCleanBranch(repo, opts.Branch.Short()); err != nil {
+				diags.AddError("unable to check out reference", err.Error())
+				return diags
+			}
+		}
+		if err := fastForwardBranch(repo, opts.Branch, plumbing.NewRemoteReferenceName(remote, opts.Branch.Short())); err != nil {
+			diags.AddAttributeError(path.Root("ref"), "unable to fast forward branch", err.Error())
+			return diags
+		}
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		diags.AddError("unable to open worktree", err.Error())
+		return diags
+	}
+
+	if err := wt.Checkout(opts); err != nil {
+		diags.AddError("unable to check out reference", err.Error())
+		return diags
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		diags.AddError("unable to read HEAD", err.Error())
+		return diags
+	}
+
+	data.Branch = types.StringValue("")
+	if head.Name().IsBranch() {
+		data.Branch = types.StringValue(head.Name().Short())
+	}
+	data.Commit = types.StringValue(head.Hash().String())
+
+	tflog.Trace(ctx, fmt.Sprintf("checked out %s at %s", data.Reference.ValueString(), head.Hash().String()))
+
+	return diags
+}
+
+// checkoutOptions returns the options checking out rev: a local branch, a new
+// branch tracking the remote branch of the same name, or a detached commit.
+func checkoutOptions(repo *git.Repository, rev string, remote string) (*git.CheckoutOptions, error) {
+	branch := plumbing.NewBranchReferenceName(rev)
+	if _, err := repo.Reference(branch, true); err == nil {
+		return &git.CheckoutOptions{Branch: branch}, nil
+	}
+
+	if ref, err := repo.Reference(plumbing.NewRemoteReferenceName(remote, rev), true); err == nil {
+		if err := repo.CreateBranch(&config.Branch{
+			Name:   rev,
+			Remote: remote,
+			Merge:  branch,
+		}); err != nil && !errors.Is(err, git.ErrBranchExists) {
+			return nil, err
+		}
+		return &git.CheckoutOptions{Branch: branch, Hash: ref.Hash(), Create: true}, nil
+	}
+
+	commit, err := resolveCommit(repo, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	return &git.CheckoutOptions{Hash: commit.Hash}, nil
+}
+
+// checkoutTarget returns the branch and commit checkoutOptions checks out for
+// rev without fetching or creating anything, false when rev can not be
+// resolved locally.
+func checkoutTarget(repo *git.Repository, rev string, remote string) (string, plumbing.Hash, bool) {
+	if ref, err := repo.Reference(plumbing.NewBranchReferenceName(rev), true); err == nil {
+		return rev, ref.Hash(), true
+	}
+	if ref, err := repo.Reference(plumbing.NewRemoteReferenceName(remote, rev), true); err == nil {
+		return rev, ref.Hash(), true
+	}
+
+	commit, err := resolveCommit(repo, rev)
+	if err != nil {
+		return "", plumbing.ZeroHash, false
+	}
+
+	return "", commit.Hash, true
+}
+
+// fastForwardBranch moves branch to the commit of its remote tracking
+// reference when branch is behind it, an error when they have diverged.
+func fastForwardBranch(repo *git.Repository, branch plumbing.ReferenceName, tracking plumbing.ReferenceName) error {
+	local, err := repo.Reference(branch, true)
+	if err != nil {
+		return err
+	}
+	remote, err := repo.Reference(tracking, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	behind, err := notContains(repo, local.Hash().String(), remote.Hash().String())
+	if err != nil || !behind {
+		return err
+	}
+	diverged, err := notContains(repo, remote.Hash().String(), local.Hash().String())
+	if err != nil {
+		return err
+	}
+	if diverged {
+		return fmt.Errorf("%s has diverged from %s", branch.Short(), tracking.Short())
+	}
+
+	return repo.Storer.SetReference(plumbing.NewHashReference(branch, remote.Hash()))
+}
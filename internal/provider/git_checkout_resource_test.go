@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitCheckoutResourceConfig(path string, ref string) string {
+	return fmt.Sprintf(`
+resource "git_checkout" "test" {
+  path = %[1]q
+  ref  = %[2]q
+}
+`, path, ref)
+}
+
+func testAccGitCheckoutResourceConfigFetch(path string, ref string, remote string) string {
+	return fmt.Sprintf(`
+resource "git_checkout" "test" {
+  path   = %[1]q
+  ref    = %[2]q
+  remote = %[3]q
+  fetch  = true
+}
+`, path, ref, remote)
+}
+
+func TestAccGitCheckoutResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	head, err := testSetupGit(tempDir, "v1.0.0", 2)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	first, err := resolveCommit(repo, "v1.0.0")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitCheckoutResourceConfig(tempDir, "missing"),
+				ExpectError: regexp.MustCompile("unable to resolve reference"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitCheckoutResourceConfig(tempDir, "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "commit", first.Hash.String()),
+					resource.TestCheckResourceAttr("git_checkout.test", "branch", ""),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitCheckoutResourceConfig(tempDir, "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("git_checkout.test", "branch", "master"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "git_checkout.test",
+				ImportState:             true,
+				ImportStateId:           tempDir,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, testCheckoutBranch(tempDir, "other", first.Hash))
+				},
+				Config: testAccGitCheckoutResourceConfig(tempDir, "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("git_checkout.test", "branch", "master"),
+					testCheckHead(tempDir, "refs/heads/master"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitCheckoutResource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	originDir := filepath.Join(tempDir, "origin")
+	cloneDir := filepath.Join(tempDir, "clone")
+
+	_, err = testSetupGit(originDir, "", 0)
+	assert.NoError(t, err)
+
+	_, err = git.PlainClone(cloneDir, false, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(originDir, "feature", plumbing.ZeroHash))
+	assert.NoError(t, os.WriteFile(filepath.Join(originDir, "feature.txt"), []byte("feature"), 0644))
+	feature, err := testCommitAll(originDir, "feature")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitCheckoutResourceConfig(cloneDir, "feature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "commit", feature.String()),
+					resource.TestCheckResourceAttr("git_checkout.test", "branch", "feature"),
+					testCheckHead(cloneDir, "refs/heads/feature"),
+				),
+			},
+			// Fast forward testing
+			{
+				PreConfig: func() {
+					feature, err = testCommitFile(originDir, "feature.txt", "feature 2")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitCheckoutResourceConfigFetch(cloneDir, "feature", "origin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("git_checkout.test", "commit", feature.String())(s)
+					},
+					testCheckWorktreeFile(cloneDir, "feature.txt", "feature 2"),
+				),
+			},
+			// Diverged testing
+			{
+				PreConfig: func() {
+					_, err = testCommitFile(originDir, "feature.txt", "feature 3")
+					assert.NoError(t, err)
+					_, err = testCommitFile(cloneDir, "local.txt", "local")
+					assert.NoError(t, err)
+					clone, err := git.PlainOpen(cloneDir)
+					assert.NoError(t, err)
+					_, err = clone.CreateRemote(&config.RemoteConfig{Name: "upstream", URLs: []string{originDir}})
+					assert.NoError(t, err)
+				},
+				Config:      testAccGitCheckoutResourceConfigFetch(cloneDir, "feature", "upstream"),
+				ExpectError: regexp.MustCompile("feature has diverged from upstream/feature"),
+			},
+		},
+	})
+}
+
+// testCheckHead checks HEAD of the repository at path points at the branch
+// name, which may not have any commit yet.
+func testCheckHead(path string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		head, err := repo.Reference(plumbing.HEAD, false)
+		if err != nil {
+			return err
+		}
+
+		if head.Target().String() != name {
+			return fmt.Errorf("expected HEAD to be %s, got %s", name, head.Target())
+		}
+
+		return nil
+	}
+}
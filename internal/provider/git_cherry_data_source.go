@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCherry{}
+
+func NewGitCherry() datasource.DataSource {
+	return &GitCherry{}
+}
+
+// GitCherry defines the data source implementation.
+type GitCherry struct {
+	provider *GitProviderData
+}
+
+// GitCherryModel describes the data source data model.
+type GitCherryModel struct {
+	Id             types.String           `tfsdk:"id"`
+	Path           types.String           `tfsdk:"path"`
+	Upstream       types.String           `tfsdk:"upstream"`
+	Head           types.String           `tfsdk:"head"`
+	UpstreamCommit types.String           `tfsdk:"upstream_commit"`
+	HeadCommit     types.String           `tfsdk:"head_commit"`
+	Commits        []GitCherryCommitModel `tfsdk:"commits"`
+	Unmerged       []types.String         `tfsdk:"unmerged"`
+	UnmergedCount  types.Int64            `tfsdk:"unmerged_count"`
+}
+
+// GitCherryCommitModel describes a commit of head missing from upstream.
+type GitCherryCommitModel struct {
+	Commit           types.String `tfsdk:"commit"`
+	Summary          types.String `tfsdk:"summary"`
+	InUpstream       types.Bool   `tfsdk:"in_upstream"`
+	EquivalentCommit types.String `tfsdk:"equivalent_commit"`
+}
+
+func (d *GitCherry) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cherry"
+}
+
+func (d *GitCherry) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Cherry data source, finds the commits of `head` whose changes are not in `upstream` like " +
+			"`git cherry upstream head`. Commits of `upstream..head` are matched by patch id against the commits of " +
+			"`head..upstream` so cherry-picked commits count as merged, merge commits are ignored",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"upstream": schema.StringAttribute{
+				MarkdownDescription: "Reference the changes are looked for in, a branch, tag or commit (ie. `release/1.x`)",
+				Required:            true,
+			},
+			"head": schema.StringAttribute{
+				MarkdownDescription: "Reference whose changes are looked for, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"upstream_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `upstream` resolved to",
+				Computed:            true,
+			},
+			"head_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `head` resolved to",
+				Computed:            true,
+			},
+			"commits": schema.ListNestedAttribute{
+				MarkdownDescription: "Commits of `upstream..head`, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"summary": schema.StringAttribute{
+							MarkdownDescription: "First line of the commit message",
+							Computed:            true,
+						},
+						"in_upstream": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not an equivalent change is in `upstream` (`-` in `git cherry`)",
+							Computed:            true,
+						},
+						"equivalent_commit": schema.StringAttribute{
+							MarkdownDescription: "Commit of `upstream` with the same changes, empty when there is none",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"unmerged": schema.ListAttribute{
+				MarkdownDescription: "Hashes of the commits without an equivalent in `upstream` (`+` in `git cherry`), oldest first",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"unmerged_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits without an equivalent in `upstream`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitCherry) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCherry) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCherryModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Head.ValueString() == "" {
+		data.Head = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	upstream, err := resolveCommit(repo, data.Upstream.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("upstream"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	head, err := resolveCommit(repo, data.Head.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("head"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	upstreamPatches := map[plumbing.Hash]plumbing.Hash{}
+	if err := walkRange(head, upstream, func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return nil
+		}
+
+		id, err := gitutils.PatchID(ctx, c)
+		if err != nil {
+			return err
+		}
+		upstreamPatches[id] = c.Hash
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk upstream commits", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("upstream patches: %d", len(upstreamPatches)))
+
+	var commits []*object.Commit
+	if err := walkRange(upstream, head, func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return nil
+		}
+		commits = append(commits, c)
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk head commits", err.Error())
+		return
+	}
+
+	data.Commits = []GitCherryCommitModel{}
+	data.Unmerged = []types.String{}
+
+	// Commits are walked newest first, git cherry lists them oldest first.
+	for i := len(commits) - 1; i >= 0; i-- {
+		c := commits[i]
+
+		id, err := gitutils.PatchID(ctx, c)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to compute patch id", err.Error())
+			return
+		}
+
+		equivalent, inUpstream := upstreamPatches[id]
+
+		tflog.Trace(ctx, fmt.Sprintf("commit: %s patch_id: %s in_upstream: %t", c.Hash.String(), id.String(), inUpstream))
+
+		model := GitCherryCommitModel{
+			Commit:           types.StringValue(c.Hash.String()),
+			Summary:          types.StringValue(strings.SplitN(strings.TrimSpace(c.Message), "\n", 2)[0]),
+			InUpstream:       types.BoolValue(inUpstream),
+			EquivalentCommit: types.StringValue(""),
+		}
+		if inUpstream {
+			model.EquivalentCommit = types.StringValue(equivalent.String())
+		} else {
+			data.Unmerged = append(data.Unmerged, model.Commit)
+		}
+
+		data.Commits = append(data.Commits, model)
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s...%s", data.Path.ValueString(), upstream.Hash.String(), head.Hash.String()))
+	data.UpstreamCommit = types.StringValue(upstream.Hash.String())
+	data.HeadCommit = types.StringValue(head.Hash.String())
+	data.UnmergedCount = types.Int64Value(int64(len(data.Unmerged)))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
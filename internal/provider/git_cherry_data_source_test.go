@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCherryDataSourceConfig(path string, upstream string, head string) string {
+	return fmt.Sprintf(`
+data "git_cherry" "test" {
+  path     = %[1]q
+  upstream = %[2]q
+  head     = %[3]q
+}
+`, path, upstream, head)
+}
+
+func TestAccGitCherryDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "fix.txt"), []byte("context\nfix\n"), 0644))
+	fix, err := testCommitAll(tempDir, "fix bug")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.txt"), []byte("feature\n"), 0644))
+	feature, err := testCommitAll(tempDir, "add feature")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(tempDir, "release", *base))
+
+	// The back-port differs in whitespace and message only.
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "fix.txt"), []byte("context\n  fix\n"), 0644))
+	picked, err := testCommitAll(tempDir, "fix bug (cherry picked)")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "release.txt"), []byte("release\n"), 0644))
+	_, err = testCommitAll(tempDir, "release only")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCherryDataSourceConfig(tempDir, "release", "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_cherry.test", "head_commit", feature.String()),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.0.commit", fix.String()),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.0.summary", "fix bug"),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.0.in_upstream", "true"),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.0.equivalent_commit", picked.String()),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.1.commit", feature.String()),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.1.in_upstream", "false"),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.1.equivalent_commit", ""),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "unmerged.#", "1"),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "unmerged.0", feature.String()),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "unmerged_count", "1"),
+				),
+			},
+			{
+				Config: testAccGitCherryDataSourceConfig(tempDir, "master", "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_cherry.test", "commits.#", "0"),
+					resource.TestCheckResourceAttr("data.git_cherry.test", "unmerged_count", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCI{}
+
+func NewGitCI() datasource.DataSource {
+	return &GitCI{}
+}
+
+// GitCI defines the data source implementation.
+type GitCI struct {
+	provider *GitProviderData
+}
+
+// GitCIModel describes the data source data model.
+type GitCIModel struct {
+	Id            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	IsCI          types.Bool   `tfsdk:"is_ci"`
+	System        types.String `tfsdk:"system"`
+	RunID         types.String `tfsdk:"run_id"`
+	Actor         types.String `tfsdk:"actor"`
+	Repository    types.String `tfsdk:"repository"`
+	Commit        types.String `tfsdk:"commit"`
+	Reference     types.String `tfsdk:"ref"`
+	IsPullRequest types.Bool   `tfsdk:"is_pull_request"`
+	PullRequest   types.Int64  `tfsdk:"pull_request"`
+	BaseRef       types.String `tfsdk:"base_ref"`
+	HeadRef       types.String `tfsdk:"head_ref"`
+	IsShallow     types.Bool   `tfsdk:"is_shallow"`
+}
+
+func (d *GitCI) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ci"
+}
+
+func (d *GitCI) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git CI data source, normalizes the environment variables of common CI systems (GitHub Actions, " +
+			"GitLab CI, CircleCI, Buildkite, Azure Pipelines and Jenkins) along with whether the checkout is shallow. " +
+			"Values a system does not expose are empty",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"is_ci": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not Terraform runs in CI",
+				Computed:            true,
+			},
+			"system": schema.StringAttribute{
+				MarkdownDescription: "CI system, one of `github_actions`, `gitlab_ci`, `circleci`, `buildkite`, `azure_pipelines`, " +
+					"`jenkins`, `generic` for other systems setting `CI=true`, empty outside of CI",
+				Computed: true,
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the run (ie. `GITHUB_RUN_ID` or `CI_PIPELINE_ID`)",
+				Computed:            true,
+			},
+			"actor": schema.StringAttribute{
+				MarkdownDescription: "User that triggered the run",
+				Computed:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository being built as the CI system names it (ie. `owner/name`)",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit being built",
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Full name of the reference being built (ie. `refs/heads/main` or `refs/pull/12/merge`)",
+				Computed:            true,
+			},
+			"is_pull_request": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the run builds a pull or merge request",
+				Computed:            true,
+			},
+			"pull_request": schema.Int64Attribute{
+				MarkdownDescription: "Number of the pull or merge request, 0 when `is_pull_request` is false",
+				Computed:            true,
+			},
+			"base_ref": schema.StringAttribute{
+				MarkdownDescription: "Branch the pull request targets (ie. `main`)",
+				Computed:            true,
+			},
+			"head_ref": schema.StringAttribute{
+				MarkdownDescription: "Branch the pull request comes from",
+				Computed:            true,
+			},
+			"is_shallow": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the repository is a shallow clone, as CI systems often clone with " +
+					"`--depth`, which hides tags and history from the other data sources",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *GitCI) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCI) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCIModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	shallow, err := repo.Storer.Shallow()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read shallow commits", err.Error())
+		return
+	}
+
+	env := gitutils.DetectCI(os.Getenv)
+
+	tflog.Trace(ctx, fmt.Sprintf("ci: %s run: %s shallow commits: %d", env.System, env.RunID, len(shallow)))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.Path.ValueString(), env.System, env.RunID))
+	data.IsCI = types.BoolValue(env.System != "")
+	data.System = types.StringValue(env.System)
+	data.RunID = types.StringValue(env.RunID)
+	data.Actor = types.StringValue(env.Actor)
+	data.Repository = types.StringValue(env.Repository)
+	data.Commit = types.StringValue(env.Commit)
+	data.Reference = types.StringValue(env.Ref)
+	data.IsPullRequest = types.BoolValue(env.PullRequest != 0)
+	data.PullRequest = types.Int64Value(env.PullRequest)
+	data.BaseRef = types.StringValue(env.BaseRef)
+	data.HeadRef = types.StringValue(env.HeadRef)
+	data.IsShallow = types.BoolValue(len(shallow) > 0)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
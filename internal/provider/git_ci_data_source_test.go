@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCIDataSourceConfig(path string) string {
+	return fmt.Sprintf(`
+data "git_ci" "test" {
+  path = %[1]q
+}
+`, path)
+}
+
+// testClearCI unsets the variables CI systems are detected by so the tests
+// behave the same when they run in CI.
+func testClearCI(t *testing.T) {
+	for _, name := range []string{"GITHUB_ACTIONS", "GITLAB_CI", "CIRCLECI", "BUILDKITE", "TF_BUILD", "JENKINS_URL", "CI"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestAccGitCIDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	testClearCI(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCIDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_ci.test", "is_ci", "false"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "system", ""),
+					resource.TestCheckResourceAttr("data.git_ci.test", "is_pull_request", "false"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "pull_request", "0"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "is_shallow", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitCIDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetShallow([]plumbing.Hash{*hash}))
+
+	testClearCI(t)
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_RUN_ID", "1234")
+	t.Setenv("GITHUB_ACTOR", "octocat")
+	t.Setenv("GITHUB_REPOSITORY", "ekristen/terraform-provider-git")
+	t.Setenv("GITHUB_SHA", hash.String())
+	t.Setenv("GITHUB_REF", "refs/pull/42/merge")
+	t.Setenv("GITHUB_BASE_REF", "main")
+	t.Setenv("GITHUB_HEAD_REF", "feature")
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCIDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_ci.test", "is_ci", "true"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "system", "github_actions"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "run_id", "1234"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "actor", "octocat"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "repository", "ekristen/terraform-provider-git"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_ci.test", "ref", "refs/pull/42/merge"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "is_pull_request", "true"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "pull_request", "42"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "base_ref", "main"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "head_ref", "feature"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "is_shallow", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitCIDataSource3(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	testClearCI(t)
+	t.Setenv("GITLAB_CI", "true")
+	t.Setenv("CI_PIPELINE_ID", "99")
+	t.Setenv("CI_MERGE_REQUEST_IID", "7")
+	t.Setenv("CI_MERGE_REQUEST_TARGET_BRANCH_NAME", "main")
+	t.Setenv("CI_MERGE_REQUEST_SOURCE_BRANCH_NAME", "feature")
+	t.Setenv("CI_COMMIT_BRANCH", "")
+	t.Setenv("CI_COMMIT_TAG", "")
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCIDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_ci.test", "system", "gitlab_ci"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "run_id", "99"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "ref", "refs/heads/feature"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "pull_request", "7"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "base_ref", "main"),
+					resource.TestCheckResourceAttr("data.git_ci.test", "head_ref", "feature"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCommitCount{}
+
+func NewGitCommitCount() datasource.DataSource {
+	return &GitCommitCount{}
+}
+
+// GitCommitCount defines the data source implementation.
+type GitCommitCount struct {
+	provider *GitProviderData
+}
+
+// GitCommitCountModel describes the data source data model.
+type GitCommitCountModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Path         types.String   `tfsdk:"path"`
+	FromRef      types.String   `tfsdk:"from_ref"`
+	ToRef        types.String   `tfsdk:"to_ref"`
+	IncludePaths []types.String `tfsdk:"include_paths"`
+	ExcludePaths []types.String `tfsdk:"exclude_paths"`
+	FirstParent  types.Bool     `tfsdk:"first_parent"`
+	FromCommit   types.String   `tfsdk:"from_commit"`
+	ToCommit     types.String   `tfsdk:"to_commit"`
+	CommitCount  types.Int64    `tfsdk:"commit_count"`
+}
+
+func (d *GitCommitCount) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit_count"
+}
+
+func (d *GitCommitCount) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Commit Count data source, counts the commits in a range (`from_ref..to_ref`) " +
+			"like `git rev-list --count`, ie. for build numbers",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, a branch, tag or commit (default: the whole history)",
+				Optional:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Include commits reachable from this reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"include_paths": schema.ListAttribute{
+				MarkdownDescription: "Only count commits touching at least one of these paths or globs (ie. `services/api/**`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"exclude_paths": schema.ListAttribute{
+				MarkdownDescription: "Do not count commits that only touch these paths or globs (ie. `docs/**`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"first_parent": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to only follow the first parent of merge commits (`--first-parent`, default: false)",
+				Optional:            true,
+			},
+			"from_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `from_ref` resolved to, empty when omitted",
+				Computed:            true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits in the range",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitCommitCount) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCommitCount) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCommitCountModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var from *object.Commit
+	data.FromCommit = types.StringValue("")
+	if data.FromRef.ValueString() != "" {
+		from, err = resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+		data.FromCommit = types.StringValue(from.Hash.String())
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	include := toStrings(data.IncludePaths)
+	exclude := toStrings(data.ExcludePaths)
+	filtered := len(include) > 0 || len(exclude) > 0
+
+	walk := walkRange
+	if data.FirstParent.ValueBool() {
+		walk = walkFirstParentRange
+	}
+
+	count := int64(0)
+	if err := walk(from, to, func(c *object.Commit) error {
+		if filtered {
+			touched, err := gitutils.CommitTouchesPaths(c, include, exclude)
+			if err != nil || !touched {
+				return err
+			}
+		}
+
+		count++
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("commit_count: %d", count))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromCommit.ValueString(), to.Hash.String()))
+	data.ToCommit = types.StringValue(to.Hash.String())
+	data.CommitCount = types.Int64Value(count)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
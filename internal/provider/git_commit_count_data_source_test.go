@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCommitCountDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_commit_count" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitCommitCountDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(tempDir, "feature", *base))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.tf"), []byte("# feature"), 0644))
+	feature, err := testCommitAll(tempDir, "feature")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+	assert.NoError(t, wt.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("master")}))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("# main"), 0644))
+	main, err := testCommitAll(tempDir, "main")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.tf"), []byte("# feature"), 0644))
+	_, err = wt.Add("feature.tf")
+	assert.NoError(t, err)
+	merge, err := wt.Commit("merge feature", &git.CommitOptions{
+		Parents: []plumbing.Hash{*main, *feature},
+	})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCommitCountDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "commit_count", "4"),
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "from_commit", ""),
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "to_commit", merge.String()),
+				),
+			},
+			{
+				Config: testAccGitCommitCountDataSourceConfig(tempDir, "first_parent = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "commit_count", "3"),
+				),
+			},
+			{
+				Config: testAccGitCommitCountDataSourceConfig(tempDir, `from_ref = "v1.0.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "commit_count", "3"),
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "from_commit", base.String()),
+				),
+			},
+			{
+				Config: testAccGitCommitCountDataSourceConfig(tempDir, "from_ref = \"v1.0.0\"\n  first_parent = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "commit_count", "2"),
+				),
+			},
+			{
+				Config: testAccGitCommitCountDataSourceConfig(tempDir, `include_paths = ["feature.tf"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_count.test", "commit_count", "2"),
+				),
+			},
+		},
+	})
+}
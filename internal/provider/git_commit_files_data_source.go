@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCommitFiles{}
+
+func NewGitCommitFiles() datasource.DataSource {
+	return &GitCommitFiles{}
+}
+
+// GitCommitFiles defines the data source implementation.
+type GitCommitFiles struct {
+	provider *GitProviderData
+}
+
+// GitCommitFilesModel describes the data source data model.
+type GitCommitFilesModel struct {
+	Id        types.String              `tfsdk:"id"`
+	Path      types.String              `tfsdk:"path"`
+	Reference types.String              `tfsdk:"ref"`
+	Commit    types.String              `tfsdk:"commit"`
+	Parent    types.String              `tfsdk:"parent"`
+	Files     []GitCommitFilesFileModel `tfsdk:"files"`
+}
+
+// GitCommitFilesFileModel describes a single file changed by the commit.
+type GitCommitFilesFileModel struct {
+	Path       types.String `tfsdk:"path"`
+	OldPath    types.String `tfsdk:"old_path"`
+	ChangeType types.String `tfsdk:"change_type"`
+	OldHash    types.String `tfsdk:"old_hash"`
+	NewHash    types.String `tfsdk:"new_hash"`
+	OldMode    types.String `tfsdk:"old_mode"`
+	NewMode    types.String `tfsdk:"new_mode"`
+}
+
+func (d *GitCommitFiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit_files"
+}
+
+func (d *GitCommitFiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Commit Files data source, lists the files a single commit changed like " +
+			"`git diff-tree -r -M <commit>`, compared to its first parent, or to an empty tree for a root commit",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference of the commit, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"parent": schema.StringAttribute{
+				MarkdownDescription: "First parent the commit is compared to, empty for a root commit",
+				Computed:            true,
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Files changed by the commit, sorted by path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file after the commit, or before it when deleted",
+							Computed:            true,
+						},
+						"old_path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file before the commit, empty when added",
+							Computed:            true,
+						},
+						"change_type": schema.StringAttribute{
+							MarkdownDescription: "Type of change, one of `added`, `modified`, `deleted` or `renamed`",
+							Computed:            true,
+						},
+						"old_hash": schema.StringAttribute{
+							MarkdownDescription: "Hash of the blob before the commit, empty when added",
+							Computed:            true,
+						},
+						"new_hash": schema.StringAttribute{
+							MarkdownDescription: "Hash of the blob after the commit, empty when deleted",
+							Computed:            true,
+						},
+						"old_mode": schema.StringAttribute{
+							MarkdownDescription: "Git file mode before the commit (ie. `0100644`), empty when added",
+							Computed:            true,
+						},
+						"new_mode": schema.StringAttribute{
+							MarkdownDescription: "Git file mode after the commit (ie. `0100644`), empty when deleted",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitCommitFiles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCommitFiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCommitFilesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	changes, err := firstParentChanges(ctx, commit)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to diff commit", err.Error())
+		return
+	}
+
+	data.Files = []GitCommitFilesFileModel{}
+	for _, change := range changes {
+		file, err := newGitDiffFileModel(change)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read change", err.Error())
+			return
+		}
+
+		oldHash, oldMode := changeEntryBlob(change.From)
+		newHash, newMode := changeEntryBlob(change.To)
+
+		tflog.Trace(ctx, fmt.Sprintf("change: %s %s", file.ChangeType.ValueString(), file.Path.ValueString()))
+
+		data.Files = append(data.Files, GitCommitFilesFileModel{
+			Path:       file.Path,
+			OldPath:    file.OldPath,
+			ChangeType: file.ChangeType,
+			OldHash:    types.StringValue(oldHash),
+			NewHash:    types.StringValue(newHash),
+			OldMode:    types.StringValue(oldMode),
+			NewMode:    types.StringValue(newMode),
+		})
+	}
+
+	sort.Slice(data.Files, func(i, j int) bool {
+		return data.Files[i].Path.ValueString() < data.Files[j].Path.ValueString()
+	})
+
+	data.Parent = types.StringValue("")
+	if commit.NumParents() > 0 {
+		data.Parent = types.StringValue(commit.ParentHashes[0].String())
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// changeEntryBlob returns the blob hash and mode of one side of a change,
+// empty when the file does not exist on that side.
+func changeEntryBlob(entry object.ChangeEntry) (string, string) {
+	if entry.Name == "" {
+		return "", ""
+	}
+	return entry.TreeEntry.Hash.String(), entry.TreeEntry.Mode.String()
+}
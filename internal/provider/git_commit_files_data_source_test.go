@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCommitFilesDataSourceConfig(path string, ref string) string {
+	return fmt.Sprintf(`
+data "git_commit_files" "test" {
+  path = %[1]q
+  ref  = %[2]q
+}
+`, path, ref)
+}
+
+func TestAccGitCommitFilesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	root, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "services", "api"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", "api", "main.go"), []byte("package main"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("updated"), 0644))
+	_, err = testCommitAll(tempDir, "add api")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.Remove(filepath.Join(tempDir, "README.md")))
+	head, err := testCommitAll(tempDir, "remove readme")
+	assert.NoError(t, err)
+
+	readme := "9a2c7732fab5bcd73ea3ed52d2d9599a4cc47666"
+	updated := plumbing.ComputeHash(plumbing.BlobObject, []byte("updated")).String()
+	mainGo := plumbing.ComputeHash(plumbing.BlobObject, []byte("package main")).String()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCommitFilesDataSourceConfig(tempDir, root.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "parent", ""),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.path", "README.md"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.change_type", "added"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.old_hash", ""),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.new_hash", readme),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.new_mode", "0100644"),
+				),
+			},
+			{
+				Config: testAccGitCommitFilesDataSourceConfig(tempDir, "HEAD~1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "parent", root.String()),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.#", "2"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.path", "README.md"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.change_type", "modified"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.old_hash", readme),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.new_hash", updated),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.1.path", "services/api/main.go"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.1.change_type", "added"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.1.new_hash", mainGo),
+				),
+			},
+			{
+				Config: testAccGitCommitFilesDataSourceConfig(tempDir, "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.change_type", "deleted"),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.old_hash", updated),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.new_hash", ""),
+					resource.TestCheckResourceAttr("data.git_commit_files.test", "files.0.new_mode", ""),
+				),
+			},
+		},
+	})
+}
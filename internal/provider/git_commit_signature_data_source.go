@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCommitSignature{}
+
+func NewGitCommitSignature() datasource.DataSource {
+	return &GitCommitSignature{}
+}
+
+// GitCommitSignature defines the data source implementation.
+type GitCommitSignature struct {
+	provider *GitProviderData
+}
+
+// GitCommitSignatureModel describes the data source data model.
+type GitCommitSignatureModel struct {
+	Id                types.String `tfsdk:"id"`
+	Path              types.String `tfsdk:"path"`
+	Reference         types.String `tfsdk:"ref"`
+	Keyring           types.String `tfsdk:"keyring"`
+	AllowedSigners    types.String `tfsdk:"allowed_signers"`
+	Hash              types.String `tfsdk:"hash"`
+	ObjectType        types.String `tfsdk:"object_type"`
+	Signed            types.Bool   `tfsdk:"signed"`
+	SignatureType     types.String `tfsdk:"signature_type"`
+	KeyID             types.String `tfsdk:"key_id"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	Verified          types.Bool   `tfsdk:"verified"`
+	Signer            types.String `tfsdk:"signer"`
+	VerificationError types.String `tfsdk:"verification_error"`
+}
+
+func (d *GitCommitSignature) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit_signature"
+}
+
+func (d *GitCommitSignature) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Commit Signature data source, verifies the GPG or SSH signature of a commit or annotated tag against a keyring or an allowed signers file",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to verify, an annotated tag verifies the tag itself, anything else the commit it resolves to (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"keyring": schema.StringAttribute{
+				MarkdownDescription: "ASCII armored public keys trusted for GPG signatures",
+				Optional:            true,
+			},
+			"allowed_signers": schema.StringAttribute{
+				MarkdownDescription: "Content of an SSH allowed signers file (`gpg.ssh.allowedSignersFile`) trusted for SSH signatures",
+				Optional:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the verified commit or tag",
+				Computed:            true,
+			},
+			"object_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the verified object, `commit` or `tag`",
+				Computed:            true,
+			},
+			"signed": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the object is signed",
+				Computed:            true,
+			},
+			"signature_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the signature, one of `gpg`, `ssh`, `x509` or `unknown`, empty when not signed",
+				Computed:            true,
+			},
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the signing key (long key ID for GPG, SHA256 fingerprint for SSH)",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Fingerprint of the signing key",
+				Computed:            true,
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the signature is valid and made by a key of `keyring` or `allowed_signers`",
+				Computed:            true,
+			},
+			"signer": schema.StringAttribute{
+				MarkdownDescription: "Signer of a verified signature, the email of the GPG key identity or the principals of the allowed signer",
+				Computed:            true,
+			},
+			"verification_error": schema.StringAttribute{
+				MarkdownDescription: "Why the signature could not be verified, empty when verified",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitCommitSignature) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCommitSignature) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCommitSignatureModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	var keyring openpgp.EntityList
+	if data.Keyring.ValueString() != "" {
+		var err error
+		keyring, err = openpgp.ReadArmoredKeyRing(strings.NewReader(data.Keyring.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("keyring"), "unable to read keyring", err.Error())
+			return
+		}
+	}
+
+	var signers []gitutils.AllowedSigner
+	if data.AllowedSigners.ValueString() != "" {
+		var err error
+		signers, err = gitutils.ParseAllowedSigners(data.AllowedSigners.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("allowed_signers"), "unable to read allowed signers", err.Error())
+			return
+		}
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var hash plumbing.Hash
+	var armored string
+	encoded := &plumbing.MemoryObject{}
+
+	if tag := annotatedTag(repo, data.Reference.ValueString()); tag != nil {
+		data.ObjectType = types.StringValue("tag")
+		hash, armored = tag.Hash, tag.PGPSignature
+		err = tag.EncodeWithoutSignature(encoded)
+	} else {
+		var commit *object.Commit
+		commit, err = resolveCommit(repo, data.Reference.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+			return
+		}
+		data.ObjectType = types.StringValue("commit")
+		hash, armored = commit.Hash, commit.PGPSignature
+		err = commit.EncodeWithoutSignature(encoded)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to encode object", err.Error())
+		return
+	}
+
+	// The signature covers the object without its signature
+	payload, err := readEncodedObject(encoded)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to encode object", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(hash.String())
+	data.Hash = types.StringValue(hash.String())
+	data.Signed = types.BoolValue(false)
+	data.SignatureType = types.StringValue("")
+	data.KeyID = types.StringValue("")
+	data.Fingerprint = types.StringValue("")
+	data.Verified = types.BoolValue(false)
+	data.Signer = types.StringValue("")
+	data.VerificationError = types.StringValue("object is not signed")
+
+	if sig := parseSignature(ctx, armored); sig != nil {
+		data.Signed = types.BoolValue(true)
+		data.SignatureType = types.StringValue(sig.Type)
+		data.KeyID = types.StringValue(sig.KeyID)
+		data.Fingerprint = types.StringValue(sig.Fingerprint)
+
+		signer, err := verifySignature(sig.Type, keyring, signers, payload, armored)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("unable to verify signature of %s: %v", hash.String(), err))
+			data.VerificationError = types.StringValue(err.Error())
+		} else {
+			data.Verified = types.BoolValue(true)
+			data.Signer = types.StringValue(signer)
+			data.VerificationError = types.StringValue("")
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("%s: %s signed: %t verified: %t", data.ObjectType.ValueString(), hash.String(), data.Signed.ValueBool(), data.Verified.ValueBool()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// annotatedTag returns the tag object ref names, nil when ref is not an
+// annotated tag.
+func annotatedTag(repo *git.Repository, ref string) *object.Tag {
+	tagRef, err := repo.Reference(plumbing.NewTagReferenceName(strings.TrimPrefix(ref, "refs/tags/")), true)
+	if err != nil {
+		return nil
+	}
+
+	tag, err := repo.TagObject(tagRef.Hash())
+	if err != nil {
+		return nil
+	}
+
+	return tag
+}
+
+func readEncodedObject(obj plumbing.EncodedObject) ([]byte, error) {
+	r, err := obj.Reader()
+	if err != nil {
+		return nil, err
+	}
+	//noinspection GoUnhandledErrorResult
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// verifySignature verifies the armored signature of payload with the trusted
+// keys matching its type, returning the signer.
+func verifySignature(signatureType string, keyring openpgp.EntityList, signers []gitutils.AllowedSigner, payload []byte, armored string) (string, error) {
+	switch signatureType {
+	case "gpg":
+		if len(keyring) == 0 {
+			return "", fmt.Errorf("no keyring to verify gpg signatures with")
+		}
+
+		entity, err := gitutils.VerifyPGPSignature(keyring, payload, armored)
+		if err != nil {
+			return "", err
+		}
+
+		if identity := entity.PrimaryIdentity(); identity != nil && identity.UserId != nil {
+			if identity.UserId.Email != "" {
+				return identity.UserId.Email, nil
+			}
+			return identity.Name, nil
+		}
+		return entity.PrimaryKey.KeyIdString(), nil
+	case "ssh":
+		if len(signers) == 0 {
+			return "", fmt.Errorf("no allowed signers to verify ssh signatures with")
+		}
+
+		signer, err := gitutils.VerifySSHSignature(signers, payload, armored)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.Join(signer.Principals, ","), nil
+	}
+
+	return "", fmt.Errorf("unable to verify %s signatures", signatureType)
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCommitSignatureDataSourceConfigKeyring(path string, ref string, keyring string) string {
+	return fmt.Sprintf(`
+data "git_commit_signature" "test" {
+  path    = %[1]q
+  ref     = %[2]q
+  keyring = %[3]q
+}
+`, path, ref, keyring)
+}
+
+func testAccGitCommitSignatureDataSourceConfigAllowedSigners(path string, ref string, allowedSigners string) string {
+	return fmt.Sprintf(`
+data "git_commit_signature" "test" {
+  path            = %[1]q
+  ref             = %[2]q
+  allowed_signers = %[3]q
+}
+`, path, ref, allowedSigners)
+}
+
+// testArmoredPublicKey returns the armored public key of entity.
+func testArmoredPublicKey(entity *openpgp.Entity) (string, error) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := entity.Serialize(w); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func TestAccGitCommitSignatureDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	entity, err := openpgp.NewEntity("Signer", "", "signer@example.com", nil)
+	assert.NoError(t, err)
+	other, err := openpgp.NewEntity("Other", "", "other@example.com", nil)
+	assert.NoError(t, err)
+
+	keyring, err := testArmoredPublicKey(entity)
+	assert.NoError(t, err)
+	otherKeyring, err := testArmoredPublicKey(other)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("# main"), 0644))
+	_, err = wt.Add("main.tf")
+	assert.NoError(t, err)
+	hash, err := wt.Commit("signed", &git.CommitOptions{
+		SignKey: entity,
+	})
+	assert.NoError(t, err)
+
+	tag, err := repo.CreateTag("v1.0.0", hash, &git.CreateTagOptions{
+		Message: "v1.0.0",
+		SignKey: entity,
+	})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCommitSignatureDataSourceConfigKeyring(tempDir, "HEAD", keyring),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "hash", hash.String()),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "object_type", "commit"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signed", "true"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signature_type", "gpg"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "key_id", entity.PrimaryKey.KeyIdString()),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verified", "true"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signer", "signer@example.com"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verification_error", ""),
+				),
+			},
+			{
+				Config: testAccGitCommitSignatureDataSourceConfigKeyring(tempDir, "v1.0.0", keyring),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "hash", tag.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "object_type", "tag"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verified", "true"),
+				),
+			},
+			{
+				Config: testAccGitCommitSignatureDataSourceConfigKeyring(tempDir, "HEAD", otherKeyring),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signed", "true"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verified", "false"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signer", ""),
+				),
+			},
+			{
+				Config: testAccGitCommitSignatureDataSourceConfigKeyring(tempDir, "HEAD~1", keyring),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signed", "false"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verified", "false"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verification_error", "object is not signed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitCommitSignatureDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(private)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	// go-git cannot sign with SSH keys, sign a copy of the commit the way
+	// `ssh-keygen -Y sign -n git` does.
+	commit, err := repo.CommitObject(*hash)
+	assert.NoError(t, err)
+
+	unsigned := &plumbing.MemoryObject{}
+	assert.NoError(t, commit.EncodeWithoutSignature(unsigned))
+	payload, err := readEncodedObject(unsigned)
+	assert.NoError(t, err)
+
+	digest := sha512.Sum512(payload)
+	message := []byte("SSHSIG")
+	for _, field := range [][]byte{[]byte("git"), {}, []byte("sha512"), digest[:]} {
+		message = binary.BigEndian.AppendUint32(message, uint32(len(field)))
+		message = append(message, field...)
+	}
+	sig, err := signer.Sign(rand.Reader, message)
+	assert.NoError(t, err)
+
+	blob := []byte("SSHSIG")
+	blob = binary.BigEndian.AppendUint32(blob, 1)
+	for _, field := range [][]byte{signer.PublicKey().Marshal(), []byte("git"), {}, []byte("sha512"), ssh.Marshal(sig)} {
+		blob = binary.BigEndian.AppendUint32(blob, uint32(len(field)))
+		blob = append(blob, field...)
+	}
+	commit.PGPSignature = string(pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob}))
+
+	obj := repo.Storer.NewEncodedObject()
+	assert.NoError(t, commit.Encode(obj))
+	signed, err := repo.Storer.SetEncodedObject(obj)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("ssh"), signed)))
+
+	key, err := ssh.NewPublicKey(public)
+	assert.NoError(t, err)
+	allowedSigners := fmt.Sprintf("# trusted signers\ndev@example.com,ops@example.com namespaces=\"git\" %s", ssh.MarshalAuthorizedKey(key))
+
+	_, otherPrivate, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	otherSigner, err := ssh.NewSignerFromKey(otherPrivate)
+	assert.NoError(t, err)
+	otherAllowedSigners := fmt.Sprintf("dev@example.com %s", ssh.MarshalAuthorizedKey(otherSigner.PublicKey()))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCommitSignatureDataSourceConfigAllowedSigners(tempDir, "ssh", allowedSigners),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "hash", signed.String()),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signature_type", "ssh"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "fingerprint", ssh.FingerprintSHA256(key)),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verified", "true"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signer", "dev@example.com,ops@example.com"),
+				),
+			},
+			{
+				Config: testAccGitCommitSignatureDataSourceConfigAllowedSigners(tempDir, "ssh", otherAllowedSigners),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "signed", "true"),
+					resource.TestCheckResourceAttr("data.git_commit_signature.test", "verified", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitCommitTrailers{}
+
+func NewGitCommitTrailers() datasource.DataSource {
+	return &GitCommitTrailers{}
+}
+
+// GitCommitTrailers defines the data source implementation.
+type GitCommitTrailers struct {
+	provider *GitProviderData
+}
+
+// GitCommitTrailersModel describes the data source data model.
+type GitCommitTrailersModel struct {
+	Id        types.String                   `tfsdk:"id"`
+	Path      types.String                   `tfsdk:"path"`
+	FromRef   types.String                   `tfsdk:"from_ref"`
+	Reference types.String                   `tfsdk:"ref"`
+	Keys      []types.String                 `tfsdk:"keys"`
+	Commit    types.String                   `tfsdk:"commit"`
+	Commits   []GitCommitTrailersCommitModel `tfsdk:"commits"`
+	Values    map[string][]types.String      `tfsdk:"values"`
+}
+
+// GitCommitTrailersCommitModel describes the trailers of a single commit.
+type GitCommitTrailersCommitModel struct {
+	Commit   types.String              `tfsdk:"commit"`
+	Summary  types.String              `tfsdk:"summary"`
+	Trailers []GitCommitTrailerModel   `tfsdk:"trailers"`
+	Values   map[string][]types.String `tfsdk:"values"`
+}
+
+// GitCommitTrailerModel describes a single trailer.
+type GitCommitTrailerModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (d *GitCommitTrailers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit_trailers"
+}
+
+func (d *GitCommitTrailers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Commit Trailers data source, parses the trailers ending commit messages (ie. " +
+			"`Signed-off-by`, `Change-Id` or `Reviewed-by`) like `git interpret-trailers --parse`, for a single commit " +
+			"or a range of commits (`from_ref..ref`). Trailers are read from the last paragraph of the message, which " +
+			"must only hold `Key: value` lines",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, a branch, tag or commit, only the commit " +
+					"`ref` points at is read when omitted",
+				Optional: true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read the trailers of, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"keys": schema.ListAttribute{
+				MarkdownDescription: "Only return these trailers (ie. `[\"Signed-off-by\"]`), keys are matched without regard " +
+					"to case, by default every trailer is returned",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"commits": schema.ListNestedAttribute{
+				MarkdownDescription: "Commits read, newest first, including those without trailers",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"summary": schema.StringAttribute{
+							MarkdownDescription: "First line of the commit message",
+							Computed:            true,
+						},
+						"trailers": schema.ListNestedAttribute{
+							MarkdownDescription: "Trailers of the commit, in order",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "Key of the trailer as written (ie. `Signed-off-by`)",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "Value of the trailer, continuation lines are joined with a space",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"values": schema.MapAttribute{
+							MarkdownDescription: "Values of the trailers of the commit keyed by lowercase key (ie. `signed-off-by`)",
+							ElementType:         types.ListType{ElemType: types.StringType},
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"values": schema.MapAttribute{
+				MarkdownDescription: "Values of the trailers of every commit read keyed by lowercase key, in commit order",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitCommitTrailers) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitCommitTrailers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitCommitTrailersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	commits := []*object.Commit{commit}
+	if data.FromRef.ValueString() != "" {
+		from, err := resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+
+		commits = nil
+		if err := walkRange(from, commit, func(c *object.Commit) error {
+			commits = append(commits, c)
+			return nil
+		}); err != nil {
+			resp.Diagnostics.AddError("unable to walk commits", err.Error())
+			return
+		}
+	}
+
+	keys := map[string]bool{}
+	for _, key := range toStrings(data.Keys) {
+		keys[strings.ToLower(key)] = true
+	}
+
+	data.Commits = []GitCommitTrailersCommitModel{}
+	data.Values = map[string][]types.String{}
+	for _, c := range commits {
+		model := GitCommitTrailersCommitModel{
+			Commit:   types.StringValue(c.Hash.String()),
+			Summary:  types.StringValue(strings.SplitN(strings.TrimSpace(c.Message), "\n", 2)[0]),
+			Trailers: []GitCommitTrailerModel{},
+			Values:   map[string][]types.String{},
+		}
+
+		for _, trailer := range gitutils.ParseTrailers(c.Message) {
+			key := strings.ToLower(trailer.Key)
+			if len(keys) > 0 && !keys[key] {
+				continue
+			}
+
+			model.Trailers = append(model.Trailers, GitCommitTrailerModel{
+				Key:   types.StringValue(trailer.Key),
+				Value: types.StringValue(trailer.Value),
+			})
+			model.Values[key] = append(model.Values[key], types.StringValue(trailer.Value))
+			data.Values[key] = append(data.Values[key], types.StringValue(trailer.Value))
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("commit: %s trailers: %d", c.Hash.String(), len(model.Trailers)))
+
+		data.Commits = append(data.Commits, model)
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitCommitTrailersDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_commit_trailers" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitCommitTrailersDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCommitMessages(tempDir,
+		"fix: handle empty body\n\nSigned-off-by: Jane <jane@example.com>\nChange-Id: I1234",
+		"docs: mention the limits\n\nReviewed-by: John <john@example.com>\nsigned-off-by: John <john@example.com>\nCloses: #12, #13\n  and #14",
+		"chore: no trailers\n\nThis paragraph: is not a trailer block\nas it holds prose.",
+	))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitCommitTrailersDataSourceConfig(tempDir, `ref = "HEAD~1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.#", "1"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.summary", "docs: mention the limits"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.trailers.#", "3"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.trailers.0.key", "Reviewed-by"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.trailers.1.key", "signed-off-by"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.trailers.2.value", "#12, #13 and #14"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.values.signed-off-by.0", "John <john@example.com>"),
+				),
+			},
+			{
+				Config: testAccGitCommitTrailersDataSourceConfig(tempDir, fmt.Sprintf(`
+  from_ref = %q
+  keys     = ["Signed-off-by"]
+`, base.String())),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.#", "3"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.summary", "chore: no trailers"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.0.trailers.#", "0"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "commits.2.trailers.#", "1"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "values.%", "1"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "values.signed-off-by.#", "2"),
+					resource.TestCheckResourceAttr("data.git_commit_trailers.test", "values.signed-off-by.1", "Jane <jane@example.com>"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitContributors{}
+
+func NewGitContributors() datasource.DataSource {
+	return &GitContributors{}
+}
+
+// GitContributors defines the data source implementation.
+type GitContributors struct {
+	provider *GitProviderData
+}
+
+// GitContributorsModel describes the data source data model.
+type GitContributorsModel struct {
+	Id           types.String          `tfsdk:"id"`
+	Path         types.String          `tfsdk:"path"`
+	FromRef      types.String          `tfsdk:"from_ref"`
+	ToRef        types.String          `tfsdk:"to_ref"`
+	CommitCount  types.Int64           `tfsdk:"commit_count"`
+	Contributors []GitContributorModel `tfsdk:"contributors"`
+}
+
+// GitContributorModel describes the commits of a single author.
+type GitContributorModel struct {
+	Name                types.String `tfsdk:"name"`
+	Email               types.String `tfsdk:"email"`
+	CommitCount         types.Int64  `tfsdk:"commit_count"`
+	FirstCommitDate     types.String `tfsdk:"first_commit_date"`
+	FirstCommitDateUnix types.Int64  `tfsdk:"first_commit_date_unix"`
+	LastCommitDate      types.String `tfsdk:"last_commit_date"`
+	LastCommitDateUnix  types.Int64  `tfsdk:"last_commit_date_unix"`
+}
+
+func (d *GitContributors) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contributors"
+}
+
+func (d *GitContributors) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Contributors data source, aggregates commit authors over a range of commits (`from_ref..to_ref`)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, a branch, tag or commit (default: the whole history)",
+				Optional:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Include commits reachable from this reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits in the range",
+				Computed:            true,
+			},
+			"contributors": schema.ListNestedAttribute{
+				MarkdownDescription: "Authors of the commits in the range grouped by email, sorted by commit count then email",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name used on the most recent commit of the author",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email of the author",
+							Computed:            true,
+						},
+						"commit_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of commits by the author in the range",
+							Computed:            true,
+						},
+						"first_commit_date": schema.StringAttribute{
+							MarkdownDescription: "Author date of the earliest commit by the author in the range (RFC3339)",
+							Computed:            true,
+						},
+						"first_commit_date_unix": schema.Int64Attribute{
+							MarkdownDescription: "Author date of the earliest commit by the author in the range (Unix timestamp)",
+							Computed:            true,
+						},
+						"last_commit_date": schema.StringAttribute{
+							MarkdownDescription: "Author date of the latest commit by the author in the range (RFC3339)",
+							Computed:            true,
+						},
+						"last_commit_date_unix": schema.Int64Attribute{
+							MarkdownDescription: "Author date of the latest commit by the author in the range (Unix timestamp)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitContributors) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitContributors) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitContributorsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var from *object.Commit
+	if data.FromRef.ValueString() != "" {
+		from, err = resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	type contributor struct {
+		name  string
+		email string
+		count int64
+		first time.Time
+		last  time.Time
+	}
+
+	total := int64(0)
+	contributors := map[string]*contributor{}
+	if err := walkRange(from, to, func(c *object.Commit) error {
+		total++
+
+		key := strings.ToLower(c.Author.Email)
+		entry, ok := contributors[key]
+		if !ok {
+			entry = &contributor{
+				name:  c.Author.Name,
+				email: c.Author.Email,
+				first: c.Author.When,
+				last:  c.Author.When,
+			}
+			contributors[key] = entry
+		}
+
+		entry.count++
+		if c.Author.When.Before(entry.first) {
+			entry.first = c.Author.When
+		}
+		if !c.Author.When.Before(entry.last) {
+			entry.last = c.Author.When
+			entry.name = c.Author.Name
+		}
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Contributors = []GitContributorModel{}
+	for _, entry := range contributors {
+		tflog.Trace(ctx, fmt.Sprintf("contributor: %s commits: %d", entry.email, entry.count))
+
+		data.Contributors = append(data.Contributors, GitContributorModel{
+			Name:                types.StringValue(entry.name),
+			Email:               types.StringValue(entry.email),
+			CommitCount:         types.Int64Value(entry.count),
+			FirstCommitDate:     types.StringValue(entry.first.Format(time.RFC3339)),
+			FirstCommitDateUnix: types.Int64Value(entry.first.Unix()),
+			LastCommitDate:      types.StringValue(entry.last.Format(time.RFC3339)),
+			LastCommitDateUnix:  types.Int64Value(entry.last.Unix()),
+		})
+	}
+
+	sort.Slice(data.Contributors, func(i, j int) bool {
+		a, b := data.Contributors[i], data.Contributors[j]
+		if a.CommitCount.ValueInt64() != b.CommitCount.ValueInt64() {
+			return a.CommitCount.ValueInt64() > b.CommitCount.ValueInt64()
+		}
+		return strings.ToLower(a.Email.ValueString()) < strings.ToLower(b.Email.ValueString())
+	})
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), to.Hash.String()))
+	data.CommitCount = types.Int64Value(total)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitContributorsDataSourceConfig(path string, fromRef string) string {
+	return fmt.Sprintf(`
+data "git_contributors" "test" {
+  path     = %[1]q
+  from_ref = %[2]q
+}
+`, path, fromRef)
+}
+
+func TestAccGitContributorsDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	commits := []object.Signature{
+		{Name: "Alice", Email: "alice@example.com", When: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Bob", Email: "bob@example.com", When: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Alice Smith", Email: "Alice@example.com", When: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, author := range commits {
+		author := author
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte(fmt.Sprintf("change %d", i)), 0644))
+		_, err = wt.Commit(fmt.Sprintf("change %d", i), &git.CommitOptions{
+			All:    true,
+			Author: &author,
+		})
+		assert.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitContributorsDataSourceConfig(tempDir, "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_contributors.test", "to_ref", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "commit_count", "3"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.#", "2"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.0.name", "Alice Smith"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.0.commit_count", "2"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.0.first_commit_date", "2022-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.0.last_commit_date", "2022-03-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.0.first_commit_date_unix", "1640995200"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.0.last_commit_date_unix", "1646092800"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.1.email", "bob@example.com"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.1.commit_count", "1"),
+				),
+			},
+			{
+				Config: testAccGitContributorsDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_contributors.test", "commit_count", "4"),
+					resource.TestCheckResourceAttr("data.git_contributors.test", "contributors.#", "3"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitConventionalCommits{}
+
+func NewGitConventionalCommits() datasource.DataSource {
+	return &GitConventionalCommits{}
+}
+
+// GitConventionalCommits defines the data source implementation.
+type GitConventionalCommits struct {
+	provider *GitProviderData
+}
+
+// GitConventionalCommitsModel describes the data source data model.
+type GitConventionalCommitsModel struct {
+	Id       types.String                        `tfsdk:"id"`
+	Path     types.String                        `tfsdk:"path"`
+	FromRef  types.String                        `tfsdk:"from_ref"`
+	ToRef    types.String                        `tfsdk:"to_ref"`
+	Types    []types.String                      `tfsdk:"types"`
+	ToCommit types.String                        `tfsdk:"to_commit"`
+	Commits  []GitConventionalCommitsCommitModel `tfsdk:"commits"`
+	Breaking types.Bool                          `tfsdk:"breaking"`
+}
+
+// GitConventionalCommitsCommitModel describes a single parsed commit.
+type GitConventionalCommitsCommitModel struct {
+	Commit   types.String            `tfsdk:"commit"`
+	Type     types.String            `tfsdk:"type"`
+	Scope    types.String            `tfsdk:"scope"`
+	Subject  types.String            `tfsdk:"subject"`
+	Body     types.String            `tfsdk:"body"`
+	Breaking types.Bool              `tfsdk:"breaking"`
+	Footers  []GitCommitTrailerModel `tfsdk:"footers"`
+}
+
+func (d *GitConventionalCommits) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conventional_commits"
+}
+
+func (d *GitConventionalCommits) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Conventional Commits data source, parses the commits between two references " +
+			"(`from_ref..to_ref`) following [Conventional Commits](https://www.conventionalcommits.org) into their type, " +
+			"scope, subject, body and footers. Commits not following Conventional Commits and merge commits are left out",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, usually the previous release tag " +
+					"(default: the whole history)",
+				Optional: true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Include commits reachable from this reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"types": schema.ListAttribute{
+				MarkdownDescription: "Only return commits of these types (ie. `[\"feat\", \"fix\"]`), breaking changes of " +
+					"other types are returned as well, by default every type is returned",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"commits": schema.ListNestedAttribute{
+				MarkdownDescription: "Commits following Conventional Commits, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the commit, lowercase (ie. `feat`)",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Scope of the commit, empty when it has none",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "Description following the type and scope",
+							Computed:            true,
+						},
+						"body": schema.StringAttribute{
+							MarkdownDescription: "Free form text between the header and the footers, empty when there is none",
+							Computed:            true,
+						},
+						"breaking": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the commit is a breaking change, marked by `!` or a `BREAKING CHANGE` footer",
+							Computed:            true,
+						},
+						"footers": schema.ListNestedAttribute{
+							MarkdownDescription: "Footers of the commit, in order",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "Token of the footer (ie. `BREAKING CHANGE` or `Refs`)",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "Value of the footer, it may span several lines",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"breaking": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not any of `commits` is a breaking change",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitConventionalCommits) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitConventionalCommits) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitConventionalCommitsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var from *object.Commit
+	if data.FromRef.ValueString() != "" {
+		from, err = resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	commitTypes := map[string]bool{}
+	for _, t := range toStrings(data.Types) {
+		commitTypes[strings.ToLower(t)] = true
+	}
+
+	breaking := false
+	data.Commits = []GitConventionalCommitsCommitModel{}
+	if err := walkRange(from, to, func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return nil
+		}
+
+		conventional := gitutils.ParseConventionalCommit(c.Message)
+		if conventional == nil {
+			return nil
+		}
+		if len(commitTypes) > 0 && !commitTypes[conventional.Type] && !conventional.Breaking {
+			return nil
+		}
+
+		model := GitConventionalCommitsCommitModel{
+			Commit:   types.StringValue(c.Hash.String()),
+			Type:     types.StringValue(conventional.Type),
+			Scope:    types.StringValue(conventional.Scope),
+			Subject:  types.StringValue(conventional.Subject),
+			Body:     types.StringValue(conventional.Body),
+			Breaking: types.BoolValue(conventional.Breaking),
+			Footers:  []GitCommitTrailerModel{},
+		}
+		for _, footer := range conventional.Footers {
+			model.Footers = append(model.Footers, GitCommitTrailerModel{
+				Key:   types.StringValue(footer.Key),
+				Value: types.StringValue(footer.Value),
+			})
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("commit: %s type: %s breaking: %t", c.Hash.String(), conventional.Type, conventional.Breaking))
+
+		breaking = breaking || conventional.Breaking
+		data.Commits = append(data.Commits, model)
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), to.Hash.String()))
+	data.ToCommit = types.StringValue(to.Hash.String())
+	data.Breaking = types.BoolValue(breaking)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
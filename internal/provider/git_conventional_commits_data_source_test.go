@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitConventionalCommitsDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_conventional_commits" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitConventionalCommitsDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCommitMessages(tempDir,
+		"fix(api): handle empty body\n\nThe body may be empty when\nthe client times out.\n\nRefs #12\nReviewed-by: Jane",
+		"not conventional",
+		"docs: mention the limits",
+		"refactor: drop v1 endpoints\n\nBREAKING CHANGE: v1 endpoints are gone,\nuse v2 instead",
+	))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitConventionalCommitsDataSourceConfig(tempDir, `from_ref = "v1.0.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.#", "3"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "breaking", "true"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.0.type", "refactor"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.0.breaking", "true"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.0.body", ""),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.0.footers.0.key", "BREAKING CHANGE"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.0.footers.0.value", "v1 endpoints are gone,\nuse v2 instead"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.1.type", "docs"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.1.footers.#", "0"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.type", "fix"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.scope", "api"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.subject", "handle empty body"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.body", "The body may be empty when\nthe client times out."),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.breaking", "false"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.footers.#", "2"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.footers.0.key", "Refs"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.2.footers.0.value", "12"),
+				),
+			},
+			{
+				Config: testAccGitConventionalCommitsDataSourceConfig(tempDir, `
+  from_ref = "v1.0.0"
+  types    = ["fix"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.0.type", "refactor"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.1.type", "fix"),
+				),
+			},
+			{
+				Config: testAccGitConventionalCommitsDataSourceConfig(tempDir, `from_ref = "HEAD"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "commits.#", "0"),
+					resource.TestCheckResourceAttr("data.git_conventional_commits.test", "breaking", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultBranchName is the branch git creates when init.defaultBranch is not
+// configured.
+const defaultBranchName = "master"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitDefaultBranch{}
+
+func NewGitDefaultBranch() datasource.DataSource {
+	return &GitDefaultBranch{}
+}
+
+// GitDefaultBranch defines the data source implementation.
+type GitDefaultBranch struct {
+	provider *GitProviderData
+}
+
+// GitDefaultBranchModel describes the data source data model.
+type GitDefaultBranchModel struct {
+	Id     types.String `tfsdk:"id"`
+	Path   types.String `tfsdk:"path"`
+	Remote types.String `tfsdk:"remote"`
+	Name   types.String `tfsdk:"name"`
+	Source types.String `tfsdk:"source"`
+	Commit types.String `tfsdk:"commit"`
+}
+
+func (d *GitDefaultBranch) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_branch"
+}
+
+func (d *GitDefaultBranch) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Default Branch data source, determines the default branch of a repository without network access, " +
+			"from the `HEAD` of the remote recorded by `git clone` or `git remote set-head`, then `init.defaultBranch`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote whose `HEAD` is read (default: origin)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the default branch (ie. `main`)",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Where the default branch was found, `remote_head` for `refs/remotes/<remote>/HEAD`, " +
+					"`init_default_branch` for the `init.defaultBranch` configuration and `default` for the git default, `master`",
+				Computed: true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Hash of the commit at the tip of the default branch, the remote tracking branch for `remote_head` " +
+					"and the local branch otherwise, empty when it does not exist",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *GitDefaultBranch) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitDefaultBranch) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitDefaultBranchModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Remote.ValueString() == "" {
+		data.Remote = types.StringValue("origin")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	remotePrefix := fmt.Sprintf("refs/remotes/%s/", data.Remote.ValueString())
+
+	var branch plumbing.ReferenceName
+
+	head, err := repo.Reference(plumbing.NewRemoteHEADReferenceName(data.Remote.ValueString()), false)
+	if err != nil && err != plumbing.ErrReferenceNotFound {
+		resp.Diagnostics.AddError("unable to read remote head reference", err.Error())
+		return
+	}
+	if head != nil && head.Type() == plumbing.SymbolicReference && strings.HasPrefix(head.Target().String(), remotePrefix) {
+		branch = head.Target()
+		data.Name = types.StringValue(strings.TrimPrefix(branch.String(), remotePrefix))
+		data.Source = types.StringValue("remote_head")
+	} else {
+		cfg, err := repo.ConfigScoped(config.SystemScope)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read git config", err.Error())
+			return
+		}
+
+		data.Name = types.StringValue(defaultBranchName)
+		data.Source = types.StringValue("default")
+		if cfg.Init.DefaultBranch != "" {
+			data.Name = types.StringValue(cfg.Init.DefaultBranch)
+			data.Source = types.StringValue("init_default_branch")
+		}
+		branch = plumbing.NewBranchReferenceName(data.Name.ValueString())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("default branch: %s source: %s", data.Name.ValueString(), data.Source.ValueString()))
+
+	data.Commit = types.StringValue("")
+	ref, err := repo.Reference(branch, true)
+	if err != nil && err != plumbing.ErrReferenceNotFound {
+		resp.Diagnostics.AddError("unable to read default branch", err.Error())
+		return
+	}
+	if ref != nil {
+		data.Commit = types.StringValue(ref.Hash().String())
+	}
+
+	data.Id = types.StringValue(data.Path.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitDefaultBranchDataSourceConfig(path string) string {
+	return fmt.Sprintf(`
+data "git_default_branch" "test" {
+  path = %[1]q
+}
+`, path)
+}
+
+func TestAccGitDefaultBranchDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				PreConfig: func() {
+					cfg, err := repo.Config()
+					assert.NoError(t, err)
+					cfg.Init.DefaultBranch = "master"
+					assert.NoError(t, repo.Storer.SetConfig(cfg))
+				},
+				Config: testAccGitDefaultBranchDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_default_branch.test", "name", "master"),
+					resource.TestCheckResourceAttr("data.git_default_branch.test", "source", "init_default_branch"),
+					resource.TestCheckResourceAttr("data.git_default_branch.test", "commit", hash.String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "main"), *hash)))
+					assert.NoError(t, repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.NewRemoteHEADReferenceName("origin"), plumbing.NewRemoteReferenceName("origin", "main"))))
+				},
+				Config: testAccGitDefaultBranchDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_default_branch.test", "name", "main"),
+					resource.TestCheckResourceAttr("data.git_default_branch.test", "source", "remote_head"),
+					resource.TestCheckResourceAttr("data.git_default_branch.test", "commit", hash.String()),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitDescribe{}
+
+func NewGitDescribe() datasource.DataSource {
+	return &GitDescribe{}
+}
+
+// GitDescribe defines the data source implementation.
+type GitDescribe struct {
+	provider *GitProviderData
+}
+
+// GitDescribeModel describes the data source data model.
+type GitDescribeModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Path        types.String   `tfsdk:"path"`
+	Reference   types.String   `tfsdk:"ref"`
+	Match       []types.String `tfsdk:"match"`
+	Exclude     []types.String `tfsdk:"exclude"`
+	Tags        types.Bool     `tfsdk:"tags"`
+	Abbrev      types.Int64    `tfsdk:"abbrev"`
+	Long        types.Bool     `tfsdk:"long"`
+	Always      types.Bool     `tfsdk:"always"`
+	FirstParent types.Bool     `tfsdk:"first_parent"`
+	Describe    types.String   `tfsdk:"describe"`
+	Tag         types.String   `tfsdk:"tag"`
+	Distance    types.Int64    `tfsdk:"distance"`
+	Commit      types.String   `tfsdk:"commit"`
+	CommitShort types.String   `tfsdk:"commit_short"`
+}
+
+func (d *GitDescribe) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_describe"
+}
+
+func (d *GitDescribe) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Describe data source, names a commit after the closest reachable tag like `git describe`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to describe, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"match": schema.ListAttribute{
+				MarkdownDescription: "Only consider tags matching at least one of these glob patterns (`--match`), `*` also matches `/` like in git",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"exclude": schema.ListAttribute{
+				MarkdownDescription: "Do not consider tags matching any of these glob patterns (`--exclude`), `*` also matches `/` like in git",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to consider lightweight tags as well as annotated tags (`--tags`, default: false)",
+				Optional:            true,
+			},
+			"abbrev": schema.Int64Attribute{
+				MarkdownDescription: "Number of hexadecimal digits of the abbreviated commit hash, 0 only outputs the tag, or the full commit hash with `always` (`--abbrev`, default: 7)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"long": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to always output the distance and hash, even when the commit is tagged (`--long`, default: false)",
+				Optional:            true,
+			},
+			"always": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to fall back to the abbreviated commit hash when no tag is found instead of failing (`--always`, default: false)",
+				Optional:            true,
+			},
+			"first_parent": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to only follow the first parent of merge commits (`--first-parent`, default: false)",
+				Optional:            true,
+			},
+			"describe": schema.StringAttribute{
+				MarkdownDescription: "Formatted description of the commit (ie. `v1.2.0-3-gabc1234`)",
+				Computed:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Closest tag, empty when none was found",
+				Computed:            true,
+			},
+			"distance": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits between the tag and the commit",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"commit_short": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to, abbreviated to `abbrev` digits",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitDescribe) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitDescribe) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitDescribeModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.Abbrev.IsNull() || data.Abbrev.IsUnknown() {
+		data.Abbrev = types.Int64Value(7)
+	}
+	if data.Abbrev.ValueInt64() < 0 || data.Abbrev.ValueInt64() > 40 {
+		resp.Diagnostics.AddAttributeError(path.Root("abbrev"), "invalid abbrev", "abbrev must be between 0 and 40")
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tags, err := tagsFingerprint(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list tags", err.Error())
+		return
+	}
+
+	opts := gitutils.DescribeCommitOptions{
+		Match:       toStrings(data.Match),
+		Exclude:     toStrings(data.Exclude),
+		Tags:        data.Tags.ValueBool(),
+		FirstParent: data.FirstParent.ValueBool(),
+	}
+	key := fmt.Sprintf("describe|%s|%s|%s|%q|%q|%t|%t", data.Path.ValueString(), commit.Hash.String(), tags, opts.Match, opts.Exclude, opts.Tags, opts.FirstParent)
+
+	var result *gitutils.DescribeResult
+	cached, err := d.provider.cache().get(key, func() (interface{}, error) {
+		return gitutils.DescribeCommit(repo, commit, opts)
+	})
+	if err == nil {
+		result = cached.(*gitutils.DescribeResult)
+	}
+	if err == gitutils.ErrNoDescribeNames && data.Always.ValueBool() {
+		result, err = &gitutils.DescribeResult{Hash: commit.Hash}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to describe commit", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("tag: %s distance: %d", result.Tag, result.Distance))
+
+	abbrev := int(data.Abbrev.ValueInt64())
+	short := commit.Hash.String()
+	if abbrev > 0 {
+		short = short[:abbrev]
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Describe = types.StringValue(result.Format(abbrev, data.Long.ValueBool()))
+	data.Tag = types.StringValue(result.Tag)
+	data.Distance = types.Int64Value(int64(result.Distance))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.CommitShort = types.StringValue(short)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
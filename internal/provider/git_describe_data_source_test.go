@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitDescribeDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_describe" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitDescribeDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 2)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	head, err := repo.Head()
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewTagReferenceName("latest"), head.Hash())))
+
+	hash := head.Hash().String()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", fmt.Sprintf("v1.0.0-2-g%s", hash[:7])),
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "2"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "commit", hash),
+					resource.TestCheckResourceAttr("data.git_describe.test", "commit_short", hash[:7]),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "tags = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", "latest"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "0"),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "tags = true\n  long = true\n  abbrev = 10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", fmt.Sprintf("latest-0-g%s", hash[:10])),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "abbrev = 0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", "v1.0.0"),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "tags = true\n  exclude = [\"lat*\"]\n  ref = \"HEAD~1\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "1"),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "match = [\"v2.*\"]\n  always = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", hash[:7]),
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", ""),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "match = [\"v2.*\"]\n  always = true\n  abbrev = 0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", hash),
+					resource.TestCheckResourceAttr("data.git_describe.test", "commit_short", hash),
+				),
+			},
+			{
+				Config:      testAccGitDescribeDataSourceConfig(tempDir, "match = [\"v2.*\"]"),
+				ExpectError: regexp.MustCompile("no names found"),
+			},
+		},
+	})
+}
+
+func TestAccGitDescribeDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	// feature branches off the tagged commit and is tagged itself
+	assert.NoError(t, testCheckoutBranch(tempDir, "feature", *base))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.tf"), []byte("# feature"), 0644))
+	feature, err := testCommitAll(tempDir, "feature")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("feature-1", *feature, &git.CreateTagOptions{Message: "feature-1"})
+	assert.NoError(t, err)
+
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+	assert.NoError(t, wt.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("master")}))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("# main"), 0644))
+	main, err := testCommitAll(tempDir, "main")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.tf"), []byte("# feature"), 0644))
+	_, err = wt.Add("feature.tf")
+	assert.NoError(t, err)
+	_, err = wt.Commit("merge feature", &git.CommitOptions{
+		Parents: []plumbing.Hash{*main, *feature},
+	})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", "feature-1"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "2"),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "first_parent = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitDescribeDataSource3(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	upstreamDir := filepath.Join(tempDir, "upstream")
+	forkDir := filepath.Join(tempDir, "fork")
+
+	head, err := testSetupGit(upstreamDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	upstream, err := git.PlainOpen(upstreamDir)
+	assert.NoError(t, err)
+	tag, err := upstream.Tag("v1.0.0")
+	assert.NoError(t, err)
+
+	// the fork has no objects of its own, only references and a relative
+	// alternates entry pointing at the upstream object database
+	fork, err := git.PlainInit(forkDir, false)
+	assert.NoError(t, err)
+	assert.NoError(t, fork.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("master"), *head)))
+	assert.NoError(t, fork.Storer.SetReference(tag))
+	assert.NoError(t, os.MkdirAll(filepath.Join(forkDir, ".git", "objects", "info"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(forkDir, ".git", "objects", "info", "alternates"), []byte("../../../upstream/.git/objects\n"), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDescribeDataSourceConfig(forkDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitDescribeDataSource4(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	head, err := testSetupGit(tempDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewTagReferenceName("release/api/v2.0.0"), *head)))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "tags = true\n  match = [\"release*\"]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "describe", "release/api/v2.0.0"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "0"),
+				),
+			},
+			{
+				Config: testAccGitDescribeDataSourceConfig(tempDir, "tags = true\n  exclude = [\"release/*.0\"]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_describe.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_describe.test", "distance", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/utils/merkletrie"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitDiff{}
+
+func NewGitDiff() datasource.DataSource {
+	return &GitDiff{}
+}
+
+// GitDiff defines the data source implementation.
+type GitDiff struct {
+	provider *GitProviderData
+}
+
+// GitDiffModel describes the data source data model.
+type GitDiffModel struct {
+	Id           types.String                `tfsdk:"id"`
+	Path         types.String                `tfsdk:"path"`
+	FromRef      types.String                `tfsdk:"from_ref"`
+	ToRef        types.String                `tfsdk:"to_ref"`
+	IncludePatch types.Bool                  `tfsdk:"include_patch"`
+	FromCommit   types.String                `tfsdk:"from_commit"`
+	ToCommit     types.String                `tfsdk:"to_commit"`
+	Files        []GitDiffFileModel          `tfsdk:"files"`
+	FilesByPath  map[string]GitDiffPathModel `tfsdk:"files_by_path"`
+	Patch        types.String                `tfsdk:"patch"`
+}
+
+// GitDiffFileModel describes a single changed file.
+type GitDiffFileModel struct {
+	Path       types.String `tfsdk:"path"`
+	OldPath    types.String `tfsdk:"old_path"`
+	ChangeType types.String `tfsdk:"change_type"`
+}
+
+// GitDiffPathModel describes a single changed file keyed by its path.
+type GitDiffPathModel struct {
+	OldPath    types.String `tfsdk:"old_path"`
+	ChangeType types.String `tfsdk:"change_type"`
+}
+
+func (d *GitDiff) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diff"
+}
+
+func (d *GitDiff) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Diff data source, lists the files changed between two references",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare from, a branch, tag or commit",
+				Required:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare to, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"include_patch": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to render the unified diff into `patch` (default: false)",
+				Optional:            true,
+			},
+			"from_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `from_ref` resolved to",
+				Computed:            true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Files changed between the two references, sorted by path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at `to_ref`, or at `from_ref` when deleted",
+							Computed:            true,
+						},
+						"old_path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at `from_ref`, empty when added",
+							Computed:            true,
+						},
+						"change_type": schema.StringAttribute{
+							MarkdownDescription: "Type of change, one of `added`, `modified`, `deleted` or `renamed`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"files_by_path": schema.MapNestedAttribute{
+				MarkdownDescription: "Files changed between the two references, keyed by `path`, ie. for `lookup()`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"old_path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at `from_ref`, empty when added",
+							Computed:            true,
+						},
+						"change_type": schema.StringAttribute{
+							MarkdownDescription: "Type of change, one of `added`, `modified`, `deleted` or `renamed`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"patch": schema.StringAttribute{
+				MarkdownDescription: "Unified diff between the two references, empty unless `include_patch` is set",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitDiff) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitDiff) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitDiffModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	from, err := resolveCommit(repo, data.FromRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	changes, err := diffCommits(ctx, from, to)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to diff references", err.Error())
+		return
+	}
+
+	data.Files = []GitDiffFileModel{}
+	data.FilesByPath = map[string]GitDiffPathModel{}
+	for _, change := range changes {
+		file, err := newGitDiffFileModel(change)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read change", err.Error())
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("change: %s %s", file.ChangeType.ValueString(), file.Path.ValueString()))
+
+		data.Files = append(data.Files, file)
+		data.FilesByPath[file.Path.ValueString()] = GitDiffPathModel{
+			OldPath:    file.OldPath,
+			ChangeType: file.ChangeType,
+		}
+	}
+
+	sort.Slice(data.Files, func(i, j int) bool {
+		return data.Files[i].Path.ValueString() < data.Files[j].Path.ValueString()
+	})
+
+	data.Patch = types.StringValue("")
+	if data.IncludePatch.ValueBool() {
+		patch, err := changes.PatchContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to generate patch", err.Error())
+			return
+		}
+
+		data.Patch = types.StringValue(patch.String())
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), from.Hash.String(), to.Hash.String()))
+	data.FromCommit = types.StringValue(from.Hash.String())
+	data.ToCommit = types.StringValue(to.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// diffCommits returns the changes between the trees of two commits with
+// rename detection enabled.
+func diffCommits(ctx context.Context, from *object.Commit, to *object.Commit) (object.Changes, error) {
+	fromTree, err := from.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	toTree, err := to.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	return object.DiffTreeWithOptions(ctx, fromTree, toTree, object.DefaultDiffTreeOptions)
+}
+
+// newGitDiffFileModel converts a tree change into its model, a modification
+// whose name differs on either side is reported as a rename.
+func newGitDiffFileModel(change *object.Change) (GitDiffFileModel, error) {
+	action, err := change.Action()
+	if err != nil {
+		return GitDiffFileModel{}, err
+	}
+
+	switch action {
+	case merkletrie.Insert:
+		return GitDiffFileModel{
+			Path:       types.StringValue(change.To.Name),
+			OldPath:    types.StringValue(""),
+			ChangeType: types.StringValue("added"),
+		}, nil
+	case merkletrie.Delete:
+		return GitDiffFileModel{
+			Path:       types.StringValue(change.From.Name),
+			OldPath:    types.StringValue(change.From.Name),
+			ChangeType: types.StringValue("deleted"),
+		}, nil
+	}
+
+	changeType := "modified"
+	if change.From.Name != change.To.Name {
+		changeType = "renamed"
+	}
+
+	return GitDiffFileModel{
+		Path:       types.StringValue(change.To.Name),
+		OldPath:    types.StringValue(change.From.Name),
+		ChangeType: types.StringValue(changeType),
+	}, nil
+}
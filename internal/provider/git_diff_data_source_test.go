@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitDiffDataSourceConfig(path string, fromRef string, includePatch bool) string {
+	return fmt.Sprintf(`
+data "git_diff" "test" {
+  path          = %[1]q
+  from_ref      = %[2]q
+  include_patch = %[3]t
+}
+`, path, fromRef, includePatch)
+}
+
+func TestAccGitDiffDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	from, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	content := strings.Repeat("resource \"null_resource\" \"this\" {}\n", 10)
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte(content), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "old.tf"), []byte("# old"), 0644))
+	_, err = testCommitAll(tempDir, "setup")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("changed"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "new.tf"), []byte("# new"), 0644))
+	assert.NoError(t, os.Remove(filepath.Join(tempDir, "old.tf")))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "modules"), 0755))
+	assert.NoError(t, os.Rename(filepath.Join(tempDir, "main.tf"), filepath.Join(tempDir, "modules", "main.tf")))
+	to, err := testCommitAll(tempDir, "changes")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDiffDataSourceConfig(tempDir, "HEAD~1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "to_ref", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "to_commit", to.String()),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.#", "4"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.0.path", "README.md"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.0.change_type", "modified"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.1.path", "modules/main.tf"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.1.old_path", "main.tf"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.1.change_type", "renamed"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.2.path", "new.tf"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.2.change_type", "added"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.3.path", "old.tf"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.3.change_type", "deleted"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files_by_path.%", "4"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files_by_path.modules/main.tf.old_path", "main.tf"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files_by_path.modules/main.tf.change_type", "renamed"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files_by_path.old.tf.change_type", "deleted"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "patch", ""),
+				),
+			},
+			{
+				Config: testAccGitDiffDataSourceConfig(tempDir, from.String(), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "from_commit", from.String()),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.#", "3"),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^\+changed$`)),
+				),
+			},
+		},
+	})
+}
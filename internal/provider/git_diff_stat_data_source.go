@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitDiffStat{}
+
+func NewGitDiffStat() datasource.DataSource {
+	return &GitDiffStat{}
+}
+
+// GitDiffStat defines the data source implementation.
+type GitDiffStat struct {
+	provider *GitProviderData
+}
+
+// GitDiffStatModel describes the data source data model.
+type GitDiffStatModel struct {
+	Id           types.String                    `tfsdk:"id"`
+	Path         types.String                    `tfsdk:"path"`
+	FromRef      types.String                    `tfsdk:"from_ref"`
+	ToRef        types.String                    `tfsdk:"to_ref"`
+	FromCommit   types.String                    `tfsdk:"from_commit"`
+	ToCommit     types.String                    `tfsdk:"to_commit"`
+	CommitCount  types.Int64                     `tfsdk:"commit_count"`
+	FilesChanged types.Int64                     `tfsdk:"files_changed"`
+	Insertions   types.Int64                     `tfsdk:"insertions"`
+	Deletions    types.Int64                     `tfsdk:"deletions"`
+	Files        []GitDiffStatFileModel          `tfsdk:"files"`
+	FilesByPath  map[string]GitDiffStatPathModel `tfsdk:"files_by_path"`
+}
+
+// GitDiffStatFileModel describes the changes to a single file.
+type GitDiffStatFileModel struct {
+	Path       types.String `tfsdk:"path"`
+	OldPath    types.String `tfsdk:"old_path"`
+	Insertions types.Int64  `tfsdk:"insertions"`
+	Deletions  types.Int64  `tfsdk:"deletions"`
+	Binary     types.Bool   `tfsdk:"binary"`
+}
+
+// GitDiffStatPathModel describes the changes to a single file keyed by its
+// path.
+type GitDiffStatPathModel struct {
+	OldPath    types.String `tfsdk:"old_path"`
+	Insertions types.Int64  `tfsdk:"insertions"`
+	Deletions  types.Int64  `tfsdk:"deletions"`
+	Binary     types.Bool   `tfsdk:"binary"`
+}
+
+func (d *GitDiffStat) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diff_stat"
+}
+
+func (d *GitDiffStat) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Diff Stat data source, summarizes the changes between two references like " +
+			"`git diff --shortstat from_ref..to_ref`, with a per file breakdown like `--numstat`, ie. to gate deployments on " +
+			"the size of a change",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare from, a branch, tag or commit",
+				Required:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare to, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `from_ref` resolved to",
+				Computed:            true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits reachable from `to_ref` but not from `from_ref`",
+				Computed:            true,
+			},
+			"files_changed": schema.Int64Attribute{
+				MarkdownDescription: "Number of files changed between the two references",
+				Computed:            true,
+			},
+			"insertions": schema.Int64Attribute{
+				MarkdownDescription: "Number of lines added, binary files are not counted",
+				Computed:            true,
+			},
+			"deletions": schema.Int64Attribute{
+				MarkdownDescription: "Number of lines removed, binary files are not counted",
+				Computed:            true,
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Changes to every file between the two references, sorted by path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at `to_ref`, or at `from_ref` when deleted",
+							Computed:            true,
+						},
+						"old_path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at `from_ref`, empty when added",
+							Computed:            true,
+						},
+						"insertions": schema.Int64Attribute{
+							MarkdownDescription: "Number of lines added to the file, 0 for binary files",
+							Computed:            true,
+						},
+						"deletions": schema.Int64Attribute{
+							MarkdownDescription: "Number of lines removed from the file, 0 for binary files",
+							Computed:            true,
+						},
+						"binary": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the file is binary",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"files_by_path": schema.MapNestedAttribute{
+				MarkdownDescription: "Changes to every file between the two references, keyed by `path`, ie. for `lookup()`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"old_path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at `from_ref`, empty when added",
+							Computed:            true,
+						},
+						"insertions": schema.Int64Attribute{
+							MarkdownDescription: "Number of lines added to the file, 0 for binary files",
+							Computed:            true,
+						},
+						"deletions": schema.Int64Attribute{
+							MarkdownDescription: "Number of lines removed from the file, 0 for binary files",
+							Computed:            true,
+						},
+						"binary": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the file is binary",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitDiffStat) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitDiffStat) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitDiffStatModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	from, err := resolveCommit(repo, data.FromRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	changes, err := diffCommits(ctx, from, to)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to diff references", err.Error())
+		return
+	}
+
+	patch, err := changes.PatchContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to generate patch", err.Error())
+		return
+	}
+
+	insertions, deletions := 0, 0
+	data.Files = []GitDiffStatFileModel{}
+	data.FilesByPath = map[string]GitDiffStatPathModel{}
+	for _, fp := range patch.FilePatches() {
+		from, to := fp.Files()
+		file := GitDiffStatFileModel{
+			OldPath: types.StringValue(""),
+			Binary:  types.BoolValue(fp.IsBinary()),
+		}
+		if from != nil {
+			file.Path = types.StringValue(from.Path())
+			file.OldPath = types.StringValue(from.Path())
+		}
+		if to != nil {
+			file.Path = types.StringValue(to.Path())
+		}
+
+		added, removed := filePatchLines(fp)
+		insertions += added
+		deletions += removed
+		file.Insertions = types.Int64Value(int64(added))
+		file.Deletions = types.Int64Value(int64(removed))
+
+		data.Files = append(data.Files, file)
+		data.FilesByPath[file.Path.ValueString()] = GitDiffStatPathModel{
+			OldPath:    file.OldPath,
+			Insertions: file.Insertions,
+			Deletions:  file.Deletions,
+			Binary:     file.Binary,
+		}
+	}
+
+	sort.Slice(data.Files, func(i, j int) bool {
+		return data.Files[i].Path.ValueString() < data.Files[j].Path.ValueString()
+	})
+
+	count := int64(0)
+	if err := walkRange(from, to, func(c *object.Commit) error {
+		count++
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("commits: %d files: %d insertions: %d deletions: %d", count, len(changes), insertions, deletions))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), from.Hash.String(), to.Hash.String()))
+	data.FromCommit = types.StringValue(from.Hash.String())
+	data.ToCommit = types.StringValue(to.Hash.String())
+	data.CommitCount = types.Int64Value(count)
+	data.FilesChanged = types.Int64Value(int64(len(changes)))
+	data.Insertions = types.Int64Value(int64(insertions))
+	data.Deletions = types.Int64Value(int64(deletions))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filePatchLines returns the number of lines a file patch adds and removes,
+// counted like `git diff --numstat`.
+func filePatchLines(fp diff.FilePatch) (int, int) {
+	added, removed := 0, 0
+	for _, chunk := range fp.Chunks() {
+		content := chunk.Content()
+		if content == "" {
+			continue
+		}
+
+		lines := strings.Count(content, "\n")
+		if !strings.HasSuffix(content, "\n") {
+			lines++
+		}
+
+		switch chunk.Type() {
+		case diff.Add:
+			added += lines
+		case diff.Delete:
+			removed += lines
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitDiffStatDataSourceConfig(path string, fromRef string, toRef string) string {
+	return fmt.Sprintf(`
+data "git_diff_stat" "test" {
+  path     = %[1]q
+  from_ref = %[2]q
+  to_ref   = %[3]q
+}
+`, path, fromRef, toRef)
+}
+
+func TestAccGitDiffStatDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("line 1\nline 2\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "NEW.md"), []byte("a\nb\nc\n"), 0644))
+	first, err := testCommitAll(tempDir, "first")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.Remove(filepath.Join(tempDir, "NEW.md")))
+	second, err := testCommitAll(tempDir, "second")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDiffStatDataSourceConfig(tempDir, "v1.0.0", first.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "to_commit", first.String()),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "commit_count", "1"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_changed", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "insertions", "5"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "deletions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.#", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.path", "NEW.md"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.old_path", ""),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.insertions", "3"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.deletions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.1.path", "README.md"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.1.insertions", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.1.deletions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.1.binary", "false"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_by_path.%", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_by_path.NEW.md.insertions", "3"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_by_path.README.md.insertions", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_by_path.README.md.deletions", "1"),
+				),
+			},
+			{
+				Config: testAccGitDiffStatDataSourceConfig(tempDir, "v1.0.0", "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "to_commit", second.String()),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "commit_count", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_changed", "1"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "insertions", "2"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "deletions", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitDiffStatDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "logo.png"), []byte{0x89, 'P', 'N', 'G', 0x00, 0x01, 0x02}, 0644))
+	_, err = testCommitAll(tempDir, "binary")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitDiffStatDataSourceConfig(tempDir, "v1.0.0", "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files_changed", "1"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "insertions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.path", "logo.png"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.binary", "true"),
+					resource.TestCheckResourceAttr("data.git_diff_stat.test", "files.0.insertions", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,561 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitFetch{}
+var _ resource.ResourceWithModifyPlan = &GitFetch{}
+var _ resource.ResourceWithValidateConfig = &GitFetch{}
+var _ resource.ResourceWithImportState = &GitFetch{}
+
+// fetchTagModes maps the values of the tags attribute to go-git tag modes.
+var fetchTagModes = map[string]git.TagMode{
+	"auto": git.TagFollowing,
+	"all":  git.AllTags,
+	"none": git.NoTags,
+}
+
+func NewGitFetch() resource.Resource {
+	return &GitFetch{}
+}
+
+// GitFetch defines the resource implementation.
+type GitFetch struct {
+	provider *GitProviderData
+}
+
+// GitFetchModel describes the resource data model.
+type GitFetchModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Path       types.String   `tfsdk:"path"`
+	Remote     types.String   `tfsdk:"remote"`
+	RefSpecs   []types.String `tfsdk:"refspecs"`
+	Prune      types.Bool     `tfsdk:"prune"`
+	Tags       types.String   `tfsdk:"tags"`
+	Username   types.String   `tfsdk:"username"`
+	Password   types.String   `tfsdk:"password"`
+	Refs       types.Map      `tfsdk:"refs"`
+	RefUpdates types.List     `tfsdk:"ref_updates"`
+}
+
+func (r *GitFetch) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fetch"
+}
+
+func (r *GitFetch) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Fetch resource, fetches references from a remote of an existing repository like " +
+			"`git fetch` does, and records the references updated. The remote is listed on every plan and the " +
+			"references fetched again whenever they moved, so that data sources depending on `refs` read fresh " +
+			"remote-tracking references in the same apply. Destroying the resource leaves the references as they are. " +
+			"Existing repositories can be imported with their `path`, using the default arguments",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote to fetch from (default: origin)",
+				Optional:            true,
+			},
+			"refspecs": schema.ListAttribute{
+				MarkdownDescription: "Refspecs to fetch, ie. `+refs/heads/*:refs/remotes/origin/*` (default: the " +
+					"`fetch` refspecs of `remote`)",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"prune": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to delete the references matching the destination of `refspecs` " +
+					"that no longer exist on the remote, like `git fetch --prune` (default: false)",
+				Optional: true,
+			},
+			"tags": schema.StringAttribute{
+				MarkdownDescription: "Which tags to fetch, one of `auto` (tags pointing at fetched commits), `all` or " +
+					"`none` (default: auto)",
+				Optional: true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"refs": schema.MapAttribute{
+				MarkdownDescription: "Map of full reference name to object hash of the local references matching the " +
+					"destination of `refspecs`",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ref_updates": refUpdatesAttribute(),
+		},
+	}
+}
+
+func (r *GitFetch) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitFetch) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitFetchModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		if _, ok := fetchTagModes[data.Tags.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("tags"), "invalid tags", "tags must be one of: auto, all, none")
+		}
+	}
+
+	for i, spec := range data.RefSpecs {
+		if spec.IsUnknown() {
+			continue
+		}
+		if err := validateFetchRefSpec(config.RefSpec(spec.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("refspecs").AtListIndex(i), "invalid refspec", err.Error())
+		}
+	}
+}
+
+func (r *GitFetch) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying or creating.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data GitFetchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Refs.IsUnknown() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	remote, specs, err := fetchRemote(repo, &data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to read remote", err.Error())
+		return
+	}
+
+	remoteRefs, err := listFetchRefs(ctx, remote, r.provider.repositoryAuth(data.Path, data.Username, data.Password))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to list remote references", err.Error())
+		return
+	}
+
+	// The state holds the local references of the last refresh, they are
+	// fetched again whenever the remote ones moved.
+	local := map[string]string{}
+	for name, value := range data.Refs.Elements() {
+		if hash, ok := value.(types.String); ok {
+			local[name] = hash.ValueString()
+		}
+	}
+
+	fetched := fetchedRefs(specs, remoteRefs)
+	stale := false
+	for name, hash := range fetched {
+		if local[name] != hash {
+			stale = true
+		}
+	}
+	if data.Prune.ValueBool() && len(local) != len(fetched) {
+		stale = true
+	}
+
+	if stale {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("refs"), types.MapUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ref_updates"), refUpdatesUnknown())...)
+	}
+}
+
+func (r *GitFetch) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitFetchModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(repoPath)
+
+	resp.Diagnostics.Append(r.fetch(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFetch) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitFetchModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	_, specs, err := fetchRemote(repo, &data)
+	if errors.Is(err, git.ErrRemoteNotFound) {
+		tflog.Warn(ctx, "remote no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to read remote", err.Error())
+		return
+	}
+
+	refs, err := localFetchRefs(repo, specs)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read references", err.Error())
+		return
+	}
+	data.Refs = mirrorRefsValue(refs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFetch) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitFetchModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.fetch(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFetch) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The fetched references are left as they are.
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *GitFetch) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("path"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// fetch fetches the refspecs of data from its remote, pruning the references
+// gone from the remote when asked to, and sets the references fetched.
+func (r *GitFetch) fetch(ctx context.Context, data *GitFetchModel) (diags diag.Diagnostics) {
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		diags.AddError("unable to open git repository", err.Error())
+		return diags
+	}
+
+	remote, specs, err := fetchRemote(repo, data)
+	if err != nil {
+		diags.AddAttributeError(path.Root("remote"), "unable to read remote", err.Error())
+		return diags
+	}
+
+	tags := git.TagFollowing
+	if mode, ok := fetchTagModes[data.Tags.ValueString()]; ok {
+		tags = mode
+	}
+
+	auth := r.provider.repositoryAuth(data.Path, data.Username, data.Password)
+
+	snapshot, err := snapshotRefs(repo)
+	if err != nil {
+		diags.AddError("unable to read references", err.Error())
+		return diags
+	}
+
+	protected, err := localFetchRefs(repo, specs)
+	if err != nil {
+		diags.AddError("unable to read references", err.Error())
+		return diags
+	}
+	for name := range protected {
+		if !r.provider.isProtectedRef(plumbing.ReferenceName(name)) {
+			delete(protected, name)
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("fetching %d refspecs from %s", len(specs), remote.Config().Name))
+	err = remote.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: remote.Config().Name,
+		RefSpecs:   specs,
+		Auth:       auth,
+		Tags:       tags,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		diags.AddAttributeError(path.Root("remote"), "unable to fetch", err.Error())
+		return diags
+	}
+
+	for _, name := range sortedKeys(protected) {
+		if err := r.checkFastForward(repo, plumbing.ReferenceName(name), plumbing.NewHash(protected[name])); err != nil {
+			diags.AddAttributeError(path.Root("refspecs"), "unable to fetch", err.Error())
+			return diags
+		}
+	}
+
+	if data.Prune.ValueBool() {
+		remoteRefs, err := listFetchRefs(ctx, remote, auth)
+		if err != nil {
+			diags.AddAttributeError(path.Root("remote"), "unable to list remote references", err.Error())
+			return diags
+		}
+
+		local, err := localFetchRefs(repo, specs)
+		if err != nil {
+			diags.AddError("unable to read references", err.Error())
+			return diags
+		}
+
+		fetched := fetchedRefs(specs, remoteRefs)
+		for _, name := range sortedKeys(local) {
+			if _, ok := fetched[name]; ok {
+				continue
+			}
+			if err := r.provider.checkProtectedRef(plumbing.ReferenceName(name), "prune"); err != nil {
+				diags.AddAttributeError(path.Root("prune"), "unable to prune reference", err.Error())
+				return diags
+			}
+			tflog.Trace(ctx, fmt.Sprintf("pruning %s", name))
+			if err := repo.Storer.RemoveReference(plumbing.ReferenceName(name)); err != nil {
+				diags.AddError("unable to prune reference", err.Error())
+				return diags
+			}
+		}
+	}
+
+	refs, err := localFetchRefs(repo, specs)
+	if err != nil {
+		diags.AddError("unable to read references", err.Error())
+		return diags
+	}
+	data.Refs = mirrorRefsValue(refs)
+
+	updates, err := snapshot.updates(repo)
+	if err != nil {
+		diags.AddError("unable to read references", err.Error())
+		return diags
+	}
+	data.RefUpdates = refUpdatesValue(updates)
+
+	return diags
+}
+
+// checkFastForward restores the reference name, which matches the provider
+// protected_refs, to old and returns an error when the fetch force updated it.
+func (r *GitFetch) checkFastForward(repo *git.Repository, name plumbing.ReferenceName, old plumbing.Hash) error {
+	ref, err := repo.Storer.Reference(name)
+	if err != nil || ref.Hash() == old {
+		return err
+	}
+
+	ff := false
+	if previous, err := repo.CommitObject(old); err == nil {
+		if current, err := repo.CommitObject(ref.Hash()); err == nil {
+			if ff, err = previous.IsAncestor(current); err != nil {
+				return err
+			}
+		}
+	}
+	if ff {
+		return nil
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(name, old)); err != nil {
+		return err
+	}
+	return r.provider.checkProtectedRef(name, "force update")
+}
+
+// fetchRemote returns the remote of data and the refspecs to fetch from it,
+// the ones configured for the remote unless data sets them.
+func fetchRemote(repo *git.Repository, data *GitFetchModel) (*git.Remote, []config.RefSpec, error) {
+	name := data.Remote.ValueString()
+	if name == "" {
+		name = git.DefaultRemoteName
+	}
+
+	remote, err := repo.Remote(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specs := remote.Config().Fetch
+	if len(data.RefSpecs) > 0 {
+		specs = make([]config.RefSpec, 0, len(data.RefSpecs))
+		for _, spec := range data.RefSpecs {
+			specs = append(specs, config.RefSpec(spec.ValueString()))
+		}
+	}
+
+	for _, spec := range specs {
+		if err := validateFetchRefSpec(spec); err != nil {
+			return nil, nil, fmt.Errorf("invalid refspec %s: %v", spec, err)
+		}
+	}
+
+	return remote, specs, nil
+}
+
+// validateFetchRefSpec returns an error when spec can not be fetched, it must
+// map remote references to local ones.
+func validateFetchRefSpec(spec config.RefSpec) error {
+	if spec == "" || spec.IsDelete() {
+		return fmt.Errorf("refspec must have a source, ie. refs/heads/*:refs/remotes/origin/*")
+	}
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	if spec.IsExactSHA1() {
+		return fmt.Errorf("refspec source must be a reference, not an object hash")
+	}
+	return nil
+}
+
+// listFetchRefs lists the references of remote, none when it is empty.
+func listFetchRefs(ctx context.Context, remote *git.Remote, auth transport.AuthMethod) ([]*plumbing.Reference, error) {
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return nil, nil
+	}
+	return refs, err
+}
+
+// fetchedRefs returns the local references specs map the remote references
+// remoteRefs to, by name, with the hash they are fetched at.
+func fetchedRefs(specs []config.RefSpec, remoteRefs []*plumbing.Reference) map[string]string {
+	fetched := map[string]string{}
+	for _, ref := range remoteRefs {
+		if ref.Type() != plumbing.HashReference {
+			continue
+		}
+		for _, spec := range specs {
+			if spec.Match(ref.Name()) {
+				fetched[spec.Dst(ref.Name()).String()] = ref.Hash().String()
+			}
+		}
+	}
+	return fetched
+}
+
+// localFetchRefs returns the local references of repo matching the
+// destination of specs, by name.
+func localFetchRefs(repo *git.Repository, specs []config.RefSpec) (map[string]string, error) {
+	reversed := make([]config.RefSpec, 0, len(specs))
+	for _, spec := range specs {
+		reversed = append(reversed, config.RefSpec(strings.TrimPrefix(spec.String(), "+")).Reverse())
+	}
+
+	iter, err := repo.Storer.IterReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := map[string]string{}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference && config.MatchAny(reversed, ref.Name()) {
+			refs[ref.Name().String()] = ref.Hash().String()
+		}
+		return nil
+	})
+
+	return refs, err
+}
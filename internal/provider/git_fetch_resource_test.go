@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitFetchResourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+resource "git_fetch" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitFetchResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	originDir := filepath.Join(tempDir, "origin")
+	cloneDir := filepath.Join(tempDir, "clone")
+
+	head, err := testSetupGit(originDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	_, err = git.PlainClone(cloneDir, true, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	origin, err := git.PlainOpen(originDir)
+	assert.NoError(t, err)
+
+	var next *plumbing.Hash
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitFetchResourceConfig(cloneDir, `tags = "some"`),
+				ExpectError: regexp.MustCompile("invalid tags"),
+			},
+			{
+				Config:      testAccGitFetchResourceConfig(cloneDir, `refspecs = ["refs/heads/*"]`),
+				ExpectError: regexp.MustCompile("invalid refspec"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitFetchResourceConfig(cloneDir, `
+  refspecs = ["+refs/heads/*:refs/remotes/origin/*"]
+  prune    = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "id", cloneDir),
+					resource.TestCheckResourceAttr("git_fetch.test", "refs.%", "1"),
+					resource.TestCheckResourceAttr("git_fetch.test", "refs.refs/remotes/origin/master", head.String()),
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(filepath.Join(originDir, "next.txt"), []byte("next"), 0644))
+					next, err = testCommitAll(originDir, "next")
+					assert.NoError(t, err)
+					assert.NoError(t, origin.Storer.SetReference(plumbing.NewHashReference("refs/heads/feature", *head)))
+					_, err = origin.CreateTag("v2.0.0", *next, nil)
+					assert.NoError(t, err)
+				},
+				Config: testAccGitFetchResourceConfig(cloneDir, `
+  refspecs = ["+refs/heads/*:refs/remotes/origin/*"]
+  prune    = true
+  tags     = "all"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "refs.%", "2"),
+					resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.#", "3"),
+					resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.0.ref", "refs/remotes/origin/feature"),
+					resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.0.old", ""),
+					resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.1.ref", "refs/remotes/origin/master"),
+					resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.2.ref", "refs/tags/v2.0.0"),
+					func(s *terraform.State) error {
+						return resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("git_fetch.test", "refs.refs/remotes/origin/master", next.String()),
+							resource.TestCheckResourceAttr("git_fetch.test", "refs.refs/remotes/origin/feature", head.String()),
+							resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.0.new", head.String()),
+							resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.1.old", head.String()),
+							resource.TestCheckResourceAttr("git_fetch.test", "ref_updates.1.new", next.String()),
+							testCheckRefs(cloneDir, map[string]string{"refs/tags/v2.0.0": next.String()}),
+						)(s)
+					},
+				),
+			},
+			// Prune testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, origin.Storer.RemoveReference("refs/heads/feature"))
+				},
+				Config: testAccGitFetchResourceConfig(cloneDir, `
+  refspecs = ["+refs/heads/*:refs/remotes/origin/*"]
+  prune    = true
+  tags     = "all"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "refs.%", "1"),
+					testCheckRefs(cloneDir, map[string]string{"refs/remotes/origin/feature": ""}),
+				),
+			},
+			// Protected references are only fast forwarded
+			{
+				PreConfig: func() {
+					assert.NoError(t, origin.Storer.SetReference(plumbing.NewHashReference("refs/heads/master", *head)))
+				},
+				Config: `
+provider "git" {
+  protected_refs = ["refs/remotes/origin/*"]
+}
+` + testAccGitFetchResourceConfig(cloneDir, `
+  refspecs = ["+refs/heads/*:refs/remotes/origin/*"]
+  prune    = true
+  tags     = "all"
+`),
+				ExpectError: regexp.MustCompile("refusing to force update refs/remotes/origin/master"),
+			},
+			// and not pruned
+			{
+				PreConfig: func() {
+					assert.NoError(t, origin.Storer.SetReference(plumbing.NewHashReference("refs/heads/master", *next)))
+					assert.NoError(t, origin.Storer.RemoveReference("refs/heads/master"))
+				},
+				Config: `
+provider "git" {
+  protected_refs = ["refs/remotes/origin/*"]
+}
+` + testAccGitFetchResourceConfig(cloneDir, `
+  refspecs = ["+refs/heads/*:refs/remotes/origin/*"]
+  prune    = true
+  tags     = "all"
+`),
+				ExpectError: regexp.MustCompile("refusing to prune refs/remotes/origin/master"),
+			},
+		},
+	})
+}
+
+func TestAccGitFetchResource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	originDir := filepath.Join(tempDir, "origin")
+	cloneDir := filepath.Join(tempDir, "clone")
+
+	head, err := testSetupGit(originDir, "", 1)
+	assert.NoError(t, err)
+
+	_, err = git.PlainClone(cloneDir, true, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	config := fmt.Sprintf(`
+provider "git" {
+  repositories = {
+    clone = {
+      path     = %[1]q
+      username = "user"
+      password = "token"
+    }
+  }
+}
+`, cloneDir) + testAccGitFetchResourceConfig("clone", `refspecs = ["+refs/heads/*:refs/remotes/origin/*"]`)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Named repository testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "path", "clone"),
+					resource.TestCheckResourceAttr("git_fetch.test", "id", cloneDir),
+					resource.TestCheckResourceAttr("git_fetch.test", "refs.refs/remotes/origin/master", head.String()),
+				),
+			},
+		},
+	})
+}
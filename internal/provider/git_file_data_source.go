@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitFile{}
+
+func NewGitFile() datasource.DataSource {
+	return &GitFile{}
+}
+
+// GitFile defines the data source implementation.
+type GitFile struct {
+	provider *GitProviderData
+}
+
+// GitFileModel describes the data source data model.
+type GitFileModel struct {
+	Id            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	File          types.String `tfsdk:"file"`
+	Reference     types.String `tfsdk:"ref"`
+	Commit        types.String `tfsdk:"commit"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Size          types.Int64  `tfsdk:"size"`
+	Mode          types.String `tfsdk:"mode"`
+	Hash          types.String `tfsdk:"hash"`
+
+	LastCommit            types.Bool   `tfsdk:"last_commit"`
+	LastCommitHash        types.String `tfsdk:"last_commit_hash"`
+	LastCommitAuthorName  types.String `tfsdk:"last_commit_author_name"`
+	LastCommitAuthorEmail types.String `tfsdk:"last_commit_author_email"`
+	LastCommitDate        types.String `tfsdk:"last_commit_date"`
+	LastCommitDateUnix    types.Int64  `tfsdk:"last_commit_date_unix"`
+}
+
+func (d *GitFile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (d *GitFile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git File data source, reads a file as committed at a reference rather than from the working tree",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file relative to the root of the repository",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read the file at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the file",
+				Computed:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded content of the file, for binary files",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the file in bytes",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Git file mode of the file (ie. `0100644`)",
+				Computed:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the blob holding the file content",
+				Computed:            true,
+			},
+			"last_commit": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to look up the last commit that modified the file (default: false)",
+				Optional:            true,
+			},
+			"last_commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the last commit that modified the file, requires `last_commit`",
+				Computed:            true,
+			},
+			"last_commit_author_name": schema.StringAttribute{
+				MarkdownDescription: "Author name of the last commit that modified the file, requires `last_commit`",
+				Computed:            true,
+			},
+			"last_commit_author_email": schema.StringAttribute{
+				MarkdownDescription: "Author email of the last commit that modified the file, requires `last_commit`",
+				Computed:            true,
+			},
+			"last_commit_date": schema.StringAttribute{
+				MarkdownDescription: "Author date of the last commit that modified the file (RFC3339), requires `last_commit`",
+				Computed:            true,
+			},
+			"last_commit_date_unix": schema.Int64Attribute{
+				MarkdownDescription: "Author date of the last commit that modified the file (Unix timestamp), requires `last_commit`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitFile) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitFile) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitFileModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	file, err := commit.File(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "unable to find file", fmt.Sprintf("%s at %s: %s", data.File.ValueString(), commit.Hash.String(), err.Error()))
+		return
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read file", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("file: %s blob: %s", file.Name, file.Hash.String()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", commit.Hash.String(), file.Name))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.Content = types.StringValue(content)
+	data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString([]byte(content)))
+	data.Size = types.Int64Value(file.Size)
+	data.Mode = types.StringValue(file.Mode.String())
+	data.Hash = types.StringValue(file.Hash.String())
+
+	data.LastCommitHash = types.StringValue("")
+	data.LastCommitAuthorName = types.StringValue("")
+	data.LastCommitAuthorEmail = types.StringValue("")
+	data.LastCommitDate = types.StringValue("")
+	data.LastCommitDateUnix = types.Int64Value(0)
+
+	if data.LastCommit.ValueBool() {
+		fileName := data.File.ValueString()
+
+		commits, err := repo.Log(&git.LogOptions{
+			From:     commit.Hash,
+			FileName: &fileName,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("unable to get log", err.Error())
+			return
+		}
+
+		last, err := commits.Next()
+		commits.Close()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to find last commit for file", err.Error())
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("last_commit: %s", last.Hash.String()))
+
+		data.LastCommitHash = types.StringValue(last.Hash.String())
+		data.LastCommitAuthorName = types.StringValue(last.Author.Name)
+		data.LastCommitAuthorEmail = types.StringValue(last.Author.Email)
+		data.LastCommitDate = types.StringValue(last.Author.When.Format(time.RFC3339))
+		data.LastCommitDateUnix = types.Int64Value(last.Author.When.Unix())
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
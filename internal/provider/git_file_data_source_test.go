@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitFileDataSourceConfig(path string, file string, ref string) string {
+	return fmt.Sprintf(`
+data "git_file" "test" {
+  path = %[1]q
+  file = %[2]q
+  ref  = %[3]q
+}
+`, path, file, ref)
+}
+
+func testAccGitFileDataSourceConfigLastCommit(path string, file string) string {
+	return fmt.Sprintf(`
+data "git_file" "test" {
+  path        = %[1]q
+  file        = %[2]q
+  last_commit = true
+}
+`, path, file)
+}
+
+func TestAccGitFileDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 2)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitFileDataSourceConfig(tempDir, "README.md", "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_file.test", "content", "testing 01"),
+					resource.TestCheckResourceAttr("data.git_file.test", "size", "10"),
+					resource.TestCheckResourceAttr("data.git_file.test", "mode", "0100644"),
+				),
+			},
+			{
+				Config: testAccGitFileDataSourceConfig(tempDir, "README.md", "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "content", "testing"),
+					resource.TestCheckResourceAttr("data.git_file.test", "content_base64", "dGVzdGluZw=="),
+					resource.TestCheckResourceAttr("data.git_file.test", "hash", "9a2c7732fab5bcd73ea3ed52d2d9599a4cc47666"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitFileDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	reg, err := regexp.Compile("unable to find file")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitFileDataSourceConfig(tempDir, "missing.txt", "HEAD"),
+				ExpectError: reg,
+			},
+		},
+	})
+}
+
+func TestAccGitFileDataSource3(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 1)
+	assert.NoError(t, err)
+
+	// A later commit touching a different file must not change the last commit.
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "OTHER.md"), []byte("other"), 0644))
+	head, err := testCommitAll(tempDir, "other")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitFileDataSourceConfigLastCommit(tempDir, "README.md"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_file.test", "last_commit_hash", hash.String()),
+					resource.TestCheckResourceAttrSet("data.git_file.test", "last_commit_author_name"),
+					resource.TestCheckResourceAttrSet("data.git_file.test", "last_commit_date"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitFileHistory{}
+
+func NewGitFileHistory() datasource.DataSource {
+	return &GitFileHistory{}
+}
+
+// GitFileHistory defines the data source implementation.
+type GitFileHistory struct {
+	provider *GitProviderData
+}
+
+// GitFileHistoryModel describes the data source data model.
+type GitFileHistoryModel struct {
+	Id        types.String                `tfsdk:"id"`
+	Path      types.String                `tfsdk:"path"`
+	File      types.String                `tfsdk:"file"`
+	Reference types.String                `tfsdk:"ref"`
+	Follow    types.Bool                  `tfsdk:"follow"`
+	MaxCount  types.Int64                 `tfsdk:"max_count"`
+	Commits   []GitFileHistoryCommitModel `tfsdk:"commits"`
+}
+
+// GitFileHistoryCommitModel describes a commit that touched the file.
+type GitFileHistoryCommitModel struct {
+	Commit      types.String `tfsdk:"commit"`
+	File        types.String `tfsdk:"file"`
+	Summary     types.String `tfsdk:"summary"`
+	AuthorName  types.String `tfsdk:"author_name"`
+	AuthorEmail types.String `tfsdk:"author_email"`
+	Date        types.String `tfsdk:"date"`
+	DateUnix    types.Int64  `tfsdk:"date_unix"`
+}
+
+func (d *GitFileHistory) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_history"
+}
+
+func (d *GitFileHistory) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git File History data source, lists the commits that touched a file or directory like " +
+			"`git log -- <file>`, newest first. Commits are compared to their first parent and merge commits are left out",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file or directory relative to the root of the repository (ie. `modules/vpc`)",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to start from, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"follow": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to follow the renames of a file like `--follow`, `file` must be a file " +
+					"(default: false)",
+				Optional: true,
+			},
+			"max_count": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of commits to return, 0 returns all of them (default: 0)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commits": schema.ListNestedAttribute{
+				MarkdownDescription: "Commits that touched the file, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"file": schema.StringAttribute{
+							MarkdownDescription: "Path of the file at the commit, it differs from `file` before a rename when `follow` is set",
+							Computed:            true,
+						},
+						"summary": schema.StringAttribute{
+							MarkdownDescription: "First line of the commit message",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the author of the commit",
+							Computed:            true,
+						},
+						"author_email": schema.StringAttribute{
+							MarkdownDescription: "Email of the author of the commit",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Author date of the commit (RFC3339)",
+							Computed:            true,
+						},
+						"date_unix": schema.Int64Attribute{
+							MarkdownDescription: "Author date of the commit (Unix timestamp)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitFileHistory) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitFileHistory) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitFileHistoryModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.MaxCount.IsNull() || data.MaxCount.IsUnknown() {
+		data.MaxCount = types.Int64Value(0)
+	}
+	if data.MaxCount.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_count"), "invalid max_count", "max_count must be 0 or more")
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	iter, err := repo.Log(&git.LogOptions{
+		From: commit.Hash,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to get log", err.Error())
+		return
+	}
+
+	current := strings.Trim(data.File.ValueString(), "/")
+	data.Commits = []GitFileHistoryCommitModel{}
+
+	if err := iter.ForEach(func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return nil
+		}
+
+		changes, err := firstParentChanges(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		touched, previous := false, current
+		for _, change := range changes {
+			switch {
+			case data.Follow.ValueBool() && change.To.Name == current:
+				touched = true
+				if change.From.Name != "" {
+					previous = change.From.Name
+				}
+			case !data.Follow.ValueBool() && (pathContains(current, change.From.Name) || pathContains(current, change.To.Name)):
+				touched = true
+			}
+		}
+		if !touched {
+			return nil
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("commit: %s file: %s", c.Hash.String(), current))
+
+		data.Commits = append(data.Commits, GitFileHistoryCommitModel{
+			Commit:      types.StringValue(c.Hash.String()),
+			File:        types.StringValue(current),
+			Summary:     types.StringValue(strings.SplitN(strings.TrimSpace(c.Message), "\n", 2)[0]),
+			AuthorName:  types.StringValue(c.Author.Name),
+			AuthorEmail: types.StringValue(c.Author.Email),
+			Date:        types.StringValue(c.Author.When.Format(time.RFC3339)),
+			DateUnix:    types.Int64Value(c.Author.When.Unix()),
+		})
+		current = previous
+
+		if data.MaxCount.ValueInt64() > 0 && int64(len(data.Commits)) >= data.MaxCount.ValueInt64() {
+			return storer.ErrStop
+		}
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.Path.ValueString(), commit.Hash.String(), data.File.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// firstParentChanges returns the changes c introduces over its first parent,
+// detecting renames, or over an empty tree for root commits.
+func firstParentChanges(ctx context.Context, c *object.Commit) (object.Changes, error) {
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	parentTree := &object.Tree{}
+	if c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return object.DiffTreeWithOptions(ctx, parentTree, tree, object.DefaultDiffTreeOptions)
+}
+
+// pathContains reports whether name is dir or a path below it.
+func pathContains(dir string, name string) bool {
+	return name != "" && (name == dir || strings.HasPrefix(name, dir+"/"))
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitFileHistoryDataSourceConfig(path string, file string, options string) string {
+	return fmt.Sprintf(`
+data "git_file_history" "test" {
+  path = %[1]q
+  file = %[2]q
+  %[3]s
+}
+`, path, file, options)
+}
+
+func TestAccGitFileHistoryDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "modules", "vpc"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "modules", "vpc", "old.tf"), []byte("# vpc\nresource \"a\" \"b\" {}\n"), 0644))
+	added, err := testCommitAll(tempDir, "add vpc")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("unrelated"), 0644))
+	_, err = testCommitAll(tempDir, "unrelated")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.Rename(filepath.Join(tempDir, "modules", "vpc", "old.tf"), filepath.Join(tempDir, "modules", "vpc", "main.tf")))
+	renamed, err := testCommitAll(tempDir, "rename vpc")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitFileHistoryDataSourceConfig(tempDir, "modules/vpc", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file_history.test", "max_count", "0"),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.0.commit", renamed.String()),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.0.summary", "rename vpc"),
+					resource.TestCheckResourceAttrSet("data.git_file_history.test", "commits.0.date"),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.1.commit", added.String()),
+				),
+			},
+			{
+				Config: testAccGitFileHistoryDataSourceConfig(tempDir, "modules/vpc/main.tf", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.#", "1"),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.0.commit", renamed.String()),
+				),
+			},
+			{
+				Config: testAccGitFileHistoryDataSourceConfig(tempDir, "modules/vpc/main.tf", "follow = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.0.file", "modules/vpc/main.tf"),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.1.commit", added.String()),
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.1.file", "modules/vpc/old.tf"),
+				),
+			},
+			{
+				Config: testAccGitFileHistoryDataSourceConfig(tempDir, "modules/vpc", "max_count = 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file_history.test", "commits.#", "1"),
+				),
+			},
+		},
+	})
+}
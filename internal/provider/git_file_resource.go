@@ -0,0 +1,522 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitFileResource{}
+var _ resource.ResourceWithModifyPlan = &GitFileResource{}
+var _ resource.ResourceWithValidateConfig = &GitFileResource{}
+
+func NewGitFileResource() resource.Resource {
+	return &GitFileResource{}
+}
+
+// GitFileResource defines the resource implementation.
+type GitFileResource struct {
+	provider *GitProviderData
+}
+
+// GitFileResourceModel describes the resource data model.
+type GitFileResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Path                 types.String `tfsdk:"path"`
+	Serialize            types.Bool   `tfsdk:"serialize"`
+	Branch               types.String `tfsdk:"branch"`
+	File                 types.String `tfsdk:"file"`
+	Content              types.String `tfsdk:"content"`
+	Source               types.String `tfsdk:"source"`
+	Executable           types.Bool   `tfsdk:"executable"`
+	CommitMessage        types.String `tfsdk:"commit_message"`
+	AuthorName           types.String `tfsdk:"author_name"`
+	AuthorEmail          types.String `tfsdk:"author_email"`
+	CommitterName        types.String `tfsdk:"committer_name"`
+	CommitterEmail       types.String `tfsdk:"committer_email"`
+	Sign                 types.Bool   `tfsdk:"sign"`
+	SigningKey           types.String `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String `tfsdk:"signing_key_passphrase"`
+	OverwriteOnCreate    types.Bool   `tfsdk:"overwrite_on_create"`
+	Remote               types.String `tfsdk:"remote"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	Blob                 types.String `tfsdk:"blob"`
+	Commit               types.String `tfsdk:"commit"`
+	RefUpdates           types.List   `tfsdk:"ref_updates"`
+}
+
+func (r *GitFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *GitFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git File resource, manages a file of a branch through commits, like `github_repository_file` " +
+			"but against any git repository. Creating or updating the resource commits the file, destroying it commits " +
+			"its deletion. The working tree is left alone unless the branch is checked out, in which case it must not " +
+			"have local changes. With `remote` set the branch is fast forwarded to the remote branch before committing " +
+			"and pushed afterwards, changes to the remote branch since then or a branch that has diverged fail the apply " +
+			"instead of being overwritten, and the file is read from the remote branch to detect drift",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch to commit to, which must exist locally or on `remote`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file in the repository (ie. `config/app.yaml`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the file, conflicts with `source`",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file to read the content of the file from, conflicts with `content`",
+				Optional:            true,
+			},
+			"executable": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the file is executable (default: false)",
+				Optional:            true,
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the commits (default: `Add <file>`, `Update <file>` or `Delete <file>`)",
+				Optional:            true,
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the author of the commits, and committer unless `committer_name` is set, defaults to `user.name` of the git config",
+				Optional:            true,
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the author of the commits, and committer unless `committer_email` is set, defaults to `user.email` of the git config",
+				Optional:            true,
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the committer of the commits, defaults to the author",
+				Optional:            true,
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the committer of the commits, defaults to the author",
+				Optional:            true,
+			},
+			"sign": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to sign the commits, false disables signing even when the provider sets a " +
+					"`signing_key`, true requires a signing key (default: signed when a signing key is set)",
+				Optional: true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key to sign the commits with, overriding the provider `signing_key`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"overwrite_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to take over a file that already exists on the branch when the " +
+					"resource is created, otherwise it is an error (default: false)",
+				Optional: true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote to keep the branch in sync with (ie. `origin`), by default commits stay local",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"blob": schema.StringAttribute{
+				MarkdownDescription: "Hash of the content of the file",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit that last wrote the file",
+				Computed:            true,
+			},
+			"ref_updates": refUpdatesAttribute(),
+		},
+	}
+}
+
+func (r *GitFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitFileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.File.IsUnknown() {
+		if err := gitutils.ValidTreePath(data.File.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file"), "invalid file", err.Error())
+		}
+	}
+
+	if data.Content.IsUnknown() || data.Source.IsUnknown() {
+		return
+	}
+	if data.Content.IsNull() == data.Source.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "invalid content", "exactly one of content or source must be set")
+	}
+}
+
+func (r *GitFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data GitFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Content.IsUnknown() || data.Source.IsUnknown() {
+		return
+	}
+
+	content, err := fileResourceContent(data.Content, data.Source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "unable to read source", err.Error())
+		return
+	}
+
+	// The blob tells content changes apart, including changes to the source
+	// file and to the file on the branch.
+	blob := plumbing.ComputeHash(plumbing.BlobObject, content).String()
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("blob"), blob)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state GitFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Blob.ValueString() != blob {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("commit"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ref_updates"), refUpdatesUnknown())...)
+	}
+}
+
+func (r *GitFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", repoPath, data.Branch.ValueString(), data.File.ValueString()))
+
+	resp.Diagnostics.Append(r.write(ctx, &data, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	refName := plumbing.NewBranchReferenceName(data.Branch.ValueString())
+	if data.Remote.ValueString() != "" {
+		refName, err = fetchBranch(ctx, repo, data.Remote.ValueString(), data.Branch.ValueString(), r.provider.repositoryAuth(data.Path, data.Username, data.Password))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to fetch", err.Error())
+			return
+		}
+	}
+
+	entry, err := branchFileEntry(repo, refName, data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read file", err.Error())
+		return
+	}
+	if entry == nil {
+		tflog.Warn(ctx, fmt.Sprintf("%s no longer exists on %s, removing it from state", data.File.ValueString(), refName.Short()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Blob = types.StringValue(entry.Hash.String())
+	if executable := entry.Mode == filemode.Executable; executable != data.Executable.ValueBool() {
+		data.Executable = types.BoolValue(executable)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GitFileResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := r.branchCommit(&data)
+	opts.Message = fileCommitMessage(data.CommitMessage, "Delete", data.File.ValueString())
+
+	repo, diags := r.provider.openBranchCommit(data.Path.ValueString(), opts, r.identity(&data))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	_, _, err = commitBranch(ctx, repo, opts, func(parent *object.Commit) (map[string]*gitutils.TreeChange, error) {
+		if _, err := parent.File(data.File.ValueString()); errors.Is(err, object.ErrFileNotFound) {
+			return nil, nil
+		}
+		return map[string]*gitutils.TreeChange{data.File.ValueString(): {}}, nil
+	})
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("branch %s no longer exists, nothing to delete", data.Branch.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to commit deletion", err.Error())
+		return
+	}
+}
+
+// write commits the file described by data, setting the commit that wrote
+// it, or keeping the commit of the prior state, nil when creating, when the
+// branch already has the file as described.
+func (r *GitFileResource) write(ctx context.Context, data *GitFileResourceModel, state *GitFileResourceModel) (diags diag.Diagnostics) {
+	content, err := fileResourceContent(data.Content, data.Source)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "unable to read source", err.Error())
+		return diags
+	}
+
+	opts := r.branchCommit(data)
+
+	repo, openDiags := r.provider.openBranchCommit(data.Path.ValueString(), opts, r.identity(data))
+	diags.Append(openDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		diags.AddError("unable to lock repository", err.Error())
+		return diags
+	}
+	defer unlock()
+
+	file := data.File.ValueString()
+	var tip plumbing.Hash
+	commit, updates, err := commitBranch(ctx, repo, opts, func(parent *object.Commit) (map[string]*gitutils.TreeChange, error) {
+		tip = parent.Hash
+		opts.Message = fileCommitMessage(data.CommitMessage, "Add", file)
+		if _, err := parent.File(file); err == nil {
+			if state == nil && !data.OverwriteOnCreate.ValueBool() {
+				return nil, fmt.Errorf("%s already exists on %s, set overwrite_on_create to manage it", file, data.Branch.ValueString())
+			}
+			opts.Message = fileCommitMessage(data.CommitMessage, "Update", file)
+		} else if !errors.Is(err, object.ErrFileNotFound) {
+			return nil, err
+		}
+
+		return map[string]*gitutils.TreeChange{file: {Content: content, Executable: data.Executable.ValueBool()}}, nil
+	})
+	if err != nil {
+		diags.AddError("unable to commit file", err.Error())
+		return diags
+	}
+
+	data.Blob = types.StringValue(plumbing.ComputeHash(plumbing.BlobObject, content).String())
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.RefUpdates = refUpdatesValue(updates)
+	if commit.Hash == tip && state != nil && state.Commit.ValueString() != "" {
+		tflog.Trace(ctx, fmt.Sprintf("%s is unchanged on %s", file, data.Branch.ValueString()))
+		data.Commit = state.Commit
+	}
+
+	return diags
+}
+
+// identity returns the identity configuration of the commits to create for
+// data.
+func (r *GitFileResource) identity(data *GitFileResourceModel) commitIdentityConfig {
+	return commitIdentityConfig{
+		AuthorName:           data.AuthorName,
+		AuthorEmail:          data.AuthorEmail,
+		CommitterName:        data.CommitterName,
+		CommitterEmail:       data.CommitterEmail,
+		Sign:                 data.Sign,
+		SigningKey:           data.SigningKey,
+		SigningKeyPassphrase: data.SigningKeyPassphrase,
+	}
+}
+
+// branchCommit returns the options of the commits to create for data.
+func (r *GitFileResource) branchCommit(data *GitFileResourceModel) *branchCommit {
+	return &branchCommit{
+		Branch: data.Branch.ValueString(),
+		Remote: data.Remote.ValueString(),
+		Auth:   r.provider.repositoryAuth(data.Path, data.Username, data.Password),
+	}
+}
+
+// fileResourceContent returns content, or the content of the source file when
+// it is null.
+func fileResourceContent(content types.String, source types.String) ([]byte, error) {
+	if source.IsNull() {
+		return []byte(content.ValueString()), nil
+	}
+
+	return os.ReadFile(source.ValueString())
+}
+
+// fileCommitMessage returns message, defaulting to `<verb> <file>`.
+func fileCommitMessage(message types.String, verb string, file string) string {
+	if message.ValueString() != "" {
+		return message.ValueString()
+	}
+	return fmt.Sprintf("%s %s", verb, file)
+}
@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitFileResourceConfig(path string, file string, options string) string {
+	return fmt.Sprintf(`
+resource "git_file" "test" {
+  path         = %[1]q
+  branch       = "master"
+  file         = %[2]q
+  author_name  = "Terraform"
+  author_email = "terraform@example.com"
+  %[3]s
+}
+`, path, file, options)
+}
+
+func TestAccGitFileResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	var written string
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckBranchFile(tempDir, "master", "config/app.yaml", ""),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitFileResourceConfig(tempDir, "README.md", `content = "replaced"`),
+				ExpectError: regexp.MustCompile("README.md already exists on master"),
+			},
+			{
+				Config:      testAccGitFileResourceConfig(tempDir, "../outside", `content = "outside"`),
+				ExpectError: regexp.MustCompile("invalid file"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitFileResourceConfig(tempDir, "config/app.yaml", `content = "a: 1\n"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "blob", plumbing.ComputeHash(plumbing.BlobObject, []byte("a: 1\n")).String()),
+					resource.TestCheckResourceAttrSet("git_file.test", "commit"),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.#", "1"),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.0.remote", ""),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.0.ref", "refs/heads/master"),
+					resource.TestCheckResourceAttrSet("git_file.test", "ref_updates.0.old"),
+					resource.TestCheckResourceAttrPair("git_file.test", "ref_updates.0.new", "git_file.test", "commit"),
+					testCheckBranchFile(tempDir, "master", "config/app.yaml", "a: 1\n"),
+					testCheckCommitMessage(tempDir, "master", "Add config/app.yaml"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitFileResourceConfig(tempDir, "config/app.yaml", `
+  content    = "a: 2\n"
+  executable = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "blob", plumbing.ComputeHash(plumbing.BlobObject, []byte("a: 2\n")).String()),
+					testCheckBranchFile(tempDir, "master", "config/app.yaml", "a: 2\n"),
+					testCheckCommitMessage(tempDir, "master", "Update config/app.yaml"),
+					func(s *terraform.State) error {
+						content, err := os.ReadFile(filepath.Join(tempDir, "config", "app.yaml"))
+						if err != nil {
+							return err
+						}
+						if string(content) != "a: 2\n" {
+							return fmt.Errorf("expected the working tree to be updated, got %q", content)
+						}
+						return nil
+					},
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config", "app.yaml"), []byte("a: 3\n"), 0755))
+					_, err := testCommitAll(tempDir, "manual change")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitFileResourceConfig(tempDir, "config/app.yaml", `
+  content    = "a: 2\n"
+  executable = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBranchFile(tempDir, "master", "config/app.yaml", "a: 2\n"),
+					func(s *terraform.State) error {
+						written = s.RootModule().Resources["git_file.test"].Primary.Attributes["commit"]
+						return nil
+					},
+				),
+			},
+			// Unchanged testing
+			{
+				PreConfig: func() {
+					_, err := testCommitFile(tempDir, "other.txt", "other")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitFileResourceConfig(tempDir, "config/app.yaml", `
+  content        = "a: 2\n"
+  executable     = true
+  commit_message = "Manage config/app.yaml"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("git_file.test", "commit", written)(s)
+					},
+					testCheckCommitMessage(tempDir, "master", "update other.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitFileResource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	originDir := filepath.Join(tempDir, "origin.git")
+	cloneDir := filepath.Join(tempDir, "clone.git")
+	otherDir := filepath.Join(tempDir, "other")
+
+	_, err = testSetupGit(sourceDir, "", 0)
+	assert.NoError(t, err)
+	_, err = git.PlainClone(originDir, true, &git.CloneOptions{URL: sourceDir})
+	assert.NoError(t, err)
+	_, err = git.PlainClone(cloneDir, true, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	source := filepath.Join(tempDir, "app.yaml")
+	assert.NoError(t, os.WriteFile(source, []byte("a: 1\n"), 0644))
+
+	// The commit pushed by someone else, the remote branch moves from it.
+	var pushed *plumbing.Hash
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckBranchFile(originDir, "master", "app.yaml", ""),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitFileResourceConfig(cloneDir, "app.yaml", fmt.Sprintf(`
+  source         = %q
+  remote         = "origin"
+  commit_message = "Manage app.yaml"
+`, source)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBranchFile(originDir, "master", "app.yaml", "a: 1\n"),
+					testCheckCommitMessage(originDir, "master", "Manage app.yaml"),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(source, []byte("a: 2\n"), 0644))
+
+					// Unrelated changes pushed in the meantime are kept.
+					other, err := git.PlainClone(otherDir, false, &git.CloneOptions{URL: originDir})
+					assert.NoError(t, err)
+					assert.NoError(t, os.WriteFile(filepath.Join(otherDir, "OTHER.md"), []byte("other"), 0644))
+					pushed, err = testCommitAll(otherDir, "other change")
+					assert.NoError(t, err)
+					assert.NoError(t, other.Push(&git.PushOptions{}))
+				},
+				Config: testAccGitFileResourceConfig(cloneDir, "app.yaml", fmt.Sprintf(`
+  source         = %q
+  remote         = "origin"
+  commit_message = "Manage app.yaml"
+`, source)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBranchFile(originDir, "master", "app.yaml", "a: 2\n"),
+					testCheckBranchFile(originDir, "master", "OTHER.md", "other"),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.#", "3"),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.1.ref", "refs/remotes/origin/master"),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.2.remote", "origin"),
+					resource.TestCheckResourceAttr("git_file.test", "ref_updates.2.ref", "refs/heads/master"),
+					resource.TestCheckResourceAttrPair("git_file.test", "ref_updates.2.new", "git_file.test", "commit"),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("git_file.test", "ref_updates.2.old", pushed.String())(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitFileResource3(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	entity, err := openpgp.NewEntity("Terraform", "", "terraform@example.com", nil)
+	assert.NoError(t, err)
+	signingKey, err := testArmoredPrivateKey(entity)
+	assert.NoError(t, err)
+	keyring, err := testArmoredPublicKey(entity)
+	assert.NoError(t, err)
+
+	providerConfig := fmt.Sprintf(`
+provider "git" {
+  signing_key = %q
+}
+`, signingKey)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitFileResourceConfig(tempDir, "app.yaml", `
+  content = "a: 1\n"
+  sign    = true
+`),
+				ExpectError: regexp.MustCompile("neither the resource nor the provider set a signing_key"),
+			},
+			// Signed with the key of the provider
+			{
+				Config: providerConfig + testAccGitFileResourceConfig(tempDir, "app.yaml", `
+  content         = "a: 1\n"
+  committer_name  = "CI"
+  committer_email = "ci@example.com"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBranchFile(tempDir, "master", "app.yaml", "a: 1\n"),
+					testCheckCommitIdentity(tempDir, "master", "Terraform", "CI", keyring),
+				),
+			},
+			// Signing disabled
+			{
+				Config: providerConfig + testAccGitFileResourceConfig(tempDir, "app.yaml", `
+  content = "a: 2\n"
+  sign    = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBranchFile(tempDir, "master", "app.yaml", "a: 2\n"),
+					testCheckCommitIdentity(tempDir, "master", "Terraform", "Terraform", ""),
+				),
+			},
+			// Signed with the key of the resource
+			{
+				Config: testAccGitFileResourceConfig(tempDir, "app.yaml", fmt.Sprintf(`
+  content     = "a: 3\n"
+  signing_key = %q
+`, signingKey)),
+				Check: testCheckCommitIdentity(tempDir, "master", "Terraform", "Terraform", keyring),
+			},
+		},
+	})
+}
+
+func TestAccGitFileResource4(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	// Resources committing to the same branch in parallel wait for each other.
+	config := fmt.Sprintf(`
+resource "git_file" "test" {
+  count        = 8
+  path         = %q
+  branch       = "master"
+  file         = "config/${count.index}.yaml"
+  content      = "index: ${count.index}\n"
+  author_name  = "Terraform"
+  author_email = "terraform@example.com"
+}
+`, tempDir)
+
+	var checks []resource.TestCheckFunc
+	for i := 0; i < 8; i++ {
+		checks = append(checks, testCheckBranchFile(tempDir, "master", fmt.Sprintf("config/%d.yaml", i), fmt.Sprintf("index: %d\n", i)))
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".git", "index.lock"), nil, 0644))
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile("index.lock exists"),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.Remove(filepath.Join(tempDir, ".git", "index.lock")))
+				},
+				Config: config,
+				Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// testCheckBranchFile checks the content of file on branch of the repository
+// at path, an empty content checking the file does not exist.
+func testCheckBranchFile(path string, branch string, file string, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		commit, err := resolveCommit(repo, branch)
+		if err != nil {
+			return err
+		}
+
+		f, err := commit.File(file)
+		if err == object.ErrFileNotFound && content == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		actual, err := f.Contents()
+		if err != nil {
+			return err
+		}
+		if actual != content {
+			return fmt.Errorf("expected %s on %s to be %q, got %q", file, branch, content, actual)
+		}
+
+		return nil
+	}
+}
+
+// testCheckCommitMessage checks the message of the commit branch points at.
+func testCheckCommitMessage(path string, branch string, message string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		commit, err := resolveCommit(repo, branch)
+		if err != nil {
+			return err
+		}
+
+		if commit.Message != message {
+			return fmt.Errorf("expected the commit message to be %q, got %q", message, commit.Message)
+		}
+
+		return nil
+	}
+}
+
+// testCheckCommitIdentity checks the author and committer names of the commit
+// branch points at, and that it is signed by keyring, or not signed when it is
+// empty.
+func testCheckCommitIdentity(path string, branch string, author string, committer string, keyring string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		commit, err := resolveCommit(repo, branch)
+		if err != nil {
+			return err
+		}
+
+		if commit.Author.Name != author {
+			return fmt.Errorf("expected the author to be %q, got %q", author, commit.Author.Name)
+		}
+		if commit.Committer.Name != committer {
+			return fmt.Errorf("expected the committer to be %q, got %q", committer, commit.Committer.Name)
+		}
+
+		if keyring != "" {
+			if _, err := commit.Verify(keyring); err != nil {
+				return fmt.Errorf("unable to verify %s: %v", commit.Hash.String(), err)
+			}
+		} else if commit.PGPSignature != "" {
+			return fmt.Errorf("expected %s not to be signed", commit.Hash.String())
+		}
+
+		return nil
+	}
+}
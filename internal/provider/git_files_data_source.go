@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitFiles{}
+
+func NewGitFiles() datasource.DataSource {
+	return &GitFiles{}
+}
+
+// GitFiles defines the data source implementation.
+type GitFiles struct {
+	provider *GitProviderData
+}
+
+// GitFilesModel describes the data source data model.
+type GitFilesModel struct {
+	Id        types.String                  `tfsdk:"id"`
+	Path      types.String                  `tfsdk:"path"`
+	Reference types.String                  `tfsdk:"ref"`
+	Include   []types.String                `tfsdk:"files"`
+	Exclude   []types.String                `tfsdk:"exclude"`
+	Commit    types.String                  `tfsdk:"commit"`
+	Files     map[string]GitFilesEntryModel `tfsdk:"contents"`
+}
+
+// GitFilesEntryModel describes a single file read.
+type GitFilesEntryModel struct {
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Size          types.Int64  `tfsdk:"size"`
+	Mode          types.String `tfsdk:"mode"`
+	Hash          types.String `tfsdk:"hash"`
+	SHA256        types.String `tfsdk:"sha256"`
+}
+
+func (d *GitFiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files"
+}
+
+func (d *GitFiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Files data source, reads several files as committed at a reference in a single read",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read the files at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"files": schema.ListAttribute{
+				MarkdownDescription: "Files to read, paths or globs (ie. `templates/**/*.tpl`) relative to the root of the repository, " +
+					"a directory reads every file below it. A path without wildcards that matches nothing is an error",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"exclude": schema.ListAttribute{
+				MarkdownDescription: "Do not read files matching any of these paths or globs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"contents": schema.MapNestedAttribute{
+				MarkdownDescription: "Files read, keyed by path relative to the root of the repository",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Content of the file",
+							Computed:            true,
+						},
+						"content_base64": schema.StringAttribute{
+							MarkdownDescription: "Base64 encoded content of the file, for binary files",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the file in bytes",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							MarkdownDescription: "Git file mode of the file (ie. `0100644`)",
+							Computed:            true,
+						},
+						"hash": schema.StringAttribute{
+							MarkdownDescription: "Hash of the blob holding the file content",
+							Computed:            true,
+						},
+						"sha256": schema.StringAttribute{
+							MarkdownDescription: "Hex encoded SHA256 checksum of the file content",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitFiles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitFiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitFilesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	files, err := commit.Files()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	include := toStrings(data.Include)
+	exclude := toStrings(data.Exclude)
+	matched := map[string]bool{}
+
+	data.Files = map[string]GitFilesEntryModel{}
+	if err := files.ForEach(func(file *object.File) error {
+		for _, pattern := range include {
+			if gitutils.MatchPath(pattern, file.Name) {
+				matched[pattern] = true
+			}
+		}
+
+		if !gitutils.MatchPaths(include, exclude, file.Name) {
+			return nil
+		}
+
+		content, err := file.Contents()
+		if err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("file: %s blob: %s", file.Name, file.Hash.String()))
+
+		sum := sha256.Sum256([]byte(content))
+		data.Files[file.Name] = GitFilesEntryModel{
+			Content:       types.StringValue(content),
+			ContentBase64: types.StringValue(base64.StdEncoding.EncodeToString([]byte(content))),
+			Size:          types.Int64Value(file.Size),
+			Mode:          types.StringValue(file.Mode.String()),
+			Hash:          types.StringValue(file.Hash.String()),
+			SHA256:        types.StringValue(hex.EncodeToString(sum[:])),
+		}
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to read files", err.Error())
+		return
+	}
+
+	for _, pattern := range include {
+		if !matched[pattern] && !strings.ContainsAny(pattern, "*?[") {
+			resp.Diagnostics.AddAttributeError(path.Root("files"), "unable to find file", fmt.Sprintf("%s at %s: %s", pattern, commit.Hash.String(), object.ErrFileNotFound.Error()))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitFilesDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_files" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitFilesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "templates", "nested"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "templates", "a.tpl"), []byte("a"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "templates", "b.txt"), []byte("b"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "templates", "nested", "c.tpl"), []byte("c"), 0644))
+	hash, err := testCommitAll(tempDir, "templates")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitFilesDataSourceConfig(tempDir, `files = ["templates/**/*.tpl", "README.md"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_files.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.%", "3"),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.templates/a.tpl.content", "a"),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.templates/nested/c.tpl.size", "1"),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.README.md.content_base64", "dGVzdGluZw=="),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.README.md.hash", "9a2c7732fab5bcd73ea3ed52d2d9599a4cc47666"),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.README.md.sha256", "cf80cd8aed482d5d1527d7dc72fceff84e6326592848447d2dc0b0e87dfc9a90"),
+				),
+			},
+			{
+				Config: testAccGitFilesDataSourceConfig(tempDir, `files = ["templates"]
+  exclude = ["templates/nested"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.%", "2"),
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.templates/b.txt.content", "b"),
+				),
+			},
+			{
+				Config: testAccGitFilesDataSourceConfig(tempDir, `files = ["templates/**/*.tpl"]
+  ref = "v1.0.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_files.test", "contents.%", "0"),
+				),
+			},
+			{
+				Config:      testAccGitFilesDataSourceConfig(tempDir, `files = ["missing.tpl"]`),
+				ExpectError: regexp.MustCompile("unable to find file"),
+			},
+		},
+	})
+}
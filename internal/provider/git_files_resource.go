@@ -0,0 +1,522 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitFilesResource{}
+var _ resource.ResourceWithModifyPlan = &GitFilesResource{}
+var _ resource.ResourceWithValidateConfig = &GitFilesResource{}
+
+func NewGitFilesResource() resource.Resource {
+	return &GitFilesResource{}
+}
+
+// GitFilesResource defines the resource implementation.
+type GitFilesResource struct {
+	provider *GitProviderData
+}
+
+// GitFilesResourceModel describes the resource data model.
+type GitFilesResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Path                 types.String `tfsdk:"path"`
+	Serialize            types.Bool   `tfsdk:"serialize"`
+	Branch               types.String `tfsdk:"branch"`
+	Files                types.Map    `tfsdk:"files"`
+	CommitMessage        types.String `tfsdk:"commit_message"`
+	AuthorName           types.String `tfsdk:"author_name"`
+	AuthorEmail          types.String `tfsdk:"author_email"`
+	CommitterName        types.String `tfsdk:"committer_name"`
+	CommitterEmail       types.String `tfsdk:"committer_email"`
+	Sign                 types.Bool   `tfsdk:"sign"`
+	SigningKey           types.String `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String `tfsdk:"signing_key_passphrase"`
+	OverwriteOnCreate    types.Bool   `tfsdk:"overwrite_on_create"`
+	Remote               types.String `tfsdk:"remote"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	Blobs                types.Map    `tfsdk:"blobs"`
+	Commit               types.String `tfsdk:"commit"`
+	RefUpdates           types.List   `tfsdk:"ref_updates"`
+}
+
+func (r *GitFilesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files"
+}
+
+func (r *GitFilesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Files resource, manages a set of files of a branch like `git_file` but with a single " +
+			"commit, and a single push, for every change to any of them. Files removed from `files` are deleted from the " +
+			"branch, destroying the resource commits the deletion of all of them",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch to commit to, which must exist locally or on `remote`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.MapAttribute{
+				MarkdownDescription: "Content of the files keyed by path in the repository (ie. `config/app.yaml`)",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the commits (default: `Add files`, `Update files` or `Delete files`)",
+				Optional:            true,
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the author of the commits, and committer unless `committer_name` is set, defaults to `user.name` of the git config",
+				Optional:            true,
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the author of the commits, and committer unless `committer_email` is set, defaults to `user.email` of the git config",
+				Optional:            true,
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the committer of the commits, defaults to the author",
+				Optional:            true,
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the committer of the commits, defaults to the author",
+				Optional:            true,
+			},
+			"sign": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to sign the commits, false disables signing even when the provider sets a " +
+					"`signing_key`, true requires a signing key (default: signed when a signing key is set)",
+				Optional: true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key to sign the commits with, overriding the provider `signing_key`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"overwrite_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to take over files that already exist on the branch when the " +
+					"resource is created, otherwise it is an error (default: false)",
+				Optional: true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote to keep the branch in sync with (ie. `origin`), by default commits stay local",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"blobs": schema.MapAttribute{
+				MarkdownDescription: "Hash of the content of the files keyed by path",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit that last wrote the files",
+				Computed:            true,
+			},
+			"ref_updates": refUpdatesAttribute(),
+		},
+	}
+}
+
+func (r *GitFilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitFilesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitFilesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for file := range data.Files.Elements() {
+		if err := gitutils.ValidTreePath(file); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("files").AtMapKey(file), "invalid file", err.Error())
+		}
+	}
+}
+
+func (r *GitFilesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data GitFilesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Files.IsUnknown() {
+		return
+	}
+
+	files := map[string]types.String{}
+	resp.Diagnostics.Append(data.Files.ElementsAs(ctx, &files, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The blobs tell content changes apart, including changes to the files on
+	// the branch.
+	blobs := map[string]string{}
+	for file, content := range files {
+		if content.IsUnknown() {
+			return
+		}
+		blobs[file] = plumbing.ComputeHash(plumbing.BlobObject, []byte(content.ValueString())).String()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("blobs"), blobs)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state GitFilesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateBlobs := state.Blobs.Elements()
+	changed := len(stateBlobs) != len(blobs)
+	for file, blob := range blobs {
+		if stateBlob, ok := stateBlobs[file].(types.String); !ok || stateBlob.ValueString() != blob {
+			changed = true
+		}
+	}
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("commit"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ref_updates"), refUpdatesUnknown())...)
+	}
+}
+
+func (r *GitFilesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitFilesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", repoPath, data.Branch.ValueString()))
+
+	resp.Diagnostics.Append(r.write(ctx, &data, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFilesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitFilesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	refName := plumbing.NewBranchReferenceName(data.Branch.ValueString())
+	if data.Remote.ValueString() != "" {
+		refName, err = fetchBranch(ctx, repo, data.Remote.ValueString(), data.Branch.ValueString(), r.provider.repositoryAuth(data.Path, data.Username, data.Password))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to fetch", err.Error())
+			return
+		}
+	}
+
+	ref, err := repo.Reference(refName, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("%s no longer exists, removing it from state", refName.Short()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read branch", err.Error())
+		return
+	}
+
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read branch", err.Error())
+		return
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	// Files missing from the branch are left out, so that they are planned to
+	// be committed again.
+	blobs := map[string]attr.Value{}
+	for file := range data.Files.Elements() {
+		entry, err := tree.FindEntry(file)
+		if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read file", err.Error())
+			return
+		}
+		if entry.Mode == filemode.Dir || entry.Mode == filemode.Submodule {
+			continue
+		}
+		blobs[file] = types.StringValue(entry.Hash.String())
+	}
+	data.Blobs = types.MapValueMust(types.StringType, blobs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GitFilesResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitFilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitFilesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := r.branchCommit(&data)
+	opts.Message = fileCommitMessage(data.CommitMessage, "Delete", "files")
+
+	repo, diags := r.provider.openBranchCommit(data.Path.ValueString(), opts, r.identity(&data))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	_, _, err = commitBranch(ctx, repo, opts, func(parent *object.Commit) (map[string]*gitutils.TreeChange, error) {
+		changes := map[string]*gitutils.TreeChange{}
+		for file := range data.Files.Elements() {
+			changes[file] = &gitutils.TreeChange{}
+		}
+		return changes, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to commit deletion", err.Error())
+		return
+	}
+}
+
+// write commits the files described by data in a single commit, deleting the
+// files of the prior state that are no longer managed, nil when creating.
+func (r *GitFilesResource) write(ctx context.Context, data *GitFilesResourceModel, state *GitFilesResourceModel) (diags diag.Diagnostics) {
+	opts := r.branchCommit(data)
+	opts.Message = fileCommitMessage(data.CommitMessage, "Update", "files")
+	if state == nil {
+		opts.Message = fileCommitMessage(data.CommitMessage, "Add", "files")
+	}
+
+	files := map[string]types.String{}
+	diags.Append(data.Files.ElementsAs(ctx, &files, false)...)
+
+	repo, openDiags := r.provider.openBranchCommit(data.Path.ValueString(), opts, r.identity(data))
+	diags.Append(openDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		diags.AddError("unable to lock repository", err.Error())
+		return diags
+	}
+	defer unlock()
+
+	commit, updates, err := commitBranch(ctx, repo, opts, func(parent *object.Commit) (map[string]*gitutils.TreeChange, error) {
+		changes := map[string]*gitutils.TreeChange{}
+		if state != nil {
+			for file := range state.Files.Elements() {
+				changes[file] = &gitutils.TreeChange{}
+			}
+		}
+
+		var existing []string
+		for file, content := range files {
+			if _, err := parent.File(file); err == nil {
+				existing = append(existing, file)
+			} else if !errors.Is(err, object.ErrFileNotFound) {
+				return nil, err
+			}
+			changes[file] = &gitutils.TreeChange{Content: []byte(content.ValueString())}
+		}
+
+		if state == nil && len(existing) > 0 && !data.OverwriteOnCreate.ValueBool() {
+			sort.Strings(existing)
+			return nil, fmt.Errorf("%s already exist on %s, set overwrite_on_create to manage them", strings.Join(existing, ", "), data.Branch.ValueString())
+		}
+
+		return changes, nil
+	})
+	if err != nil {
+		diags.AddError("unable to commit files", err.Error())
+		return diags
+	}
+
+	blobs := map[string]attr.Value{}
+	for file, content := range files {
+		blobs[file] = types.StringValue(plumbing.ComputeHash(plumbing.BlobObject, []byte(content.ValueString())).String())
+	}
+	data.Blobs = types.MapValueMust(types.StringType, blobs)
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.RefUpdates = refUpdatesValue(updates)
+
+	return diags
+}
+
+// identity returns the identity configuration of the commits to create for
+// data.
+func (r *GitFilesResource) identity(data *GitFilesResourceModel) commitIdentityConfig {
+	return commitIdentityConfig{
+		AuthorName:           data.AuthorName,
+		AuthorEmail:          data.AuthorEmail,
+		CommitterName:        data.CommitterName,
+		CommitterEmail:       data.CommitterEmail,
+		Sign:                 data.Sign,
+		SigningKey:           data.SigningKey,
+		SigningKeyPassphrase: data.SigningKeyPassphrase,
+	}
+}
+
+// branchCommit returns the options of the commits to create for data.
+func (r *GitFilesResource) branchCommit(data *GitFilesResourceModel) *branchCommit {
+	return &branchCommit{
+		Branch: data.Branch.ValueString(),
+		Remote: data.Remote.ValueString(),
+		Auth:   r.provider.repositoryAuth(data.Path, data.Username, data.Password),
+	}
+}
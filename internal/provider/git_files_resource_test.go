@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitFilesResourceConfig(path string, files string, options string) string {
+	return fmt.Sprintf(`
+resource "git_files" "test" {
+  path         = %[1]q
+  branch       = "master"
+  author_name  = "Terraform"
+  author_email = "terraform@example.com"
+  files        = %[2]s
+  %[3]s
+}
+`, path, files, options)
+}
+
+func TestAccGitFilesResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	originDir := filepath.Join(tempDir, "origin.git")
+	cloneDir := filepath.Join(tempDir, "clone.git")
+
+	head, err := testSetupGit(sourceDir, "", 0)
+	assert.NoError(t, err)
+	_, err = git.PlainClone(originDir, true, &git.CloneOptions{URL: sourceDir})
+	assert.NoError(t, err)
+	_, err = git.PlainClone(cloneDir, true, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testCheckBranchFile(originDir, "master", "a.txt", ""),
+			testCheckBranchFile(originDir, "master", "dir/c.txt", ""),
+			testCheckBranchFile(originDir, "master", "README.md", "testing"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitFilesResourceConfig(cloneDir, `{ "README.md" = "replaced", "a.txt" = "a" }`, `remote = "origin"`),
+				ExpectError: regexp.MustCompile("README.md already exist on master"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitFilesResourceConfig(cloneDir, `{ "a.txt" = "a", "dir/b.txt" = "b" }`, `remote = "origin"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_files.test", "blobs.%", "2"),
+					testCheckBranchFile(originDir, "master", "a.txt", "a"),
+					testCheckBranchFile(originDir, "master", "dir/b.txt", "b"),
+					testCheckCommitMessage(originDir, "master", "Add files"),
+					testCheckCommitDistance(originDir, "master", *head, 1),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitFilesResourceConfig(cloneDir, `{ "a.txt" = "A", "dir/c.txt" = "c" }`, `
+  remote         = "origin"
+  commit_message = "Sync files"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBranchFile(originDir, "master", "a.txt", "A"),
+					testCheckBranchFile(originDir, "master", "dir/b.txt", ""),
+					testCheckBranchFile(originDir, "master", "dir/c.txt", "c"),
+					testCheckCommitMessage(originDir, "master", "Sync files"),
+					testCheckCommitDistance(originDir, "master", *head, 2),
+				),
+			},
+		},
+	})
+}
+
+// testCheckCommitDistance checks branch of the repository at path is count
+// commits ahead of base.
+func testCheckCommitDistance(path string, branch string, base plumbing.Hash, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		tip, err := resolveCommit(repo, branch)
+		if err != nil {
+			return err
+		}
+		from, err := repo.CommitObject(base)
+		if err != nil {
+			return err
+		}
+
+		actual := 0
+		if err := walkRange(from, tip, func(c *object.Commit) error {
+			actual++
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if actual != count {
+			return fmt.Errorf("expected %s to be %d commits ahead, got %d", branch, count, actual)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitGitmodules{}
+
+func NewGitGitmodules() datasource.DataSource {
+	return &GitGitmodules{}
+}
+
+// GitGitmodules defines the data source implementation.
+type GitGitmodules struct {
+	provider *GitProviderData
+}
+
+// GitGitmodulesModel describes the data source data model.
+type GitGitmodulesModel struct {
+	Id         types.String                  `tfsdk:"id"`
+	Path       types.String                  `tfsdk:"path"`
+	Reference  types.String                  `tfsdk:"ref"`
+	Commit     types.String                  `tfsdk:"commit"`
+	Exists     types.Bool                    `tfsdk:"exists"`
+	Submodules []GitGitmodulesSubmoduleModel `tfsdk:"submodules"`
+}
+
+// GitGitmodulesSubmoduleModel describes a single declared submodule.
+type GitGitmodulesSubmoduleModel struct {
+	Name   types.String `tfsdk:"name"`
+	Path   types.String `tfsdk:"path"`
+	URL    types.String `tfsdk:"url"`
+	Branch types.String `tfsdk:"branch"`
+	Commit types.String `tfsdk:"commit"`
+}
+
+func (d *GitGitmodules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitmodules"
+}
+
+func (d *GitGitmodules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Gitmodules data source, parses the `.gitmodules` file committed at a reference, without " +
+			"requiring the submodules to be initialized or a working tree, so it works with bare clones. Use " +
+			"`git_submodules` for the status of checked out submodules",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read `.gitmodules` at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not `.gitmodules` exists at the reference",
+				Computed:            true,
+			},
+			"submodules": schema.ListNestedAttribute{
+				MarkdownDescription: "Submodules declared in `.gitmodules`, sorted by path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the submodule",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the submodule relative to the superproject",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the submodule",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "Branch configured for the submodule, empty when none is",
+							Computed:            true,
+						},
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Commit recorded for the submodule at the reference (gitlink), empty when the " +
+								"path holds none",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitGitmodules) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitGitmodules) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitGitmodulesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	data.Exists = types.BoolValue(false)
+	data.Submodules = []GitGitmodulesSubmoduleModel{}
+
+	file, err := tree.File(".gitmodules")
+	switch {
+	case err == object.ErrFileNotFound:
+		// no submodules are declared
+	case err != nil:
+		resp.Diagnostics.AddError("unable to read .gitmodules", err.Error())
+		return
+	default:
+		content, err := file.Contents()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read .gitmodules", err.Error())
+			return
+		}
+
+		modules := config.NewModules()
+		if err := modules.Unmarshal([]byte(content)); err != nil {
+			resp.Diagnostics.AddError("unable to parse .gitmodules", err.Error())
+			return
+		}
+
+		data.Exists = types.BoolValue(true)
+		for _, submodule := range modules.Submodules {
+			model := GitGitmodulesSubmoduleModel{
+				Name:   types.StringValue(submodule.Name),
+				Path:   types.StringValue(submodule.Path),
+				URL:    types.StringValue(submodule.URL),
+				Branch: types.StringValue(submodule.Branch),
+				Commit: types.StringValue(""),
+			}
+
+			entry, err := tree.FindEntry(submodule.Path)
+			switch {
+			case err == object.ErrDirectoryNotFound || err == object.ErrEntryNotFound:
+				// declared but never committed
+			case err != nil:
+				resp.Diagnostics.AddError("unable to read tree", err.Error())
+				return
+			case entry.Mode == filemode.Submodule:
+				model.Commit = types.StringValue(entry.Hash.String())
+			}
+
+			tflog.Trace(ctx, fmt.Sprintf("submodule: %s path: %s commit: %s", submodule.Name, submodule.Path, model.Commit.ValueString()))
+
+			data.Submodules = append(data.Submodules, model)
+		}
+	}
+
+	sort.Slice(data.Submodules, func(i, j int) bool {
+		return data.Submodules[i].Path.ValueString() < data.Submodules[j].Path.ValueString()
+	})
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitGitmodulesDataSourceConfig(path string, ref string) string {
+	return fmt.Sprintf(`
+data "git_gitmodules" "test" {
+  path = %[1]q
+  ref  = %[2]q
+}
+`, path, ref)
+}
+
+func TestAccGitGitmodulesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	bareDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(bareDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	subHash, err := testSetupSubmodule(tempDir, "modules/sub", nil)
+	assert.NoError(t, err)
+
+	_, err = git.PlainClone(bareDir, true, &git.CloneOptions{URL: tempDir})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitGitmodulesDataSourceConfig(tempDir, "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.#", "1"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.0.name", "modules/sub"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.0.path", "modules/sub"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.0.url", "https://example.com/modules/sub.git"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.0.branch", ""),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.0.commit", subHash.String()),
+				),
+			},
+			{
+				Config: testAccGitGitmodulesDataSourceConfig(tempDir, "HEAD~1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "exists", "false"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.#", "0"),
+				),
+			},
+			{
+				Config: testAccGitGitmodulesDataSourceConfig(bareDir, "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_gitmodules.test", "submodules.0.commit", subHash.String()),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitGrep{}
+
+func NewGitGrep() datasource.DataSource {
+	return &GitGrep{}
+}
+
+// GitGrep defines the data source implementation.
+type GitGrep struct {
+	provider *GitProviderData
+}
+
+// GitGrepModel describes the data source data model.
+type GitGrepModel struct {
+	Id           types.String        `tfsdk:"id"`
+	Path         types.String        `tfsdk:"path"`
+	Reference    types.String        `tfsdk:"ref"`
+	Pattern      types.String        `tfsdk:"pattern"`
+	IgnoreCase   types.Bool          `tfsdk:"ignore_case"`
+	IncludePaths []types.String      `tfsdk:"include_paths"`
+	ExcludePaths []types.String      `tfsdk:"exclude_paths"`
+	Commit       types.String        `tfsdk:"commit"`
+	Matches      []GitGrepMatchModel `tfsdk:"matches"`
+	Files        []types.String      `tfsdk:"files"`
+	MatchCount   types.Int64         `tfsdk:"match_count"`
+}
+
+// GitGrepMatchModel describes a line matching the pattern.
+type GitGrepMatchModel struct {
+	File    types.String `tfsdk:"file"`
+	Line    types.Int64  `tfsdk:"line"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (d *GitGrep) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grep"
+}
+
+func (d *GitGrep) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Grep data source, searches the files committed at a reference for lines matching a " +
+			"regular expression like `git grep -n <pattern> <ref>`, ie. to fail a plan when forbidden patterns are " +
+			"committed. Binary files are skipped",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to search, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression to search for, in [RE2 syntax](https://github.com/google/re2/wiki/Syntax), " +
+					"matched against each line",
+				Required: true,
+			},
+			"ignore_case": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to match without regard to case like `-i` (default: false)",
+				Optional:            true,
+			},
+			"include_paths": schema.ListAttribute{
+				MarkdownDescription: "Only search files matching any of these paths or globs (ie. `src/**/*.go`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"exclude_paths": schema.ListAttribute{
+				MarkdownDescription: "Do not search files matching any of these paths or globs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"matches": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching lines, sorted by file then line",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file": schema.StringAttribute{
+							MarkdownDescription: "Path of the file relative to the root of the repository",
+							Computed:            true,
+						},
+						"line": schema.Int64Attribute{
+							MarkdownDescription: "Line number, starting at 1",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Content of the line",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"files": schema.ListAttribute{
+				MarkdownDescription: "Files with at least one matching line, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"match_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of matching lines",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitGrep) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitGrep) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitGrepModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	expr := data.Pattern.ValueString()
+	if data.IgnoreCase.ValueBool() {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("pattern"), "invalid pattern", err.Error())
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	files, err := commit.Files()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	include := toStrings(data.IncludePaths)
+	exclude := toStrings(data.ExcludePaths)
+
+	data.Matches = []GitGrepMatchModel{}
+	var matchedFiles []string
+	if err := files.ForEach(func(file *object.File) error {
+		if !gitutils.MatchPaths(include, exclude, file.Name) {
+			return nil
+		}
+
+		binary, err := file.IsBinary()
+		if err != nil {
+			return err
+		}
+		if binary {
+			return nil
+		}
+
+		content, err := file.Contents()
+		if err != nil {
+			return err
+		}
+
+		matched := false
+		scanner := bufio.NewScanner(strings.NewReader(content))
+		scanner.Buffer(make([]byte, 64*1024), len(content)+1)
+		for line := int64(1); scanner.Scan(); line++ {
+			text := strings.TrimSuffix(scanner.Text(), "\r")
+			if !re.MatchString(text) {
+				continue
+			}
+
+			matched = true
+			data.Matches = append(data.Matches, GitGrepMatchModel{
+				File:    types.StringValue(file.Name),
+				Line:    types.Int64Value(line),
+				Content: types.StringValue(text),
+			})
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+
+		if matched {
+			tflog.Trace(ctx, fmt.Sprintf("file: %s matches", file.Name))
+			matchedFiles = append(matchedFiles, file.Name)
+		}
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to search files", err.Error())
+		return
+	}
+
+	// Trees are walked in git order, which sorts directories as if their name
+	// ended with a slash.
+	sort.SliceStable(data.Matches, func(i, j int) bool {
+		return data.Matches[i].File.ValueString() < data.Matches[j].File.ValueString()
+	})
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.Path.ValueString(), commit.Hash.String(), data.Pattern.ValueString()))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.Files = sortedStringValues(matchedFiles)
+	data.MatchCount = types.Int64Value(int64(len(data.Matches)))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
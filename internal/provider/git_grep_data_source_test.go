@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitGrepDataSourceConfig(path string, pattern string, options string) string {
+	return fmt.Sprintf(`
+data "git_grep" "test" {
+  path    = %[1]q
+  pattern = %[2]q
+  %[3]s
+}
+`, path, pattern, options)
+}
+
+func TestAccGitGrepDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "config"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config", "app.yaml"), []byte("name: app\npassword: hunter2\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.txt"), []byte("PASSWORD=secret\r\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "image.bin"), []byte("password\x00"), 0644))
+	commit, err := testCommitAll(tempDir, "add config")
+	assert.NoError(t, err)
+
+	// Uncommitted changes are not searched.
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("password"), 0644))
+
+	reg, err := regexp.Compile("invalid pattern")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitGrepDataSourceConfig(tempDir, "password", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_grep.test", "commit", commit.String()),
+					resource.TestCheckResourceAttr("data.git_grep.test", "match_count", "1"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.0.file", "config/app.yaml"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.0.line", "2"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.0.content", "password: hunter2"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "files.#", "1"),
+				),
+			},
+			{
+				Config: testAccGitGrepDataSourceConfig(tempDir, "password", "ignore_case = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_grep.test", "match_count", "2"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.0.file", "config.txt"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.0.content", "PASSWORD=secret"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.1.file", "config/app.yaml"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "files.0", "config.txt"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "files.1", "config/app.yaml"),
+				),
+			},
+			{
+				Config: testAccGitGrepDataSourceConfig(tempDir, "password", "ignore_case = true\n  exclude_paths = [\"config\"]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_grep.test", "match_count", "1"),
+					resource.TestCheckResourceAttr("data.git_grep.test", "matches.0.file", "config.txt"),
+				),
+			},
+			{
+				Config:      testAccGitGrepDataSourceConfig(tempDir, "(", ""),
+				ExpectError: reg,
+			},
+		},
+	})
+}
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitHook{}
+var _ resource.ResourceWithImportState = &GitHook{}
+var _ resource.ResourceWithModifyPlan = &GitHook{}
+var _ resource.ResourceWithValidateConfig = &GitHook{}
+
+func NewGitHook() resource.Resource {
+	return &GitHook{}
+}
+
+// GitHook defines the resource implementation.
+type GitHook struct {
+	provider *GitProviderData
+}
+
+// GitHookModel describes the resource data model.
+type GitHookModel struct {
+	Id      types.String `tfsdk:"id"`
+	Path    types.String `tfsdk:"path"`
+	Name    types.String `tfsdk:"name"`
+	Content types.String `tfsdk:"content"`
+	Source  types.String `tfsdk:"source"`
+	File    types.String `tfsdk:"file"`
+	SHA256  types.String `tfsdk:"sha256"`
+}
+
+func (r *GitHook) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hook"
+}
+
+func (r *GitHook) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Hook resource, installs an executable hook script in the directory git runs hooks from, " +
+			"`hooks` of the git directory or `core.hooksPath` when configured. A script modified, made non executable or " +
+			"removed outside of Terraform is installed again on the next apply, as is one left behind when " +
+			"`core.hooksPath` changes. Existing hooks are refused, import them with `<path>:<name>` to manage them",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the hook (ie. `pre-commit`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the script, conflicts with `source`",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file to read the content of the script from, conflicts with `content`",
+				Optional:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path the script is installed at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the content of the script",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GitHook) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitHook) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitHookModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.IsUnknown() && !isHookName(data.Name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "invalid name",
+			fmt.Sprintf("%q is not a hook git runs, expected one of %s", data.Name.ValueString(), strings.Join(gitutils.HookNames, ", ")))
+	}
+
+	if data.Content.IsUnknown() || data.Source.IsUnknown() {
+		return
+	}
+	if data.Content.IsNull() == data.Source.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "invalid content", "exactly one of content or source must be set")
+	}
+}
+
+func (r *GitHook) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data GitHookModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Content.IsUnknown() || data.Source.IsUnknown() {
+		return
+	}
+
+	content, err := fileResourceContent(data.Content, data.Source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "unable to read source", err.Error())
+		return
+	}
+
+	// The checksum tells content changes apart, including changes to the
+	// source file and to the installed script.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sha256"), hookChecksum(content))...)
+}
+
+func (r *GitHook) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitHookModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", repoPath, data.Name.ValueString()))
+
+	file, err := r.hookFile(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to determine hooks directory", err.Error())
+		return
+	}
+
+	if _, err := os.Lstat(file); err == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "hook already exists",
+			fmt.Sprintf("%s already exists, import it to manage it", file))
+		return
+	} else if !errors.Is(err, os.ErrNotExist) {
+		resp.Diagnostics.AddError("unable to read hook", err.Error())
+		return
+	}
+
+	if err := r.install(&data, file); err != nil {
+		resp.Diagnostics.AddError("unable to install hook", err.Error())
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitHook) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitHookModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.hookFile(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to determine hooks directory", err.Error())
+		return
+	}
+
+	// Imported hooks adopt the installed script.
+	if data.File.IsNull() {
+		data.File = types.StringValue(file)
+	}
+	if data.File.ValueString() != file {
+		tflog.Warn(ctx, fmt.Sprintf("hooks are now run from %s, removing %s from state", filepath.Dir(file), data.File.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	info, err := os.Stat(file)
+	if errors.Is(err, os.ErrNotExist) {
+		tflog.Warn(ctx, fmt.Sprintf("hook %s no longer exists, removing it from state", file))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read hook", err.Error())
+		return
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read hook", err.Error())
+		return
+	}
+
+	if data.Content.IsNull() && data.Source.IsNull() {
+		data.Content = types.StringValue(string(content))
+	}
+
+	data.SHA256 = types.StringValue(hookChecksum(content))
+	// git skips hooks that are not executable, which is drift as well.
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0111 == 0 {
+		data.SHA256 = types.StringValue("")
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitHook) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitHookModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.install(&data, data.File.ValueString()); err != nil {
+		resp.Diagnostics.AddError("unable to install hook", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitHook) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitHookModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := os.Remove(data.File.ValueString()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		resp.Diagnostics.AddError("unable to remove hook", err.Error())
+		return
+	}
+}
+
+func (r *GitHook) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Paths may hold colons, hook names do not.
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError("invalid import id", fmt.Sprintf("expected <path>:<name>, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID[i+1:])...)
+}
+
+// hookFile returns the path git runs the hook described by data from.
+func (r *GitHook) hookFile(data *GitHookModel) (string, error) {
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := gitutils.HooksDir(repo)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, data.Name.ValueString()), nil
+}
+
+// install writes the script described by data to file, making it executable,
+// and sets its checksum.
+func (r *GitHook) install(data *GitHookModel, file string) error {
+	content, err := fileResourceContent(data.Content, data.Source)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(file, content, 0755); err != nil {
+		return err
+	}
+	// WriteFile keeps the mode of existing files.
+	if err := os.Chmod(file, 0755); err != nil {
+		return err
+	}
+
+	data.File = types.StringValue(file)
+	data.SHA256 = types.StringValue(hookChecksum(content))
+
+	return nil
+}
+
+// isHookName returns whether git runs a hook called name.
+func isHookName(name string) bool {
+	for _, hook := range gitutils.HookNames {
+		if hook == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hookChecksum returns the hex encoded SHA-256 checksum of content.
+func hookChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitHookResourceConfig(path string, name string, options string) string {
+	return fmt.Sprintf(`
+resource "git_hook" "test" {
+  path = %[1]q
+  name = %[2]q
+  %[3]s
+}
+`, path, name, options)
+}
+
+func TestAccGitHookResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	repoDir := filepath.Join(tempDir, "repo")
+	_, err = testSetupGit(repoDir, "", 0)
+	assert.NoError(t, err)
+
+	hooksDir := filepath.Join(repoDir, ".git", "hooks")
+	assert.NoError(t, os.MkdirAll(hooksDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(hooksDir, "pre-push"), []byte("#!/bin/sh\n"), 0755))
+
+	source := filepath.Join(tempDir, "pre-commit")
+	assert.NoError(t, os.WriteFile(source, []byte("#!/bin/sh\nmake lint\n"), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckHook(filepath.Join(repoDir, ".githooks", "pre-commit"), ""),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitHookResourceConfig(repoDir, "pre-comit", `content = "#!/bin/sh\n"`),
+				ExpectError: regexp.MustCompile("invalid name"),
+			},
+			{
+				Config:      testAccGitHookResourceConfig(repoDir, "pre-push", `content = "#!/bin/sh\n"`),
+				ExpectError: regexp.MustCompile("hook already exists"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitHookResourceConfig(repoDir, "pre-commit", `content = "#!/bin/sh\nmake test\n"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_hook.test", "file", filepath.Join(hooksDir, "pre-commit")),
+					resource.TestCheckResourceAttr("git_hook.test", "sha256", hookChecksum([]byte("#!/bin/sh\nmake test\n"))),
+					testCheckHook(filepath.Join(hooksDir, "pre-commit"), "#!/bin/sh\nmake test\n"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitHookResourceConfig(repoDir, "pre-commit", fmt.Sprintf(`source = %q`, source)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_hook.test", "sha256", hookChecksum([]byte("#!/bin/sh\nmake lint\n"))),
+					testCheckHook(filepath.Join(hooksDir, "pre-commit"), "#!/bin/sh\nmake lint\n"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "git_hook.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:pre-commit", repoDir),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "source"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					file := filepath.Join(hooksDir, "pre-commit")
+					assert.NoError(t, os.WriteFile(file, []byte("#!/bin/sh\nexit 0\n"), 0644))
+					assert.NoError(t, os.Chmod(file, 0644))
+				},
+				Config: testAccGitHookResourceConfig(repoDir, "pre-commit", fmt.Sprintf(`source = %q`, source)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckHook(filepath.Join(hooksDir, "pre-commit"), "#!/bin/sh\nmake lint\n"),
+				),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.Chmod(filepath.Join(hooksDir, "pre-commit"), 0644))
+				},
+				Config: testAccGitHookResourceConfig(repoDir, "pre-commit", fmt.Sprintf(`source = %q`, source)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckHook(filepath.Join(hooksDir, "pre-commit"), "#!/bin/sh\nmake lint\n"),
+				),
+			},
+			{
+				PreConfig: func() {
+					repo, err := git.PlainOpen(repoDir)
+					assert.NoError(t, err)
+					cfg, err := repo.Config()
+					assert.NoError(t, err)
+					cfg.Raw.Section("core").SetOption("hooksPath", ".githooks")
+					assert.NoError(t, repo.Storer.SetConfig(cfg))
+				},
+				Config: testAccGitHookResourceConfig(repoDir, "pre-commit", fmt.Sprintf(`source = %q`, source)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_hook.test", "file", filepath.Join(repoDir, ".githooks", "pre-commit")),
+					testCheckHook(filepath.Join(repoDir, ".githooks", "pre-commit"), "#!/bin/sh\nmake lint\n"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckHook checks the content of the hook script file and that it is
+// executable, an empty content checking the file does not exist.
+func testCheckHook(file string, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := os.Stat(file)
+		if os.IsNotExist(err) && content == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode().Perm()&0111 == 0 {
+			return fmt.Errorf("expected %s to be executable, got mode %s", file, info.Mode())
+		}
+
+		actual, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if string(actual) != content {
+			return fmt.Errorf("expected %s to be %q, got %q", file, content, actual)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitInit{}
+var _ resource.ResourceWithImportState = &GitInit{}
+
+func NewGitInit() resource.Resource {
+	return &GitInit{}
+}
+
+// GitInit defines the resource implementation.
+type GitInit struct {
+	provider *GitProviderData
+}
+
+// GitInitModel describes the resource data model.
+type GitInitModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Path                 types.String `tfsdk:"path"`
+	Bare                 types.Bool   `tfsdk:"bare"`
+	InitialBranch        types.String `tfsdk:"initial_branch"`
+	InitialCommit        types.Bool   `tfsdk:"initial_commit"`
+	CommitMessage        types.String `tfsdk:"commit_message"`
+	AuthorName           types.String `tfsdk:"author_name"`
+	AuthorEmail          types.String `tfsdk:"author_email"`
+	CommitterName        types.String `tfsdk:"committer_name"`
+	CommitterEmail       types.String `tfsdk:"committer_email"`
+	Sign                 types.Bool   `tfsdk:"sign"`
+	SigningKey           types.String `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String `tfsdk:"signing_key_passphrase"`
+	Commit               types.String `tfsdk:"commit"`
+}
+
+func (r *GitInit) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_init"
+}
+
+func (r *GitInit) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Init resource, initializes a repository like `git init`, optionally with an empty " +
+			"initial commit so that the branch exists. Paths already holding a repository are refused, import them " +
+			"instead. Destroying the resource leaves the repository on disk, a repository removed outside of Terraform " +
+			"is initialized again",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to initialize the repository at, created if missing",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bare": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to create a bare repository (default: false)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"initial_branch": schema.StringAttribute{
+				MarkdownDescription: "Branch HEAD points at (default: master)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"initial_commit": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to create an empty initial commit on `initial_branch` (default: false)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the initial commit (default: Initial commit)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the author of the initial commit, defaults to `user.name` of the git config",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the author of the initial commit, defaults to `user.email` of the git config",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the committer of the initial commit, defaults to the author",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the committer of the initial commit, defaults to the author",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sign": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to sign the initial commit, false disables signing even when the provider sets a " +
+					"`signing_key`, true requires a signing key (default: signed when a signing key is set)",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key to sign the initial commit with, overriding the provider `signing_key`",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Initial commit, empty without `initial_commit`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GitInit) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitInit) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitInitModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch := data.InitialBranch.ValueString()
+	if branch == "" {
+		branch = plumbing.Master.Short()
+	}
+	if err := gitutils.ValidRefName(branch); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("initial_branch"), "invalid initial_branch", err.Error())
+		return
+	}
+
+	repo, err := git.PlainInit(data.Path.ValueString(), data.Bare.ValueBool())
+	if errors.Is(err, git.ErrRepositoryAlreadyExists) {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "repository already exists",
+			fmt.Sprintf("%s is already a git repository, import it to manage it", data.Path.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to initialize git repository", err.Error())
+		return
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName(branch))); err != nil {
+		resp.Diagnostics.AddError("unable to set HEAD", err.Error())
+		return
+	}
+
+	data.Id = data.Path
+	data.Commit = types.StringValue("")
+
+	if data.InitialCommit.ValueBool() {
+		identity, diags := r.provider.commitIdentity(repo, commitIdentityConfig{
+			AuthorName:           data.AuthorName,
+			AuthorEmail:          data.AuthorEmail,
+			CommitterName:        data.CommitterName,
+			CommitterEmail:       data.CommitterEmail,
+			Sign:                 data.Sign,
+			SigningKey:           data.SigningKey,
+			SigningKeyPassphrase: data.SigningKeyPassphrase,
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		message := data.CommitMessage.ValueString()
+		if message == "" {
+			message = "Initial commit"
+		}
+
+		treeHash, err := gitutils.WriteTree(repo.Storer, nil, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to write tree", err.Error())
+			return
+		}
+
+		hash, err := writeCommit(repo, &object.Commit{
+			Author:    *identity.Author,
+			Committer: *identity.Committer,
+			Message:   message,
+			TreeHash:  treeHash,
+		}, identity.SignKey)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to write commit", err.Error())
+			return
+		}
+
+		if err := repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(branch), hash)); err != nil {
+			resp.Diagnostics.AddError("unable to create branch", err.Error())
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("initial commit: %s", hash.String()))
+
+		data.Commit = types.StringValue(hash.String())
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitInit) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitInitModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		tflog.Warn(ctx, fmt.Sprintf("repository %s no longer exists, removing it from state", data.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	// Imported repositories adopt how they were initialized.
+	if data.Commit.IsNull() {
+		cfg, err := repo.Config()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read git config", err.Error())
+			return
+		}
+		if cfg.Core.IsBare {
+			data.Bare = types.BoolValue(true)
+		}
+
+		head, err := repo.Reference(plumbing.HEAD, false)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read HEAD", err.Error())
+			return
+		}
+		if head.Type() == plumbing.SymbolicReference && head.Target() != plumbing.Master {
+			data.InitialBranch = types.StringValue(head.Target().Short())
+		}
+
+		data.Commit = types.StringValue("")
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitInit) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitInitModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires replacement, nothing to update in place.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitInit) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The repository is left on disk.
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *GitInit) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("path"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
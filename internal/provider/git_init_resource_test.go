@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitInitResourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+resource "git_init" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitInitResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	existingDir := filepath.Join(tempDir, "existing")
+	repoDir := filepath.Join(tempDir, "repo")
+	bareDir := filepath.Join(tempDir, "bare.git")
+
+	_, err = testSetupGit(existingDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitInitResourceConfig(existingDir, ""),
+				ExpectError: regexp.MustCompile("is already a git repository"),
+			},
+			{
+				Config:      testAccGitInitResourceConfig(repoDir, `initial_branch = "bad..name"`),
+				ExpectError: regexp.MustCompile("invalid initial_branch"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitInitResourceConfig(repoDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_init.test", "commit", ""),
+					testCheckHead(repoDir, "refs/heads/master"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "git_init.test",
+				ImportState:       true,
+				ImportStateId:     repoDir,
+				ImportStateVerify: true,
+			},
+			// Replace and Read testing
+			{
+				Config: testAccGitInitResourceConfig(bareDir, `
+  bare           = true
+  initial_branch = "main"
+  initial_commit = true
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("git_init.test", "commit"),
+					testCheckHead(bareDir, "refs/heads/main"),
+					testCheckCommitMessage(bareDir, "main", "Initial commit"),
+					func(s *terraform.State) error {
+						repo, err := git.PlainOpen(bareDir)
+						if err != nil {
+							return err
+						}
+						if _, err := repo.Worktree(); err != git.ErrIsBareRepository {
+							return fmt.Errorf("expected a bare repository, got %v", err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitIsAncestor{}
+
+func NewGitIsAncestor() datasource.DataSource {
+	return &GitIsAncestor{}
+}
+
+// GitIsAncestor defines the data source implementation.
+type GitIsAncestor struct {
+	provider *GitProviderData
+}
+
+// GitIsAncestorModel describes the data source data model.
+type GitIsAncestorModel struct {
+	Id               types.String `tfsdk:"id"`
+	Path             types.String `tfsdk:"path"`
+	AncestorRef      types.String `tfsdk:"ancestor_ref"`
+	DescendantRef    types.String `tfsdk:"descendant_ref"`
+	AncestorCommit   types.String `tfsdk:"ancestor_commit"`
+	DescendantCommit types.String `tfsdk:"descendant_commit"`
+	IsAncestor       types.Bool   `tfsdk:"is_ancestor"`
+}
+
+func (d *GitIsAncestor) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_is_ancestor"
+}
+
+func (d *GitIsAncestor) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Is Ancestor data source, checks whether a commit is an ancestor of another (`git merge-base --is-ancestor`), ie. that a release includes a fix",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ancestor_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference expected to be the ancestor, a branch, tag or commit",
+				Required:            true,
+			},
+			"descendant_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference expected to be the descendant, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ancestor_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ancestor_ref` resolved to",
+				Computed:            true,
+			},
+			"descendant_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `descendant_ref` resolved to",
+				Computed:            true,
+			},
+			"is_ancestor": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not `ancestor_commit` is reachable from `descendant_commit`, true when both are the same commit",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitIsAncestor) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitIsAncestor) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitIsAncestorModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DescendantRef.ValueString() == "" {
+		data.DescendantRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	ancestor, err := resolveCommit(repo, data.AncestorRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ancestor_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	descendant, err := resolveCommit(repo, data.DescendantRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("descendant_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	isAncestor, err := ancestor.IsAncestor(descendant)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to check ancestry", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("%s is ancestor of %s: %t", ancestor.Hash.String(), descendant.Hash.String(), isAncestor))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), ancestor.Hash.String(), descendant.Hash.String()))
+	data.AncestorCommit = types.StringValue(ancestor.Hash.String())
+	data.DescendantCommit = types.StringValue(descendant.Hash.String())
+	data.IsAncestor = types.BoolValue(isAncestor)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitIsAncestorDataSourceConfig(path string, ancestorRef string, descendantRef string) string {
+	return fmt.Sprintf(`
+data "git_is_ancestor" "test" {
+  path           = %[1]q
+  ancestor_ref   = %[2]q
+  descendant_ref = %[3]q
+}
+`, path, ancestorRef, descendantRef)
+}
+
+func TestAccGitIsAncestorDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "fix.tf"), []byte("# fix"), 0644))
+	fix, err := testCommitAll(tempDir, "security fix")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(tempDir, "release", *base))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitIsAncestorDataSourceConfig(tempDir, fix.String(), "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_is_ancestor.test", "ancestor_commit", fix.String()),
+					resource.TestCheckResourceAttr("data.git_is_ancestor.test", "descendant_commit", fix.String()),
+					resource.TestCheckResourceAttr("data.git_is_ancestor.test", "is_ancestor", "true"),
+				),
+			},
+			{
+				Config: testAccGitIsAncestorDataSourceConfig(tempDir, base.String(), "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_is_ancestor.test", "is_ancestor", "true"),
+				),
+			},
+			{
+				Config: testAccGitIsAncestorDataSourceConfig(tempDir, fix.String(), "release"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_is_ancestor.test", "descendant_commit", base.String()),
+					resource.TestCheckResourceAttr("data.git_is_ancestor.test", "is_ancestor", "false"),
+				),
+			},
+		},
+	})
+}
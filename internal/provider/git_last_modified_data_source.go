@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitLastModified{}
+
+func NewGitLastModified() datasource.DataSource {
+	return &GitLastModified{}
+}
+
+// GitLastModified defines the data source implementation.
+type GitLastModified struct {
+	provider *GitProviderData
+}
+
+// GitLastModifiedModel describes the data source data model.
+type GitLastModifiedModel struct {
+	Id        types.String                        `tfsdk:"id"`
+	Path      types.String                        `tfsdk:"path"`
+	Reference types.String                        `tfsdk:"ref"`
+	Paths     []types.String                      `tfsdk:"paths"`
+	Commit    types.String                        `tfsdk:"commit"`
+	Files     map[string]GitLastModifiedFileModel `tfsdk:"files"`
+}
+
+// GitLastModifiedFileModel describes the last commit that modified a path.
+type GitLastModifiedFileModel struct {
+	Commit   types.String `tfsdk:"commit"`
+	Date     types.String `tfsdk:"date"`
+	DateUnix types.Int64  `tfsdk:"date_unix"`
+}
+
+func (d *GitLastModified) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_last_modified"
+}
+
+func (d *GitLastModified) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Last Modified data source, finds the last commit that modified each of a set of files or " +
+			"directories like `git log -1 -- <path>`, walking the history once for all of them. Commits are compared to " +
+			"their first parent and merge commits are left out like `git_file_history`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to start from, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "Paths of the files or directories relative to the root of the repository (ie. `modules/vpc`)",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"files": schema.MapNestedAttribute{
+				MarkdownDescription: "Last commit that modified each path, keyed by path. Paths never modified (ie. that do not " +
+					"exist) have an empty `commit`",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the last commit that modified the path",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Committer date of the commit (RFC3339)",
+							Computed:            true,
+						},
+						"date_unix": schema.Int64Attribute{
+							MarkdownDescription: "Committer date of the commit (Unix timestamp)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitLastModified) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitLastModified) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitLastModifiedModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	data.Files = map[string]GitLastModifiedFileModel{}
+
+	pending := map[string]bool{}
+	for _, p := range toStrings(data.Paths) {
+		pending[p] = true
+		data.Files[p] = GitLastModifiedFileModel{
+			Commit:   types.StringValue(""),
+			Date:     types.StringValue(""),
+			DateUnix: types.Int64Value(0),
+		}
+	}
+
+	iter, err := repo.Log(&git.LogOptions{
+		From: commit.Hash,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to get log", err.Error())
+		return
+	}
+
+	if err := iter.ForEach(func(c *object.Commit) error {
+		if len(pending) == 0 {
+			return storer.ErrStop
+		}
+		if c.NumParents() > 1 {
+			return nil
+		}
+
+		changes, err := firstParentChanges(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		for p := range pending {
+			name := strings.Trim(p, "/")
+			for _, change := range changes {
+				if !pathContains(name, change.From.Name) && !pathContains(name, change.To.Name) {
+					continue
+				}
+
+				tflog.Trace(ctx, fmt.Sprintf("path: %s last modified: %s", p, c.Hash.String()))
+
+				data.Files[p] = GitLastModifiedFileModel{
+					Commit:   types.StringValue(c.Hash.String()),
+					Date:     types.StringValue(c.Committer.When.Format(time.RFC3339)),
+					DateUnix: types.Int64Value(c.Committer.When.Unix()),
+				}
+				delete(pending, p)
+				break
+			}
+		}
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
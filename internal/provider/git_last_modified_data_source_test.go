@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitLastModifiedDataSourceConfig(path string) string {
+	return fmt.Sprintf(`
+data "git_last_modified" "test" {
+  path  = %[1]q
+  paths = ["README.md", "modules/vpc", "modules/eks/main.tf", "missing"]
+}
+`, path)
+}
+
+func TestAccGitLastModifiedDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	readme, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "modules", "vpc"), 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "modules", "eks"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "modules", "vpc", "main.tf"), []byte("# vpc"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "modules", "eks", "main.tf"), []byte("# eks"), 0644))
+	eks, err := testCommitAll(tempDir, "add modules")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "modules", "vpc", "variables.tf"), []byte("# variables"), 0644))
+	vpc, err := testCommitAll(tempDir, "add vpc variables")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitLastModifiedDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "commit", vpc.String()),
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "files.%", "4"),
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "files.README.md.commit", readme.String()),
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "files.modules/vpc.commit", vpc.String()),
+					resource.TestCheckResourceAttrSet("data.git_last_modified.test", "files.modules/vpc.date"),
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "files.modules/eks/main.tf.commit", eks.String()),
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "files.missing.commit", ""),
+					resource.TestCheckResourceAttr("data.git_last_modified.test", "files.missing.date_unix", "0"),
+				),
+			},
+		},
+	})
+}
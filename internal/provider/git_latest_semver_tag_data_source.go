@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitLatestSemverTag{}
+
+func NewGitLatestSemverTag() datasource.DataSource {
+	return &GitLatestSemverTag{}
+}
+
+// GitLatestSemverTag defines the data source implementation.
+type GitLatestSemverTag struct {
+	provider *GitProviderData
+}
+
+// GitLatestSemverTagModel describes the data source data model.
+type GitLatestSemverTagModel struct {
+	Id         types.String `tfsdk:"id"`
+	Path       types.String `tfsdk:"path"`
+	Prefix     types.String `tfsdk:"prefix"`
+	Constraint types.String `tfsdk:"constraint"`
+	Exists     types.Bool   `tfsdk:"exists"`
+	Tag        types.String `tfsdk:"tag"`
+	Version    types.String `tfsdk:"version"`
+	Commit     types.String `tfsdk:"commit"`
+}
+
+func (d *GitLatestSemverTag) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latest_semver_tag"
+}
+
+func (d *GitLatestSemverTag) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Latest Semver Tag data source, finds the highest version among the local tags, ie. to " +
+			"version the components of a monorepo tagged like `api/v1.2.3`. Tags that are not versions once `prefix` is " +
+			"removed are ignored, prereleases are only considered when `constraint` includes one (ie. `>= 2.0.0-0`)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "Only consider tags starting with this prefix (ie. `api/`), it is removed before parsing the version",
+				Optional:            true,
+			},
+			"constraint": schema.StringAttribute{
+				MarkdownDescription: "Only consider versions satisfying this constraint (ie. `>= 1.2, < 2.0` or `~> 1.2`)",
+				Optional:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not a matching tag was found",
+				Computed:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Name of the highest matching tag (ie. `api/v1.4.2`), empty when none matches",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of `tag` without prefix (ie. `1.4.2`), empty when none matches",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `tag` points at, annotated tags are peeled, empty when none matches",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitLatestSemverTag) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitLatestSemverTag) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitLatestSemverTagModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var constraint *semver.Constraints
+	if data.Constraint.ValueString() != "" {
+		c, err := semver.NewConstraint(data.Constraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("constraint"), "invalid constraint", err.Error())
+			return
+		}
+		constraint = c
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	latest, err := latestLocalTag(repo, data.Prefix.ValueString(), constraint)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tags", err.Error())
+		return
+	}
+
+	data.Exists = types.BoolValue(latest != nil)
+	data.Tag = types.StringValue("")
+	data.Version = types.StringValue("")
+	data.Commit = types.StringValue("")
+
+	if latest != nil {
+		tflog.Trace(ctx, fmt.Sprintf("tag: %s commit: %s", latest.Name, latest.Commit.String()))
+
+		data.Tag = types.StringValue(latest.Name)
+		data.Version = types.StringValue(latest.Version.String())
+		data.Commit = types.StringValue(latest.Commit.String())
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), data.Tag.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// latestLocalTag returns the highest version among the tags of repo starting
+// with prefix and matching the constraint, nil when there is none. The prefix
+// is removed before parsing the version, prereleases are only considered when
+// the constraint includes one.
+func latestLocalTag(repo *git.Repository, prefix string, constraint *semver.Constraints) (*versionTag, error) {
+	iter, err := repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *versionTag
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		tag := ref.Name().Short()
+		if !strings.HasPrefix(tag, prefix) {
+			return nil
+		}
+
+		version, err := semver.NewVersion(strings.TrimPrefix(tag, prefix))
+		if err != nil {
+			return nil
+		}
+		if constraint != nil && !constraint.Check(version) || constraint == nil && version.Prerelease() != "" {
+			return nil
+		}
+
+		// Equal versions (ie. 1.2 and v1.2.0) are ordered by name to keep
+		// the result stable.
+		if latest == nil || version.GreaterThan(latest.Version) || version.Equal(latest.Version) && tag < latest.Name {
+			latest = &versionTag{Name: tag, Version: version, Commit: ref.Hash()}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil {
+		// annotated tags point at a tag object, peel it to the commit
+		if obj, err := repo.TagObject(latest.Commit); err == nil {
+			commit, err := obj.Commit()
+			if err != nil {
+				return nil, err
+			}
+			latest.Commit = commit.Hash
+		} else if err != plumbing.ErrObjectNotFound {
+			return nil, err
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitLatestSemverTagDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_latest_semver_tag" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitLatestSemverTagDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	first, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCommitMessages(tempDir, "feat: api", "feat: web"))
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	head, err := repo.Head()
+	assert.NoError(t, err)
+
+	_, err = repo.CreateTag("api/v1.0.0", *first, nil)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("api/v1.2.0", head.Hash(), &git.CreateTagOptions{Message: "api/v1.2.0"})
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("api/v2.0.0-rc.1", head.Hash(), nil)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("web/v3.0.0", head.Hash(), nil)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitLatestSemverTagDataSourceConfig(tempDir, `constraint = "not a constraint"`),
+				ExpectError: regexp.MustCompile("invalid constraint"),
+			},
+			{
+				Config: testAccGitLatestSemverTagDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "version", "1.0.0"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "commit", first.String()),
+				),
+			},
+			{
+				Config: testAccGitLatestSemverTagDataSourceConfig(tempDir, `prefix = "api/"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "tag", "api/v1.2.0"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "version", "1.2.0"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "commit", head.Hash().String()),
+				),
+			},
+			{
+				Config: testAccGitLatestSemverTagDataSourceConfig(tempDir, `
+  prefix     = "api/"
+  constraint = ">= 2.0.0-0"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "tag", "api/v2.0.0-rc.1"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "version", "2.0.0-rc.1"),
+				),
+			},
+			{
+				Config: testAccGitLatestSemverTagDataSourceConfig(tempDir, `
+  prefix     = "api/"
+  constraint = "~> 1.0.0"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "tag", "api/v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "commit", first.String()),
+				),
+			},
+			{
+				Config: testAccGitLatestSemverTagDataSourceConfig(tempDir, `prefix = "worker/"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "exists", "false"),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "tag", ""),
+					resource.TestCheckResourceAttr("data.git_latest_semver_tag.test", "version", ""),
+				),
+			},
+		},
+	})
+}
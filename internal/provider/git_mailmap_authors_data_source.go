@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitMailmapAuthors{}
+
+func NewGitMailmapAuthors() datasource.DataSource {
+	return &GitMailmapAuthors{}
+}
+
+// GitMailmapAuthors defines the data source implementation.
+type GitMailmapAuthors struct {
+	provider *GitProviderData
+}
+
+// GitMailmapAuthorsModel describes the data source data model.
+type GitMailmapAuthorsModel struct {
+	Id          types.String            `tfsdk:"id"`
+	Path        types.String            `tfsdk:"path"`
+	FromRef     types.String            `tfsdk:"from_ref"`
+	ToRef       types.String            `tfsdk:"to_ref"`
+	CommitCount types.Int64             `tfsdk:"commit_count"`
+	Authors     []GitMailmapAuthorModel `tfsdk:"authors"`
+}
+
+// GitMailmapAuthorModel describes the commits of a single canonical author.
+type GitMailmapAuthorModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Email       types.String   `tfsdk:"email"`
+	Aliases     []types.String `tfsdk:"aliases"`
+	CommitCount types.Int64    `tfsdk:"commit_count"`
+}
+
+func (d *GitMailmapAuthors) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mailmap_authors"
+}
+
+func (d *GitMailmapAuthors) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Mailmap Authors data source, aggregates commit authors over a range of commits " +
+			"(`from_ref..to_ref`) under their canonical identity according to the `.mailmap` of the repository, " +
+			"like `git shortlog -se`. The `.mailmap` of the worktree is used, the one committed at HEAD for bare repositories",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, a branch, tag or commit (default: the whole history)",
+				Optional:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Include commits reachable from this reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits in the range",
+				Computed:            true,
+			},
+			"authors": schema.ListNestedAttribute{
+				MarkdownDescription: "Canonical authors of the commits in the range, sorted by commit count then email",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Canonical name of the author, the name used on their most recent commit when " +
+								"the `.mailmap` does not set one",
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Canonical email of the author",
+							Computed:            true,
+						},
+						"aliases": schema.ListAttribute{
+							MarkdownDescription: "Identities the commits of the author are recorded with (ie. `Jane <jane@old.example.com>`), sorted",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"commit_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of commits by the author in the range",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitMailmapAuthors) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitMailmapAuthors) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitMailmapAuthorsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var from *object.Commit
+	if data.FromRef.ValueString() != "" {
+		from, err = resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	mailmap, err := readMailmap(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read mailmap", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("mailmap emails: %d", mailmap.Len()))
+
+	type author struct {
+		name    string
+		email   string
+		aliases map[string]bool
+		count   int64
+		last    time.Time
+	}
+
+	total := int64(0)
+	authors := map[string]*author{}
+	if err := walkRange(from, to, func(c *object.Commit) error {
+		total++
+
+		name, email := mailmap.Resolve(c.Author.Name, c.Author.Email)
+
+		key := strings.ToLower(email)
+		entry, ok := authors[key]
+		if !ok {
+			entry = &author{name: name, email: email, aliases: map[string]bool{}, last: c.Author.When}
+			authors[key] = entry
+		}
+
+		entry.count++
+		entry.aliases[fmt.Sprintf("%s <%s>", c.Author.Name, c.Author.Email)] = true
+		if !c.Author.When.Before(entry.last) {
+			entry.last = c.Author.When
+			entry.name = name
+		}
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Authors = []GitMailmapAuthorModel{}
+	for _, entry := range authors {
+		tflog.Trace(ctx, fmt.Sprintf("author: %s commits: %d aliases: %d", entry.email, entry.count, len(entry.aliases)))
+
+		aliases := make([]string, 0, len(entry.aliases))
+		for alias := range entry.aliases {
+			aliases = append(aliases, alias)
+		}
+
+		data.Authors = append(data.Authors, GitMailmapAuthorModel{
+			Name:        types.StringValue(entry.name),
+			Email:       types.StringValue(entry.email),
+			Aliases:     sortedStringValues(aliases),
+			CommitCount: types.Int64Value(entry.count),
+		})
+	}
+
+	sort.Slice(data.Authors, func(i, j int) bool {
+		a, b := data.Authors[i], data.Authors[j]
+		if a.CommitCount.ValueInt64() != b.CommitCount.ValueInt64() {
+			return a.CommitCount.ValueInt64() > b.CommitCount.ValueInt64()
+		}
+		return strings.ToLower(a.Email.ValueString()) < strings.ToLower(b.Email.ValueString())
+	})
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), to.Hash.String()))
+	data.CommitCount = types.Int64Value(total)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readMailmap reads the `.mailmap` of the worktree of repo, or the one
+// committed at HEAD for bare repositories like git does. A missing file is an
+// empty mailmap.
+func readMailmap(repo *git.Repository) (*gitutils.Mailmap, error) {
+	wt, err := repo.Worktree()
+	if err == git.ErrIsBareRepository {
+		head, err := resolveCommit(repo, "HEAD")
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := head.File(".mailmap")
+		if err == object.ErrFileNotFound {
+			return gitutils.ParseMailmap(""), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := file.Contents()
+		if err != nil {
+			return nil, err
+		}
+		return gitutils.ParseMailmap(content), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := wt.Filesystem.Open(".mailmap")
+	if os.IsNotExist(err) {
+		return gitutils.ParseMailmap(""), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	//noinspection GoUnhandledErrorResult
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return gitutils.ParseMailmap(string(content)), nil
+}
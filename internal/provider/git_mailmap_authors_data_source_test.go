@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitMailmapAuthorsDataSourceConfig(path string, fromRef string) string {
+	return fmt.Sprintf(`
+data "git_mailmap_authors" "test" {
+  path     = %[1]q
+  from_ref = %[2]q
+}
+`, path, fromRef)
+}
+
+func TestAccGitMailmapAuthorsDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	commits := []object.Signature{
+		{Name: "Jane Doe", Email: "jane@old.example.com", When: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "jdoe", Email: "JANE@work.example.com", When: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Other Name", Email: "jane@work.example.com", When: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Bob", Email: "bob@example.com", When: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, author := range commits {
+		author := author
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte(fmt.Sprintf("change %d", i)), 0644))
+		_, err = wt.Commit(fmt.Sprintf("change %d", i), &git.CommitOptions{
+			All:    true,
+			Author: &author,
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".mailmap"), []byte(`# canonical identities
+Jane Doe <jane@example.com> <jane@old.example.com>
+Jane Doe <jane@example.com> jdoe <jane@work.example.com>
+Robert <bob@example.com>
+`), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitMailmapAuthorsDataSourceConfig(tempDir, "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "commit_count", "4"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.#", "3"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.0.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.0.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.0.commit_count", "2"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.0.aliases.#", "2"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.0.aliases.0", "Jane Doe <jane@old.example.com>"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.0.aliases.1", "jdoe <JANE@work.example.com>"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.1.name", "Robert"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.1.email", "bob@example.com"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.2.name", "Other Name"),
+					resource.TestCheckResourceAttr("data.git_mailmap_authors.test", "authors.2.email", "jane@work.example.com"),
+				),
+			},
+		},
+	})
+}
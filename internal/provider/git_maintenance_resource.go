@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitMaintenance{}
+var _ resource.ResourceWithModifyPlan = &GitMaintenance{}
+
+func NewGitMaintenance() resource.Resource {
+	return &GitMaintenance{}
+}
+
+// GitMaintenance defines the resource implementation.
+type GitMaintenance struct {
+	provider *GitProviderData
+}
+
+// GitMaintenanceModel describes the resource data model.
+type GitMaintenanceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Path                  types.String `tfsdk:"path"`
+	Serialize             types.Bool   `tfsdk:"serialize"`
+	LooseObjectsThreshold types.Int64  `tfsdk:"loose_objects_threshold"`
+	PacksThreshold        types.Int64  `tfsdk:"packs_threshold"`
+	PruneExpireDays       types.Int64  `tfsdk:"prune_expire_days"`
+	ReflogExpireDays      types.Int64  `tfsdk:"reflog_expire_days"`
+	LooseObjects          types.Int64  `tfsdk:"loose_objects"`
+	Packs                 types.Int64  `tfsdk:"packs"`
+}
+
+func (r *GitMaintenance) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance"
+}
+
+func (r *GitMaintenance) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Maintenance resource, keeps a repository compact like `git gc` does: expires old " +
+			"reflog entries, packs references, repacks the objects reachable from references, reflogs, indexes and " +
+			"working trees into a single pack and prunes unreachable loose objects once expired. Maintenance runs when " +
+			"the resource is created or updated, and whenever the repository exceeds one of the thresholds, which is " +
+			"checked on every plan. Like `git gc`, maintenance holds `gc.pid` while it runs and refuses to run while " +
+			"another git process holds a lock on the repository. Shallow repositories are not supported. Destroying " +
+			"the resource leaves the repository as it is",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, " +
+					"false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"loose_objects_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Run maintenance when the repository has more loose objects than this, like " +
+					"`gc.auto`, 0 disables it (default: 6700)",
+				Optional: true,
+			},
+			"packs_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Run maintenance when the repository has more packs than this, like " +
+					"`gc.autoPackLimit`, 0 disables it (default: 50)",
+				Optional: true,
+			},
+			"prune_expire_days": schema.Int64Attribute{
+				MarkdownDescription: "Age in days after which unreachable loose objects are pruned (default: 14)",
+				Optional:            true,
+			},
+			"reflog_expire_days": schema.Int64Attribute{
+				MarkdownDescription: "Age in days after which reflog entries are expired (default: 90)",
+				Optional:            true,
+			},
+			"loose_objects": schema.Int64Attribute{
+				MarkdownDescription: "Number of loose objects of the repository",
+				Computed:            true,
+			},
+			"packs": schema.Int64Attribute{
+				MarkdownDescription: "Number of packs of the repository",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GitMaintenance) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitMaintenance) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying or creating.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data GitMaintenanceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.LooseObjects.IsUnknown() || data.Packs.IsUnknown() {
+		return
+	}
+
+	// The state holds the counts of the last refresh, maintenance is planned
+	// once they exceed a threshold.
+	looseObjects := int64Value(data.LooseObjectsThreshold, 6700)
+	packs := int64Value(data.PacksThreshold, 50)
+	if (looseObjects > 0 && data.LooseObjects.ValueInt64() > looseObjects) || (packs > 0 && data.Packs.ValueInt64() > packs) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("loose_objects"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("packs"), types.Int64Unknown())...)
+	}
+}
+
+func (r *GitMaintenance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitMaintenanceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(repoPath)
+
+	resp.Diagnostics.Append(r.maintain(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitMaintenance) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitMaintenanceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(countObjects(repo, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitMaintenance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitMaintenanceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.maintain(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitMaintenance) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Maintenance can not be undone, the repository is left as it is.
+}
+
+// maintain runs maintenance on the repository of data and counts its objects
+// afterwards.
+func (r *GitMaintenance) maintain(ctx context.Context, data *GitMaintenanceModel) (diags diag.Diagnostics) {
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		diags.AddError("unable to open git repository", err.Error())
+		return diags
+	}
+
+	gitDir, err := gitutils.GitDir(repo)
+	if err != nil {
+		diags.AddError("unable to determine git directory", err.Error())
+		return diags
+	}
+
+	commonDir, err := gitutils.CommonDir(repo)
+	if err != nil {
+		diags.AddError("unable to determine git directory", err.Error())
+		return diags
+	}
+
+	// Only the other resources are waited for, the lock files of git
+	// processes make LockMaintenance fail instead.
+	if data.Serialize.IsNull() || data.Serialize.ValueBool() {
+		defer r.provider.locks().lock(commonDir)()
+	}
+
+	unlock, err := gitutils.LockMaintenance(commonDir, gitDir)
+	if err != nil {
+		diags.AddError("unable to lock repository", err.Error())
+		return diags
+	}
+	defer unlock()
+
+	now := time.Now()
+
+	expired, err := gitutils.ExpireReflogs(commonDir, now.AddDate(0, 0, -int(int64Value(data.ReflogExpireDays, 90))))
+	if err != nil {
+		diags.AddError("unable to expire reflogs", err.Error())
+		return diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("expired %d reflog entries", expired))
+
+	if err := repo.Storer.PackRefs(); err != nil {
+		diags.AddError("unable to pack references", err.Error())
+		return diags
+	}
+
+	packed, err := gitutils.Repack(repo)
+	if err != nil {
+		diags.AddError("unable to repack objects", err.Error())
+		return diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("packed %d objects", packed))
+
+	pruned, err := gitutils.PruneObjects(repo, now.AddDate(0, 0, -int(int64Value(data.PruneExpireDays, 14))))
+	if err != nil {
+		diags.AddError("unable to prune objects", err.Error())
+		return diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("pruned %d objects", pruned))
+
+	return countObjects(repo, data)
+}
+
+// countObjects sets the loose objects and packs of data from repo.
+func countObjects(repo *git.Repository, data *GitMaintenanceModel) (diags diag.Diagnostics) {
+	counts, err := gitutils.CountObjects(repo)
+	if err != nil {
+		diags.AddError("unable to count objects", err.Error())
+		return diags
+	}
+
+	data.LooseObjects = types.Int64Value(int64(counts.LooseObjects))
+	data.Packs = types.Int64Value(int64(counts.Packs))
+
+	return diags
+}
+
+// int64Value returns the value of v, def when it is not set.
+func int64Value(v types.Int64, def int64) int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	return v.ValueInt64()
+}
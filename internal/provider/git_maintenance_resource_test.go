@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitMaintenanceResourceConfig(path string) string {
+	return fmt.Sprintf(`
+resource "git_maintenance" "test" {
+  path                    = %[1]q
+  loose_objects_threshold = 5
+}
+`, path)
+}
+
+// testWriteBlob writes content as a loose blob of repo last modified at when.
+func testWriteBlob(repo *git.Repository, path string, content string, when time.Time) (plumbing.Hash, error) {
+	obj := repo.Storer.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+	w, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if _, err := w.Write([]byte(content)); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if err := w.Close(); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	hash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	loose := filepath.Join(path, ".git", "objects", hash.String()[:2], hash.String()[2:])
+	return hash, os.Chtimes(loose, when, when)
+}
+
+func TestAccGitMaintenanceResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	head, err := testSetupGit(tempDir, "v1.0.0", 3)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	old := time.Now().AddDate(0, 0, -30)
+	expired, err := testWriteBlob(repo, tempDir, "expired", old)
+	assert.NoError(t, err)
+	recent, err := testWriteBlob(repo, tempDir, "recent", time.Now())
+	assert.NoError(t, err)
+
+	// A staged file is only referenced by the index.
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "staged.txt"), []byte("staged"), 0644))
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+	staged, err := wt.Add("staged.txt")
+	assert.NoError(t, err)
+
+	// A commit of a deleted branch is only referenced by its reflog.
+	headCommit, err := repo.CommitObject(*head)
+	assert.NoError(t, err)
+	obj := repo.Storer.NewEncodedObject()
+	assert.NoError(t, (&object.Commit{
+		Author:       headCommit.Author,
+		Committer:    headCommit.Committer,
+		Message:      "deleted",
+		TreeHash:     headCommit.TreeHash,
+		ParentHashes: []plumbing.Hash{*head},
+	}).Encode(obj))
+	deleted, err := repo.Storer.SetEncodedObject(obj)
+	assert.NoError(t, err)
+
+	reflog := filepath.Join(tempDir, ".git", "logs", "refs", "heads", "deleted")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(reflog), 0755))
+	assert.NoError(t, os.WriteFile(reflog, []byte(fmt.Sprintf(
+		"%[1]s %[2]s Test <test@example.com> %[3]d +0000\tbranch: Created from HEAD\n"+
+			"%[2]s %[4]s Test <test@example.com> %[5]d +0000\tcommit: deleted\n",
+		plumbing.ZeroHash, *head, old.AddDate(0, 0, -90).Unix(), deleted, time.Now().Unix())), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitMaintenanceResourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_maintenance.test", "id", tempDir),
+					resource.TestCheckResourceAttr("git_maintenance.test", "packs", "1"),
+					resource.TestCheckResourceAttr("git_maintenance.test", "loose_objects", "1"),
+					testCheckObjects(tempDir, map[plumbing.Hash]bool{
+						*head:   true,
+						staged:  true,
+						deleted: true,
+						recent:  true,
+						expired: false,
+					}),
+					func(s *terraform.State) error {
+						content, err := os.ReadFile(reflog)
+						if err != nil {
+							return err
+						}
+						if lines := strings.Count(string(content), "\n"); lines != 1 {
+							return fmt.Errorf("expected 1 reflog entry, got %d", lines)
+						}
+						return nil
+					},
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					for i := 0; i < 3; i++ {
+						assert.NoError(t, os.WriteFile(filepath.Join(tempDir, fmt.Sprintf("file%d.txt", i)), []byte(fmt.Sprintf("file %d", i)), 0644))
+						_, err := testCommitAll(tempDir, fmt.Sprintf("add file%d.txt", i))
+						assert.NoError(t, err)
+					}
+				},
+				Config: testAccGitMaintenanceResourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_maintenance.test", "packs", "1"),
+					resource.TestCheckResourceAttr("git_maintenance.test", "loose_objects", "1"),
+				),
+			},
+			// Lock testing
+			{
+				PreConfig: func() {
+					for i := 3; i < 6; i++ {
+						assert.NoError(t, os.WriteFile(filepath.Join(tempDir, fmt.Sprintf("file%d.txt", i)), []byte(fmt.Sprintf("file %d", i)), 0644))
+						_, err := testCommitAll(tempDir, fmt.Sprintf("add file%d.txt", i))
+						assert.NoError(t, err)
+					}
+					assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".git", "gc.pid"), []byte("1 other"), 0644))
+				},
+				Config:      testAccGitMaintenanceResourceConfig(tempDir),
+				ExpectError: regexp.MustCompile("gc.pid exists"),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.Remove(filepath.Join(tempDir, ".git", "gc.pid")))
+					assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".git", "refs", "heads", "master.lock"), []byte{}, 0644))
+				},
+				Config:      testAccGitMaintenanceResourceConfig(tempDir),
+				ExpectError: regexp.MustCompile("refs/heads/master.lock exists"),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.Remove(filepath.Join(tempDir, ".git", "refs", "heads", "master.lock")))
+				},
+				Config: testAccGitMaintenanceResourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_maintenance.test", "packs", "1"),
+					func(s *terraform.State) error {
+						if _, err := os.Stat(filepath.Join(tempDir, ".git", "gc.pid")); !os.IsNotExist(err) {
+							return fmt.Errorf("expected gc.pid to be removed: %v", err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testCheckObjects checks whether objects exist in the repository at path.
+func testCheckObjects(path string, objects map[plumbing.Hash]bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		for hash, exists := range objects {
+			err := repo.Storer.HasEncodedObject(hash)
+			if exists && err != nil {
+				return fmt.Errorf("expected %s to exist: %v", hash, err)
+			}
+			if !exists && err == nil {
+				return fmt.Errorf("expected %s to be pruned", hash)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitMergeBase{}
+
+func NewGitMergeBase() datasource.DataSource {
+	return &GitMergeBase{}
+}
+
+// GitMergeBase defines the data source implementation.
+type GitMergeBase struct {
+	provider *GitProviderData
+}
+
+// GitMergeBaseModel describes the data source data model.
+type GitMergeBaseModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Path         types.String   `tfsdk:"path"`
+	FromRef      types.String   `tfsdk:"from_ref"`
+	ToRef        types.String   `tfsdk:"to_ref"`
+	FromCommit   types.String   `tfsdk:"from_commit"`
+	ToCommit     types.String   `tfsdk:"to_commit"`
+	MergeBase    types.String   `tfsdk:"merge_base"`
+	MergeBases   []types.String `tfsdk:"merge_bases"`
+	IsAncestor   types.Bool     `tfsdk:"is_ancestor"`
+	IsDescendant types.Bool     `tfsdk:"is_descendant"`
+}
+
+func (d *GitMergeBase) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge_base"
+}
+
+func (d *GitMergeBase) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Merge Base data source, finds the best common ancestor of two references (`git merge-base`)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "First reference, a branch, tag or commit",
+				Required:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Second reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `from_ref` resolved to",
+				Computed:            true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"merge_base": schema.StringAttribute{
+				MarkdownDescription: "Best common ancestor of both commits, empty when they share no history",
+				Computed:            true,
+			},
+			"merge_bases": schema.ListAttribute{
+				MarkdownDescription: "All best common ancestors of both commits, more than one for criss-cross merges",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"is_ancestor": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not `from_ref` is an ancestor of, or the same commit as, `to_ref`, ie. it has been merged into `to_ref`",
+				Computed:            true,
+			},
+			"is_descendant": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not `to_ref` is an ancestor of, or the same commit as, `from_ref`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitMergeBase) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitMergeBase) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitMergeBaseModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	from, err := resolveCommit(repo, data.FromRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	bases, err := from.MergeBase(to)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to find merge base", err.Error())
+		return
+	}
+
+	isAncestor, err := from.IsAncestor(to)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to check ancestry", err.Error())
+		return
+	}
+
+	isDescendant, err := to.IsAncestor(from)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to check ancestry", err.Error())
+		return
+	}
+
+	data.MergeBase = types.StringValue("")
+	data.MergeBases = []types.String{}
+	for _, base := range bases {
+		tflog.Trace(ctx, fmt.Sprintf("merge_base: %s", base.Hash.String()))
+
+		data.MergeBases = append(data.MergeBases, types.StringValue(base.Hash.String()))
+	}
+	if len(bases) > 0 {
+		data.MergeBase = types.StringValue(bases[0].Hash.String())
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s...%s", data.Path.ValueString(), from.Hash.String(), to.Hash.String()))
+	data.FromCommit = types.StringValue(from.Hash.String())
+	data.ToCommit = types.StringValue(to.Hash.String())
+	data.IsAncestor = types.BoolValue(isAncestor)
+	data.IsDescendant = types.BoolValue(isDescendant)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitMergeBaseDataSourceConfig(path string, fromRef string, toRef string) string {
+	return fmt.Sprintf(`
+data "git_merge_base" "test" {
+  path     = %[1]q
+  from_ref = %[2]q
+  to_ref   = %[3]q
+}
+`, path, fromRef, toRef)
+}
+
+func TestAccGitMergeBaseDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("# main"), 0644))
+	_, err = testCommitAll(tempDir, "main")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(tempDir, "hotfix", *base))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "hotfix.tf"), []byte("# hotfix"), 0644))
+	_, err = testCommitAll(tempDir, "hotfix")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitMergeBaseDataSourceConfig(tempDir, "hotfix", "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "merge_base", base.String()),
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "merge_bases.#", "1"),
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "is_ancestor", "false"),
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "is_descendant", "false"),
+				),
+			},
+			{
+				Config: testAccGitMergeBaseDataSourceConfig(tempDir, base.String(), "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "merge_base", base.String()),
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "is_ancestor", "true"),
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "is_descendant", "false"),
+				),
+			},
+			{
+				Config: testAccGitMergeBaseDataSourceConfig(tempDir, "hotfix", base.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "is_ancestor", "false"),
+					resource.TestCheckResourceAttr("data.git_merge_base.test", "is_descendant", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitMergeCheck{}
+
+func NewGitMergeCheck() datasource.DataSource {
+	return &GitMergeCheck{}
+}
+
+// GitMergeCheck defines the data source implementation.
+type GitMergeCheck struct {
+	provider *GitProviderData
+}
+
+// GitMergeCheckModel describes the data source data model.
+type GitMergeCheckModel struct {
+	Id               types.String   `tfsdk:"id"`
+	Path             types.String   `tfsdk:"path"`
+	TargetRef        types.String   `tfsdk:"target_ref"`
+	SourceRef        types.String   `tfsdk:"source_ref"`
+	TargetCommit     types.String   `tfsdk:"target_commit"`
+	SourceCommit     types.String   `tfsdk:"source_commit"`
+	MergeBase        types.String   `tfsdk:"merge_base"`
+	UpToDate         types.Bool     `tfsdk:"up_to_date"`
+	FastForward      types.Bool     `tfsdk:"fast_forward"`
+	HasConflicts     types.Bool     `tfsdk:"has_conflicts"`
+	ConflictingPaths []types.String `tfsdk:"conflicting_paths"`
+}
+
+func (d *GitMergeCheck) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge_check"
+}
+
+func (d *GitMergeCheck) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Merge Check data source, reports whether merging one reference into another would conflict without touching the worktree. " +
+			"Files changed on both sides since the merge base are merged line by line in memory, like `git merge` without rename detection: " +
+			"they conflict when the changes overlap or touch adjacent lines, when one side deletes a file the other modifies, or replaces it with a directory. " +
+			"Binary files changed differently on both sides always conflict.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"target_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to merge into, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"source_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to merge, a branch, tag or commit",
+				Required:            true,
+			},
+			"target_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `target_ref` resolved to",
+				Computed:            true,
+			},
+			"source_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `source_ref` resolved to",
+				Computed:            true,
+			},
+			"merge_base": schema.StringAttribute{
+				MarkdownDescription: "Best common ancestor of both commits",
+				Computed:            true,
+			},
+			"up_to_date": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the source commit is already contained in the target",
+				Computed:            true,
+			},
+			"fast_forward": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the target can be fast-forwarded to the source",
+				Computed:            true,
+			},
+			"has_conflicts": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the merge would conflict",
+				Computed:            true,
+			},
+			"conflicting_paths": schema.ListAttribute{
+				MarkdownDescription: "Paths that can not be merged, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitMergeCheck) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitMergeCheck) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitMergeCheckModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TargetRef.ValueString() == "" {
+		data.TargetRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	target, err := resolveCommit(repo, data.TargetRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("target_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	source, err := resolveCommit(repo, data.SourceRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	bases, err := target.MergeBase(source)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to find merge base", err.Error())
+		return
+	}
+	if len(bases) == 0 {
+		resp.Diagnostics.AddError("unable to find merge base", fmt.Sprintf("%s and %s have no common ancestor", target.Hash.String(), source.Hash.String()))
+		return
+	}
+	base := bases[0]
+
+	tflog.Trace(ctx, fmt.Sprintf("target: %s source: %s base: %s", target.Hash.String(), source.Hash.String(), base.Hash.String()))
+
+	data.UpToDate = types.BoolValue(base.Hash == source.Hash)
+	data.FastForward = types.BoolValue(base.Hash == target.Hash && target.Hash != source.Hash)
+	data.ConflictingPaths = []types.String{}
+
+	if !data.UpToDate.ValueBool() && !data.FastForward.ValueBool() {
+		merge, err := mergeCommitTrees(ctx, base, target, source)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to check merge", err.Error())
+			return
+		}
+
+		for _, conflict := range merge.Conflicts {
+			data.ConflictingPaths = append(data.ConflictingPaths, types.StringValue(conflict))
+		}
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), target.Hash.String(), source.Hash.String()))
+	data.TargetCommit = types.StringValue(target.Hash.String())
+	data.SourceCommit = types.StringValue(source.Hash.String())
+	data.MergeBase = types.StringValue(base.Hash.String())
+	data.HasConflicts = types.BoolValue(len(data.ConflictingPaths) > 0)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mergeCommitTrees merges the changes between base and theirs into ours.
+func mergeCommitTrees(ctx context.Context, base *object.Commit, ours *object.Commit, theirs *object.Commit) (*gitutils.TreeMerge, error) {
+	var trees [3]*object.Tree
+	for i, c := range []*object.Commit{base, ours, theirs} {
+		tree, err := c.Tree()
+		if err != nil {
+			return nil, err
+		}
+		trees[i] = tree
+	}
+
+	return gitutils.MergeTrees(ctx, trees[0], trees[1], trees[2])
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitMergeCheckDataSourceConfig(path string, targetRef string, sourceRef string) string {
+	return fmt.Sprintf(`
+data "git_merge_check" "test" {
+  path       = %[1]q
+  target_ref = %[2]q
+  source_ref = %[3]q
+}
+`, path, targetRef, sourceRef)
+}
+
+// testCheckoutBranch creates branch at hash and checks it out.
+func testCheckoutBranch(path string, branch string, hash plumbing.Hash) error {
+	repo, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return wt.Checkout(&git.CheckoutOptions{
+		Hash:   hash,
+		Branch: plumbing.NewBranchReferenceName(branch),
+		Create: true,
+	})
+}
+
+func TestAccGitMergeCheckDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("main"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "a.tf"), []byte("# a"), 0644))
+	_, err = testCommitAll(tempDir, "main")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(tempDir, "feature", *base))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("feature"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "b.tf"), []byte("# b"), 0644))
+	_, err = testCommitAll(tempDir, "feature")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCheckoutBranch(tempDir, "clean", *base))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "c.tf"), []byte("# c"), 0644))
+	_, err = testCommitAll(tempDir, "clean")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", "feature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "merge_base", base.String()),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "fast_forward", "false"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "has_conflicts", "true"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.#", "1"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.0", "README.md"),
+				),
+			},
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", "clean"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "has_conflicts", "false"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.#", "0"),
+				),
+			},
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", base.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "up_to_date", "true"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "has_conflicts", "false"),
+				),
+			},
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, base.String(), "master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "fast_forward", "true"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "has_conflicts", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitMergeCheckDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	config := "one\ntwo\nthree\nfour\nfive\nsix\nseven\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.txt"), []byte(config), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "doc.md"), []byte("# doc\n"), 0644))
+	base, err := testCommitAll(tempDir, "base")
+	assert.NoError(t, err)
+
+	branches := map[string]map[string]string{
+		"master":  {"config.txt": "ONE\ntwo\nthree\nfour\nfive\nsix\nseven\n", "doc.md": "# DOC\n", "svc": "file"},
+		"lines":   {"config.txt": "one\ntwo\nthree\nfour\nfive\nsix\nSEVEN\n"},
+		"overlap": {"config.txt": "1\ntwo\nthree\nfour\nfive\nsix\nseven\n"},
+		"delete":  {"doc.md": ""},
+		"dir":     {"svc/main.go": "package main\n"},
+	}
+	// master is checked out, the other branches start from base.
+	for _, branch := range []string{"master", "lines", "overlap", "delete", "dir"} {
+		if branch != "master" {
+			assert.NoError(t, testCheckoutBranch(tempDir, branch, *base))
+		}
+		for name, content := range branches[branch] {
+			if content == "" {
+				assert.NoError(t, os.Remove(filepath.Join(tempDir, name)))
+				continue
+			}
+			assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tempDir, name)), 0755))
+			assert.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+		}
+		_, err = testCommitAll(tempDir, branch)
+		assert.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Non overlapping changes to the same file merge
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", "lines"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "has_conflicts", "false"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.#", "0"),
+				),
+			},
+			// Overlapping changes conflict
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", "overlap"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "has_conflicts", "true"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.#", "1"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.0", "config.txt"),
+				),
+			},
+			// A file deleted on one side and modified on the other conflicts
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", "delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.#", "1"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.0", "doc.md"),
+				),
+			},
+			// A file on one side and a directory on the other conflicts
+			{
+				Config: testAccGitMergeCheckDataSourceConfig(tempDir, "master", "dir"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.#", "1"),
+					resource.TestCheckResourceAttr("data.git_merge_check.test", "conflicting_paths.0", "svc/main.go"),
+				),
+			},
+		},
+	})
+}
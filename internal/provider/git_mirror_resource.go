@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitMirror{}
+var _ resource.ResourceWithModifyPlan = &GitMirror{}
+
+func NewGitMirror() resource.Resource {
+	return &GitMirror{}
+}
+
+// GitMirror defines the resource implementation.
+type GitMirror struct {
+	provider *GitProviderData
+}
+
+// GitMirrorModel describes the resource data model.
+type GitMirrorModel struct {
+	Id                  types.String   `tfsdk:"id"`
+	SourceURL           types.String   `tfsdk:"source_url"`
+	SourceUsername      types.String   `tfsdk:"source_username"`
+	SourcePassword      types.String   `tfsdk:"source_password"`
+	DestinationURL      types.String   `tfsdk:"destination_url"`
+	DestinationUsername types.String   `tfsdk:"destination_username"`
+	DestinationPassword types.String   `tfsdk:"destination_password"`
+	Include             []types.String `tfsdk:"include"`
+	Exclude             []types.String `tfsdk:"exclude"`
+	Prune               types.Bool     `tfsdk:"prune"`
+	CachePath           types.String   `tfsdk:"cache_path"`
+	Refs                types.Map      `tfsdk:"refs"`
+}
+
+func (r *GitMirror) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mirror"
+}
+
+func (r *GitMirror) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Mirror resource, mirrors the references of a source repository to a destination " +
+			"repository like `git push --mirror` does from a `git clone --mirror`. Creating the resource pushes every " +
+			"reference, later applies only push the ones that moved, whenever the references of the destination no " +
+			"longer match the source, which is checked on every plan. References are fetched into memory unless " +
+			"`cache_path` is set. Destroying the resource leaves the destination as it is",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository to mirror",
+				Required:            true,
+			},
+			"source_username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication against the source",
+				Optional:            true,
+			},
+			"source_password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication against the source",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"destination_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository to mirror to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication against the destination",
+				Optional:            true,
+			},
+			"destination_password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication against the destination",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"include": schema.ListAttribute{
+				MarkdownDescription: "Only mirror references matching any of these namespaces or globs, ie. `refs/heads` " +
+					"or `refs/tags/v*` (default: every reference)",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"exclude": schema.ListAttribute{
+				MarkdownDescription: "Do not mirror references matching any of these namespaces or globs, ie. `refs/pull`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"prune": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to delete the references of the destination matching `include` and " +
+					"`exclude` that no longer exist in the source (default: true)",
+				Optional: true,
+			},
+			"cache_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a bare repository, created if missing, to fetch the source into so that " +
+					"only new objects are fetched on later applies",
+				Optional: true,
+			},
+			"refs": schema.MapAttribute{
+				MarkdownDescription: "Map of full reference name to object hash of the mirrored references",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GitMirror) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitMirror) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying or creating.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data GitMirrorModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SourceURL.IsUnknown() || data.Refs.IsUnknown() {
+		return
+	}
+
+	url, auth := r.provider.repositoryLocation(data.SourceURL, data.SourceUsername, data.SourcePassword)
+	source, err := mirrorRefs(url, auth, &data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_url"), "unable to list source references", err.Error())
+		return
+	}
+
+	// The state holds the references of the destination, the mirror is synced
+	// whenever they differ from the source.
+	if !mirrorRefsValue(source).Equal(data.Refs) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("refs"), types.MapUnknown(types.StringType))...)
+	}
+}
+
+func (r *GitMirror) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitMirrorModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.DestinationURL
+
+	resp.Diagnostics.Append(r.sync(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitMirror) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitMirrorModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url, auth := r.provider.repositoryLocation(data.DestinationURL, data.DestinationUsername, data.DestinationPassword)
+	destination, err := mirrorRefs(url, auth, &data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("destination_url"), "unable to list destination references", err.Error())
+		return
+	}
+
+	// Without pruning, references only found on the destination are left
+	// alone, so only the mirrored ones are tracked.
+	if !data.Prune.IsNull() && !data.Prune.ValueBool() {
+		mirrored := map[string]string{}
+		for name := range data.Refs.Elements() {
+			if hash, ok := destination[name]; ok {
+				mirrored[name] = hash
+			}
+		}
+		destination = mirrored
+	}
+
+	data.Refs = mirrorRefsValue(destination)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitMirror) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitMirrorModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitMirror) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The destination is left as it is.
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+// sync fetches the references of the source described by data and pushes the
+// ones the destination is missing, setting the mirrored references.
+func (r *GitMirror) sync(ctx context.Context, data *GitMirrorModel) (diags diag.Diagnostics) {
+	sourceURL, sourceAuth := r.provider.repositoryLocation(data.SourceURL, data.SourceUsername, data.SourcePassword)
+	destinationURL, destinationAuth := r.provider.repositoryLocation(data.DestinationURL, data.DestinationUsername, data.DestinationPassword)
+
+	source, err := mirrorRefs(sourceURL, sourceAuth, data)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_url"), "unable to list source references", err.Error())
+		return diags
+	}
+	destination, err := mirrorRefs(destinationURL, destinationAuth, data)
+	if err != nil {
+		diags.AddAttributeError(path.Root("destination_url"), "unable to list destination references", err.Error())
+		return diags
+	}
+
+	var fetchSpecs, pushSpecs []config.RefSpec
+	for _, name := range sortedKeys(source) {
+		if destination[name] == source[name] {
+			continue
+		}
+		fetchSpecs = append(fetchSpecs, config.RefSpec(fmt.Sprintf("+%[1]s:%[1]s", name)))
+		pushSpec := config.RefSpec(fmt.Sprintf("+%[1]s:%[1]s", name))
+		if _, ok := destination[name]; ok && r.provider.isProtectedRef(plumbing.ReferenceName(name)) {
+			// The push is rejected unless it fast forwards the reference.
+			pushSpec = config.RefSpec(fmt.Sprintf("%[1]s:%[1]s", name))
+		}
+		pushSpecs = append(pushSpecs, pushSpec)
+	}
+	if data.Prune.IsNull() || data.Prune.ValueBool() {
+		for _, name := range sortedKeys(destination) {
+			if _, ok := source[name]; ok {
+				continue
+			}
+			if err := r.provider.checkProtectedRef(plumbing.ReferenceName(name), "prune"); err != nil {
+				diags.AddAttributeError(path.Root("prune"), "unable to prune destination", err.Error())
+				return diags
+			}
+			pushSpecs = append(pushSpecs, config.RefSpec(":"+name))
+		}
+	}
+
+	if len(pushSpecs) > 0 {
+		var s storage.Storer = memory.NewStorage()
+		if data.CachePath.ValueString() != "" {
+			repo, err := git.PlainInit(data.CachePath.ValueString(), true)
+			if errors.Is(err, git.ErrRepositoryAlreadyExists) {
+				repo, err = r.provider.openRepository(data.CachePath.ValueString())
+			}
+			if err != nil {
+				diags.AddAttributeError(path.Root("cache_path"), "unable to open cache repository", err.Error())
+				return diags
+			}
+			s = repo.Storer
+		}
+
+		if len(fetchSpecs) > 0 {
+			tflog.Trace(ctx, fmt.Sprintf("fetching %d references from %s", len(fetchSpecs), sourceURL))
+			err := git.NewRemote(s, &config.RemoteConfig{Name: "source", URLs: []string{sourceURL}}).FetchContext(ctx, &git.FetchOptions{
+				RemoteName: "source",
+				RefSpecs:   fetchSpecs,
+				Auth:       sourceAuth,
+				Tags:       git.NoTags,
+			})
+			if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+				diags.AddAttributeError(path.Root("source_url"), "unable to fetch", err.Error())
+				return diags
+			}
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("pushing %d references to %s", len(pushSpecs), destinationURL))
+		err := git.NewRemote(s, &config.RemoteConfig{Name: "destination", URLs: []string{destinationURL}}).PushContext(ctx, &git.PushOptions{
+			RemoteName: "destination",
+			RefSpecs:   pushSpecs,
+			Auth:       destinationAuth,
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			diags.AddAttributeError(path.Root("destination_url"), "unable to push", err.Error())
+			return diags
+		}
+	}
+
+	data.Refs = mirrorRefsValue(source)
+
+	return diags
+}
+
+// mirrorRefs lists the references of the repository at url matching the
+// include and exclude patterns of data, by name.
+func mirrorRefs(url string, auth transport.AuthMethod, data *GitMirrorModel) (map[string]string, error) {
+	refs, err := listRemote(url, auth)
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	include, exclude := toStrings(data.Include), toStrings(data.Exclude)
+
+	mirrored := map[string]string{}
+	for _, ref := range refs {
+		// HEAD follows the default branch of each repository.
+		if ref.Type() != plumbing.HashReference || ref.Name() == plumbing.HEAD {
+			continue
+		}
+		if gitutils.MatchPaths(include, exclude, ref.Name().String()) {
+			mirrored[ref.Name().String()] = ref.Hash().String()
+		}
+	}
+
+	return mirrored, nil
+}
+
+// mirrorRefsValue returns refs as a map value.
+func mirrorRefsValue(refs map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for name, hash := range refs {
+		elements[name] = types.StringValue(hash)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
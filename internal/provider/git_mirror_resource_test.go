@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitMirrorResourceConfig(source string, destination string, options string) string {
+	return fmt.Sprintf(`
+resource "git_mirror" "test" {
+  source_url      = %[1]q
+  destination_url = %[2]q
+  %[3]s
+}
+`, source, destination, options)
+}
+
+func TestAccGitMirrorResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	destinationDir := filepath.Join(tempDir, "destination.git")
+	cacheDir := filepath.Join(tempDir, "cache.git")
+
+	first, err := testSetupGit(sourceDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+	tag, err := testResolveRef(sourceDir, "refs/tags/v1.0.0")
+	assert.NoError(t, err)
+
+	source, err := git.PlainOpen(sourceDir)
+	assert.NoError(t, err)
+	assert.NoError(t, source.Storer.SetReference(plumbing.NewHashReference("refs/heads/feature", *first)))
+	assert.NoError(t, source.Storer.SetReference(plumbing.NewHashReference("refs/pull/1/head", *first)))
+
+	_, err = git.PlainInit(destinationDir, true)
+	assert.NoError(t, err)
+
+	var second *plumbing.Hash
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitMirrorResourceConfig(sourceDir, destinationDir, `exclude = ["refs/pull"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_mirror.test", "refs.%", "3"),
+					resource.TestCheckResourceAttr("git_mirror.test", "refs.refs/heads/master", first.String()),
+					testCheckRefs(destinationDir, map[string]string{
+						"refs/heads/master":  first.String(),
+						"refs/heads/feature": first.String(),
+						"refs/tags/v1.0.0":   tag.String(),
+						"refs/pull/1/head":   "",
+					}),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(filepath.Join(sourceDir, "CHANGELOG.md"), []byte("# Changelog\n"), 0644))
+					second, err = testCommitAll(sourceDir, "add changelog")
+					assert.NoError(t, err)
+					assert.NoError(t, source.Storer.RemoveReference("refs/heads/feature"))
+				},
+				Config: testAccGitMirrorResourceConfig(sourceDir, destinationDir, `exclude = ["refs/pull"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_mirror.test", "refs.%", "2"),
+					func(s *terraform.State) error {
+						return testCheckRefs(destinationDir, map[string]string{
+							"refs/heads/master":  second.String(),
+							"refs/heads/feature": "",
+							"refs/tags/v1.0.0":   tag.String(),
+						})(s)
+					},
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					destination, err := git.PlainOpen(destinationDir)
+					assert.NoError(t, err)
+					assert.NoError(t, destination.Storer.RemoveReference("refs/tags/v1.0.0"))
+				},
+				Config: testAccGitMirrorResourceConfig(sourceDir, destinationDir, `exclude = ["refs/pull"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRefs(destinationDir, map[string]string{
+						"refs/tags/v1.0.0": tag.String(),
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, source.Storer.SetReference(plumbing.NewHashReference("refs/heads/feature", *first)))
+					assert.NoError(t, source.Storer.RemoveReference("refs/tags/v1.0.0"))
+				},
+				Config: testAccGitMirrorResourceConfig(sourceDir, destinationDir, fmt.Sprintf(`
+  include    = ["refs/heads", "refs/tags"]
+  prune      = false
+  cache_path = %q
+`, cacheDir)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_mirror.test", "refs.%", "2"),
+					testCheckRefs(destinationDir, map[string]string{
+						"refs/heads/feature": first.String(),
+						"refs/tags/v1.0.0":   tag.String(),
+					}),
+					testCheckRefs(cacheDir, map[string]string{
+						"refs/heads/feature": first.String(),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitMirrorResource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	destinationDir := filepath.Join(tempDir, "destination.git")
+
+	first, err := testSetupGit(sourceDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+	source, err := git.PlainOpen(sourceDir)
+	assert.NoError(t, err)
+
+	_, err = git.PlainInit(destinationDir, true)
+	assert.NoError(t, err)
+
+	providerConfig := `
+provider "git" {
+  protected_refs = ["refs/heads/master", "refs/tags/v*"]
+}
+`
+
+	var second *plumbing.Hash
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccGitMirrorResourceConfig(sourceDir, destinationDir, ""),
+				Check: testCheckRefs(destinationDir, map[string]string{
+					"refs/heads/master": first.String(),
+				}),
+			},
+			// Protected references are fast forwarded
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(filepath.Join(sourceDir, "CHANGELOG.md"), []byte("# Changelog\n"), 0644))
+					second, err = testCommitAll(sourceDir, "add changelog")
+					assert.NoError(t, err)
+				},
+				Config: providerConfig + testAccGitMirrorResourceConfig(sourceDir, destinationDir, ""),
+				Check: func(s *terraform.State) error {
+					return testCheckRefs(destinationDir, map[string]string{
+						"refs/heads/master": second.String(),
+					})(s)
+				},
+			},
+			// but not force pushed
+			{
+				PreConfig: func() {
+					assert.NoError(t, source.Storer.SetReference(plumbing.NewHashReference("refs/heads/master", *first)))
+				},
+				Config:      providerConfig + testAccGitMirrorResourceConfig(sourceDir, destinationDir, ""),
+				ExpectError: regexp.MustCompile("non-fast-forward update: refs/heads/master"),
+			},
+			// nor pruned
+			{
+				PreConfig: func() {
+					assert.NoError(t, source.Storer.SetReference(plumbing.NewHashReference("refs/heads/master", *second)))
+					assert.NoError(t, source.Storer.RemoveReference("refs/tags/v1.0.0"))
+				},
+				Config:      providerConfig + testAccGitMirrorResourceConfig(sourceDir, destinationDir, ""),
+				ExpectError: regexp.MustCompile("refusing to prune refs/tags/v1.0.0"),
+			},
+		},
+	})
+}
+
+// testResolveRef returns the hash name points at in the repository at path.
+func testResolveRef(path string, name plumbing.ReferenceName) (*plumbing.Hash, error) {
+	repo, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := repo.Reference(name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := ref.Hash()
+	return &hash, nil
+}
+
+// testCheckRefs checks the hash of references of the repository at path, an
+// empty hash checking the reference does not exist.
+func testCheckRefs(path string, refs map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		for name, hash := range refs {
+			ref, err := repo.Reference(plumbing.ReferenceName(name), false)
+			if err == plumbing.ErrReferenceNotFound && hash == "" {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			if ref.Hash().String() != hash {
+				return fmt.Errorf("expected %s to be %s, got %s", name, hash, ref.Hash())
+			}
+		}
+
+		return nil
+	}
+}
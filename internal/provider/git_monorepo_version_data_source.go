@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitMonorepoVersion{}
+
+func NewGitMonorepoVersion() datasource.DataSource {
+	return &GitMonorepoVersion{}
+}
+
+// GitMonorepoVersion defines the data source implementation.
+type GitMonorepoVersion struct {
+	provider *GitProviderData
+}
+
+// GitMonorepoVersionModel describes the data source data model.
+type GitMonorepoVersionModel struct {
+	Id          types.String `tfsdk:"id"`
+	Path        types.String `tfsdk:"path"`
+	Reference   types.String `tfsdk:"ref"`
+	Directory   types.String `tfsdk:"directory"`
+	TagPrefix   types.String `tfsdk:"tag_prefix"`
+	FallbackTag types.String `tfsdk:"fallback_tag"`
+	Commit      types.String `tfsdk:"commit"`
+	Tag         types.String `tfsdk:"tag"`
+	Distance    types.Int64  `tfsdk:"distance"`
+	Version     types.String `tfsdk:"version"`
+}
+
+func (d *GitMonorepoVersion) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monorepo_version"
+}
+
+func (d *GitMonorepoVersion) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Monorepo Version data source, computes the version of a component of a monorepo living in " +
+			"a directory, like `git describe` scoped to the component: only tags namespaced for the component (ie. " +
+			"`service-a/v1.2.3`) are considered and only commits touching the directory count towards the distance",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compute the version of, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "Directory of the component, relative to the root of the repository (ie. `services/service-a`)",
+				Required:            true,
+			},
+			"tag_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix of the tags of the component, followed by the version (default: the last element " +
+					"of `directory` and a slash, ie. `service-a/`)",
+				Optional: true,
+				Computed: true,
+			},
+			"fallback_tag": schema.StringAttribute{
+				MarkdownDescription: "Version used when no tag of the component is reachable, without prefix (default: v0.0.0)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Closest tag of the component reachable from `ref` (ie. `service-a/v1.2.3`), empty when none is",
+				Computed:            true,
+			},
+			"distance": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits touching `directory` since `tag`, or since the root commit when there is no tag",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the component without prefix, the version of `tag` when `distance` is 0, " +
+					"otherwise with the distance and short hash as prerelease (ie. `1.2.3-4.gabc1234`)",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *GitMonorepoVersion) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitMonorepoVersion) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitMonorepoVersionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := strings.Trim(filepath.ToSlash(filepath.Clean(data.Directory.ValueString())), "/")
+	if directory == "" || directory == "." {
+		resp.Diagnostics.AddAttributeError(path.Root("directory"), "invalid directory", "directory must not be the root of the repository, use git_repository instead")
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.TagPrefix.IsNull() || data.TagPrefix.IsUnknown() {
+		data.TagPrefix = types.StringValue(filepath.Base(directory) + "/")
+	}
+	if data.FallbackTag.ValueString() == "" {
+		data.FallbackTag = types.StringValue("v0.0.0")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tag, tagVersion := "", ""
+	distance := 0
+
+	described, err := gitutils.DescribePath(repo, commit, data.TagPrefix.ValueString(), []string{directory})
+	switch {
+	case err == gitutils.ErrNoDescribeNames:
+		distance, err = gitutils.PathDistance(nil, commit, []string{directory})
+		if err != nil {
+			resp.Diagnostics.AddError("unable to walk commits", err.Error())
+			return
+		}
+	case err != nil:
+		resp.Diagnostics.AddError("unable to describe directory", err.Error())
+		return
+	default:
+		tag = described.Tag
+		tagVersion = strings.TrimPrefix(tag, data.TagPrefix.ValueString())
+		distance = described.Distance
+	}
+
+	version, err := gitutils.GenerateVersion(tagVersion, distance, commit.Hash.String(), time.Now(), gitutils.GenerateVersionOptions{
+		FallbackTagName:   data.FallbackTag.ValueString(),
+		DropTagNamePrefix: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to generate version", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("directory: %s tag: %s distance: %d version: %s", directory, tag, distance, *version))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.Path.ValueString(), commit.Hash.String(), directory))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.Tag = types.StringValue(tag)
+	data.Distance = types.Int64Value(int64(distance))
+	data.Version = types.StringValue(*version)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitMonorepoVersionDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_monorepo_version" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitMonorepoVersionDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	// the repository wide tag is not a tag of any service
+	_, err = testSetupGit(tempDir, "v9.0.0", 0)
+	assert.NoError(t, err)
+
+	write := func(service string, content string) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "services", service), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", service, "main.go"), []byte(content), 0644))
+	}
+
+	write("service-a", "a1")
+	tagged, err := testCommitAll(tempDir, "service-a")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("service-a/v1.2.0", *tagged, &git.CreateTagOptions{Message: "service-a/v1.2.0"})
+	assert.NoError(t, err)
+
+	write("service-b", "b1")
+	_, err = testCommitAll(tempDir, "service-b")
+	assert.NoError(t, err)
+	write("service-a", "a2")
+	_, err = testCommitAll(tempDir, "service-a again")
+	assert.NoError(t, err)
+	write("service-b", "b2")
+	head, err := testCommitAll(tempDir, "service-b again")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitMonorepoVersionDataSourceConfig(tempDir, `directory = "services/service-a"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "tag_prefix", "service-a/"),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "tag", "service-a/v1.2.0"),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "distance", "1"),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "version", "1.2.0-1.g"+head.String()[:7]),
+				),
+			},
+			{
+				Config: testAccGitMonorepoVersionDataSourceConfig(tempDir, `
+  directory = "services/service-a"
+  ref       = "service-a/v1.2.0"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "commit", tagged.String()),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "distance", "0"),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "version", "1.2.0"),
+				),
+			},
+			{
+				Config: testAccGitMonorepoVersionDataSourceConfig(tempDir, `directory = "services/service-b"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "tag", ""),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "distance", "2"),
+					resource.TestCheckResourceAttr("data.git_monorepo_version.test", "version", "0.0.0-2.g"+head.String()[:7]),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitObject{}
+
+func NewGitObject() datasource.DataSource {
+	return &GitObject{}
+}
+
+// GitObject defines the data source implementation.
+type GitObject struct {
+	provider *GitProviderData
+}
+
+// GitObjectModel describes the data source data model.
+type GitObjectModel struct {
+	Id     types.String `tfsdk:"id"`
+	Path   types.String `tfsdk:"path"`
+	Hash   types.String `tfsdk:"hash"`
+	Exists types.Bool   `tfsdk:"exists"`
+	Type   types.String `tfsdk:"type"`
+	Size   types.Int64  `tfsdk:"size"`
+}
+
+func (d *GitObject) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object"
+}
+
+func (d *GitObject) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Object data source, reports whether an object exists without failing when it does not",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Full hash of the object to look up",
+				Required:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the object exists in the repository, false for malformed hashes",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the object, one of `commit`, `tree`, `blob` or `tag`, empty when it does not exist",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the object in bytes, 0 when it does not exist",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitObject) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitObject) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitObjectModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), data.Hash.ValueString()))
+	data.Exists = types.BoolValue(false)
+	data.Type = types.StringValue("")
+	data.Size = types.Int64Value(0)
+
+	if isHash(data.Hash.ValueString()) {
+		obj, err := repo.Storer.EncodedObject(plumbing.AnyObject, plumbing.NewHash(data.Hash.ValueString()))
+		if err != nil && err != plumbing.ErrObjectNotFound {
+			resp.Diagnostics.AddError("unable to read object", err.Error())
+			return
+		}
+
+		if obj != nil {
+			data.Exists = types.BoolValue(true)
+			data.Type = types.StringValue(obj.Type().String())
+			data.Size = types.Int64Value(obj.Size())
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("object: %s exists: %t", data.Hash.ValueString(), data.Exists.ValueBool()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isHash reports whether s is a full, hex encoded, object hash.
+func isHash(s string) bool {
+	if len(s) != 40 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitObjectDataSourceConfig(path string, hash string) string {
+	return fmt.Sprintf(`
+data "git_object" "test" {
+  path = %[1]q
+  hash = %[2]q
+}
+`, path, hash)
+}
+
+func TestAccGitObjectDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitObjectDataSourceConfig(tempDir, hash.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_object.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_object.test", "type", "commit"),
+				),
+			},
+			{
+				Config: testAccGitObjectDataSourceConfig(tempDir, "9a2c7732fab5bcd73ea3ed52d2d9599a4cc47666"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_object.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_object.test", "type", "blob"),
+					resource.TestCheckResourceAttr("data.git_object.test", "size", "7"),
+				),
+			},
+			{
+				Config: testAccGitObjectDataSourceConfig(tempDir, "0123456789012345678901234567890123456789"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_object.test", "exists", "false"),
+					resource.TestCheckResourceAttr("data.git_object.test", "type", ""),
+				),
+			},
+			{
+				Config: testAccGitObjectDataSourceConfig(tempDir, "not-a-hash"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_object.test", "exists", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitPatch{}
+
+func NewGitPatch() datasource.DataSource {
+	return &GitPatch{}
+}
+
+// GitPatch defines the data source implementation.
+type GitPatch struct {
+	provider *GitProviderData
+}
+
+// GitPatchModel describes the data source data model.
+type GitPatchModel struct {
+	Id              types.String         `tfsdk:"id"`
+	Path            types.String         `tfsdk:"path"`
+	FromRef         types.String         `tfsdk:"from_ref"`
+	Reference       types.String         `tfsdk:"ref"`
+	OutputDirectory types.String         `tfsdk:"output_directory"`
+	Commit          types.String         `tfsdk:"commit"`
+	Patches         []GitPatchPatchModel `tfsdk:"patches"`
+	Patch           types.String         `tfsdk:"patch"`
+}
+
+// GitPatchPatchModel describes the patch of a single commit.
+type GitPatchPatchModel struct {
+	Commit   types.String `tfsdk:"commit"`
+	Subject  types.String `tfsdk:"subject"`
+	FileName types.String `tfsdk:"file_name"`
+	Patch    types.String `tfsdk:"patch"`
+}
+
+func (d *GitPatch) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_patch"
+}
+
+func (d *GitPatch) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Patch data source, renders commits as mbox formatted patches like " +
+			"`git format-patch --no-signature`, ready for `git am` or email based review, for a single commit or a " +
+			"range of commits (`from_ref..ref`). Merge commits in a range are skipped",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, a branch, tag or commit, only the commit " +
+					"`ref` points at is rendered when omitted",
+				Optional: true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to render the patches of, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"output_directory": schema.StringAttribute{
+				MarkdownDescription: "Directory to write every patch to, named like `git format-patch` does (ie. " +
+					"`0001-fix-handle-empty-body.patch`), it is created as needed. Patches are returned either way",
+				Optional: true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"patches": schema.ListNestedAttribute{
+				MarkdownDescription: "Patches of the commits, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "Subject of the commit message",
+							Computed:            true,
+						},
+						"file_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the patch file, written to `output_directory` when set",
+							Computed:            true,
+						},
+						"patch": schema.StringAttribute{
+							MarkdownDescription: "Patch of the commit",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"patch": schema.StringAttribute{
+				MarkdownDescription: "Every patch concatenated into a single mbox, oldest first",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitPatch) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitPatch) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitPatchModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	commits := []*object.Commit{commit}
+	if data.FromRef.ValueString() != "" {
+		from, err := resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+
+		commits = nil
+		if err := walkRange(from, commit, func(c *object.Commit) error {
+			if c.NumParents() > 1 {
+				return nil
+			}
+			// oldest first, like git format-patch
+			commits = append([]*object.Commit{c}, commits...)
+			return nil
+		}); err != nil {
+			resp.Diagnostics.AddError("unable to walk commits", err.Error())
+			return
+		}
+	}
+
+	outputDirectory := data.OutputDirectory.ValueString()
+	if outputDirectory != "" {
+		if err := os.MkdirAll(outputDirectory, 0755); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("output_directory"), "unable to create directory", err.Error())
+			return
+		}
+	}
+
+	var mbox strings.Builder
+	data.Patches = []GitPatchPatchModel{}
+	for i, c := range commits {
+		patch, err := gitutils.FormatPatch(ctx, c, i+1, len(commits))
+		if err != nil {
+			resp.Diagnostics.AddError("unable to format patch", fmt.Sprintf("%s: %s", c.Hash.String(), err.Error()))
+			return
+		}
+
+		subject := strings.SplitN(strings.TrimSpace(c.Message), "\n", 2)[0]
+		fileName := gitutils.PatchFileName(i+1, c.Message)
+
+		if outputDirectory != "" {
+			if err := os.WriteFile(filepath.Join(outputDirectory, fileName), []byte(patch), 0644); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("output_directory"), "unable to write patch", err.Error())
+				return
+			}
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("commit: %s patch: %s", c.Hash.String(), fileName))
+
+		mbox.WriteString(patch)
+		data.Patches = append(data.Patches, GitPatchPatchModel{
+			Commit:   types.StringValue(c.Hash.String()),
+			Subject:  types.StringValue(subject),
+			FileName: types.StringValue(fileName),
+			Patch:    types.StringValue(patch),
+		})
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.Patch = types.StringValue(mbox.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitPatchDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_patch" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitPatchDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	outputDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(outputDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCommitMessages(tempDir,
+		"fix: handle empty body\n\nThe body may be empty.",
+		"docs: mention the limits",
+	))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitPatchDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_patch.test", "patches.#", "1"),
+					resource.TestCheckResourceAttr("data.git_patch.test", "patches.0.subject", "docs: mention the limits"),
+					resource.TestCheckResourceAttr("data.git_patch.test", "patches.0.file_name", "0001-docs-mention-the-limits.patch"),
+					resource.TestMatchResourceAttr("data.git_patch.test", "patch", regexp.MustCompile(`(?m)^Subject: \[PATCH\] docs: mention the limits$`)),
+					resource.TestMatchResourceAttr("data.git_patch.test", "patch", regexp.MustCompile(`(?m)^ 1 file changed, 1 insertion\(\+\), 1 deletion\(-\)$`)),
+					resource.TestMatchResourceAttr("data.git_patch.test", "patch", regexp.MustCompile(`(?m)^\+change 1$`)),
+				),
+			},
+			{
+				Config: testAccGitPatchDataSourceConfig(tempDir, fmt.Sprintf(`
+  from_ref         = %q
+  output_directory = %q
+`, base.String(), outputDir)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_patch.test", "patches.#", "2"),
+					resource.TestCheckResourceAttr("data.git_patch.test", "patches.0.file_name", "0001-fix-handle-empty-body.patch"),
+					resource.TestCheckResourceAttr("data.git_patch.test", "patches.1.file_name", "0002-docs-mention-the-limits.patch"),
+					resource.TestMatchResourceAttr("data.git_patch.test", "patches.0.patch", regexp.MustCompile(`(?m)^Subject: \[PATCH 1/2\] fix: handle empty body\n\nThe body may be empty.\n---\n`)),
+					resource.TestMatchResourceAttr("data.git_patch.test", "patches.0.patch", regexp.MustCompile(`(?m)^new file mode 100644$`)),
+					func(s *terraform.State) error {
+						for _, name := range []string{"0001-fix-handle-empty-body.patch", "0002-docs-mention-the-limits.patch"} {
+							if _, err := os.Stat(filepath.Join(outputDir, name)); err != nil {
+								return err
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
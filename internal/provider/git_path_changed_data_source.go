@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitPathChanged{}
+
+func NewGitPathChanged() datasource.DataSource {
+	return &GitPathChanged{}
+}
+
+// GitPathChanged defines the data source implementation.
+type GitPathChanged struct {
+	provider *GitProviderData
+}
+
+// GitPathChangedModel describes the data source data model.
+type GitPathChangedModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Path       types.String   `tfsdk:"path"`
+	FromRef    types.String   `tfsdk:"from_ref"`
+	ToRef      types.String   `tfsdk:"to_ref"`
+	Pathspec   types.String   `tfsdk:"pathspec"`
+	FromCommit types.String   `tfsdk:"from_commit"`
+	ToCommit   types.String   `tfsdk:"to_commit"`
+	Changed    types.Bool     `tfsdk:"changed"`
+	Files      []types.String `tfsdk:"files"`
+}
+
+func (d *GitPathChanged) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_path_changed"
+}
+
+func (d *GitPathChanged) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Path Changed data source, reports whether a single path changed between two references, " +
+			"ie. as the condition of a `count`. Use `git_changed_paths` to check many paths at once",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare from, a branch, tag or commit",
+				Required:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare to, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pathspec": schema.StringAttribute{
+				MarkdownDescription: "Path prefix or glob to check (ie. `stacks/network` or `stacks/*/main.tf`), a prefix " +
+					"matches every file below it",
+				Required: true,
+			},
+			"from_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `from_ref` resolved to",
+				Computed:            true,
+			},
+			"to_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"changed": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not a file matching `pathspec` changed",
+				Computed:            true,
+			},
+			"files": schema.ListAttribute{
+				MarkdownDescription: "Changed files matching `pathspec`, sorted, renames list both the old and new path",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitPathChanged) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitPathChanged) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitPathChangedModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	from, err := resolveCommit(repo, data.FromRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	changes, err := diffCommits(ctx, from, to)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to diff references", err.Error())
+		return
+	}
+
+	pathspec := data.Pathspec.ValueString()
+	files := map[string]bool{}
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			if name != "" && gitutils.MatchPath(pathspec, name) {
+				files[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data.Files = []types.String{}
+	for _, name := range names {
+		data.Files = append(data.Files, types.StringValue(name))
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("path: %s changed: %t", pathspec, len(names) > 0))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s:%s", data.Path.ValueString(), from.Hash.String(), to.Hash.String(), pathspec))
+	data.FromCommit = types.StringValue(from.Hash.String())
+	data.ToCommit = types.StringValue(to.Hash.String())
+	data.Changed = types.BoolValue(len(names) > 0)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
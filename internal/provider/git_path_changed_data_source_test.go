@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitPathChangedDataSourceConfig(path string, fromRef string, pathspec string) string {
+	return fmt.Sprintf(`
+data "git_path_changed" "test" {
+  path     = %[1]q
+  from_ref = %[2]q
+  pathspec = %[3]q
+}
+`, path, fromRef, pathspec)
+}
+
+func TestAccGitPathChangedDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	for _, stack := range []string{"network", "app"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "stacks", stack), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "stacks", stack, "main.tf"), []byte("# "+stack), 0644))
+	}
+	_, err = testCommitAll(tempDir, "stacks")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "stacks", "network", "main.tf"), []byte("# network changed"), 0644))
+	_, err = testCommitAll(tempDir, "network")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitPathChangedDataSourceConfig(tempDir, "HEAD~1", "stacks/network"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "changed", "true"),
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "files.0", "stacks/network/main.tf"),
+				),
+			},
+			{
+				Config: testAccGitPathChangedDataSourceConfig(tempDir, "HEAD~1", "stacks/app"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "changed", "false"),
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "files.#", "0"),
+				),
+			},
+			{
+				Config: testAccGitPathChangedDataSourceConfig(tempDir, "HEAD~2", "stacks/*/main.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "changed", "true"),
+					resource.TestCheckResourceAttr("data.git_path_changed.test", "files.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,748 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitPull{}
+var _ resource.ResourceWithModifyPlan = &GitPull{}
+var _ resource.ResourceWithValidateConfig = &GitPull{}
+
+// pullModes are the values of the mode attribute.
+var pullModes = []string{"ff-only", "merge", "rebase"}
+
+func NewGitPull() resource.Resource {
+	return &GitPull{}
+}
+
+// GitPull defines the resource implementation.
+type GitPull struct {
+	provider *GitProviderData
+}
+
+// GitPullModel describes the resource data model.
+type GitPullModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Path                 types.String `tfsdk:"path"`
+	Serialize            types.Bool   `tfsdk:"serialize"`
+	Branch               types.String `tfsdk:"branch"`
+	Mode                 types.String `tfsdk:"mode"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	AuthorName           types.String `tfsdk:"author_name"`
+	AuthorEmail          types.String `tfsdk:"author_email"`
+	CommitterName        types.String `tfsdk:"committer_name"`
+	CommitterEmail       types.String `tfsdk:"committer_email"`
+	Sign                 types.Bool   `tfsdk:"sign"`
+	SigningKey           types.String `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String `tfsdk:"signing_key_passphrase"`
+	Upstream             types.String `tfsdk:"upstream"`
+	Commit               types.String `tfsdk:"commit"`
+	UpstreamCommit       types.String `tfsdk:"upstream_commit"`
+	RefUpdates           types.List   `tfsdk:"ref_updates"`
+}
+
+func (r *GitPull) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pull"
+}
+
+func (r *GitPull) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Pull resource, updates a local branch to its upstream like `git pull` does: fetches " +
+			"the upstream branch and fast forwards the local branch to it, or merges or rebases it when they have " +
+			"diverged and `mode` allows it. The upstream is checked on every plan and pulled again whenever it moved or " +
+			"the local branch fell behind. A checked out branch also updates the working tree, which must not have local " +
+			"changes to tracked files. Files changed on both sides are merged line by line, like the `git_merge_check` data source, " +
+			"and conflicts fail the pull leaving the branch as it is. Destroying the resource leaves the branch as it is",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Local branch to update, its upstream is configured by `branch.<name>.remote` and " +
+					"`branch.<name>.merge` (default: the branch HEAD points at)",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "What to do when the local branch has diverged from its upstream, one of `ff-only` " +
+					"(fail), `merge` (create a merge commit) or `rebase` (replay the local commits on top of the " +
+					"upstream) (default: ff-only)",
+				Optional: true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the author of merge commits, and committer unless `committer_name` is set, " +
+					"defaults to `user.name` of the git config",
+				Optional: true,
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the author of merge commits, and committer unless `committer_email` is set, " +
+					"defaults to `user.email` of the git config",
+				Optional: true,
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the committer of the merge or rebased commits, defaults to the author",
+				Optional:            true,
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the committer of the merge or rebased commits, defaults to the author",
+				Optional:            true,
+			},
+			"sign": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to sign the merge or rebased commits, false disables signing even when the provider sets a " +
+					"`signing_key`, true requires a signing key (default: signed when a signing key is set)",
+				Optional: true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key to sign the merge or rebased commits with, overriding the provider `signing_key`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"upstream": schema.StringAttribute{
+				MarkdownDescription: "Upstream tracking branch (ie. `origin/main`), empty when none is configured",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit at the tip of the local branch",
+				Computed:            true,
+			},
+			"upstream_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit of the upstream branch last pulled",
+				Computed:            true,
+			},
+			"ref_updates": refUpdatesAttribute(),
+		},
+	}
+}
+
+func (r *GitPull) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitPull) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitPullModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Mode.IsNull() || data.Mode.IsUnknown() {
+		return
+	}
+
+	for _, mode := range pullModes {
+		if data.Mode.ValueString() == mode {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("mode"), "invalid mode", fmt.Sprintf("mode must be one of: %s", strings.Join(pullModes, ", ")))
+}
+
+func (r *GitPull) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying or creating.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data GitPullModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Commit.IsUnknown() || data.UpstreamCommit.IsUnknown() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	// The state holds the commits of the last refresh, the branch is pulled
+	// again once the upstream moved or the branch no longer contains it.
+	stale := data.UpstreamCommit.ValueString() == ""
+	if !stale {
+		upstream, err := remoteUpstreamHash(ctx, repo, data.Branch.ValueString(), r.provider.repositoryAuth(data.Path, data.Username, data.Password))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("branch"), "unable to list upstream", err.Error())
+			return
+		}
+		stale = upstream.String() != data.UpstreamCommit.ValueString()
+	}
+	if !stale {
+		stale, err = notContains(repo, data.Commit.ValueString(), data.UpstreamCommit.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to compare commits", err.Error())
+			return
+		}
+	}
+
+	if stale {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("commit"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("upstream_commit"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ref_updates"), refUpdatesUnknown())...)
+	}
+}
+
+func (r *GitPull) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitPullModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+
+	resp.Diagnostics.Append(r.pull(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", repoPath, data.Branch.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitPull) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitPullModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	tip, err := branchTip(repo, plumbing.NewBranchReferenceName(data.Branch.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read branch", err.Error())
+		return
+	}
+	if tip == nil {
+		tflog.Warn(ctx, fmt.Sprintf("branch %s no longer exists, removing from state", data.Branch.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Commit = types.StringValue(tip.Hash.String())
+
+	upstream, err := gitutils.BranchUpstream(repo, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read upstream", err.Error())
+		return
+	}
+
+	data.Upstream = types.StringValue("")
+	data.UpstreamCommit = types.StringValue("")
+	if upstream != nil {
+		data.Upstream = types.StringValue(upstream.Reference().Short())
+
+		upstreamTip, err := branchTip(repo, upstream.Reference())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read upstream", err.Error())
+			return
+		}
+		if upstreamTip != nil {
+			data.UpstreamCommit = types.StringValue(upstreamTip.Hash.String())
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitPull) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitPullModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.pull(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitPull) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The branch is left where it is.
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+// pull fetches the upstream of the branch of data and updates the branch to
+// it according to the mode of data, setting the commits pulled.
+func (r *GitPull) pull(ctx context.Context, data *GitPullModel) (diags diag.Diagnostics) {
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		diags.AddError("unable to open git repository", err.Error())
+		return diags
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		diags.AddError("unable to lock repository", err.Error())
+		return diags
+	}
+	defer unlock()
+
+	snapshot, err := snapshotRefs(repo)
+	if err != nil {
+		diags.AddError("unable to read references", err.Error())
+		return diags
+	}
+
+	if data.Branch.ValueString() == "" {
+		branch, err := gitutils.CurrentBranch(repo)
+		if err != nil {
+			diags.AddAttributeError(path.Root("branch"), "unable to determine branch", err.Error())
+			return diags
+		}
+		data.Branch = types.StringValue(branch)
+	}
+	branch := data.Branch.ValueString()
+
+	upstream, err := gitutils.BranchUpstream(repo, branch)
+	if err != nil {
+		diags.AddAttributeError(path.Root("branch"), "unable to read upstream", err.Error())
+		return diags
+	}
+	if upstream == nil {
+		diags.AddAttributeError(path.Root("branch"), "branch has no upstream",
+			fmt.Sprintf("branch.%[1]s.remote and branch.%[1]s.merge must be set to pull %[1]s", branch))
+		return diags
+	}
+	data.Upstream = types.StringValue(upstream.Reference().Short())
+
+	if upstream.Remote != "." {
+		tflog.Trace(ctx, fmt.Sprintf("fetching %s from %s", upstream.Merge.Short(), upstream.Remote))
+		if _, err := fetchBranch(ctx, repo, upstream.Remote, upstream.Merge.Short(), r.provider.repositoryAuth(data.Path, data.Username, data.Password)); err != nil {
+			diags.AddAttributeError(path.Root("branch"), "unable to fetch", err.Error())
+			return diags
+		}
+	}
+
+	local, err := branchTip(repo, plumbing.NewBranchReferenceName(branch))
+	if err == nil && local == nil {
+		err = fmt.Errorf("branch %s does not exist", branch)
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("branch"), "unable to read branch", err.Error())
+		return diags
+	}
+
+	theirs, err := branchTip(repo, upstream.Reference())
+	if err == nil && theirs == nil {
+		err = fmt.Errorf("upstream branch %s does not exist", upstream.Reference().Short())
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("branch"), "unable to read upstream", err.Error())
+		return diags
+	}
+	data.UpstreamCommit = types.StringValue(theirs.Hash.String())
+
+	hash, d := r.pullCommit(ctx, repo, data, upstream, local, theirs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if hash != local.Hash {
+		if err := checkCleanBranch(repo, branch); err != nil {
+			diags.AddError("unable to pull", err.Error())
+			return diags
+		}
+		if err := setBranch(repo, branch, hash); err != nil {
+			diags.AddError("unable to update branch", err.Error())
+			return diags
+		}
+		tflog.Trace(ctx, fmt.Sprintf("updated %s from %s to %s", branch, local.Hash.String(), hash.String()))
+	}
+
+	data.Commit = types.StringValue(hash.String())
+
+	updates, err := snapshot.updates(repo)
+	if err != nil {
+		diags.AddError("unable to read references", err.Error())
+		return diags
+	}
+	data.RefUpdates = refUpdatesValue(updates)
+
+	return diags
+}
+
+// pullCommit returns the commit the branch at local moves to when pulling
+// theirs from upstream, creating the merge or rebased commits the mode of data
+// asks for when they have diverged.
+func (r *GitPull) pullCommit(ctx context.Context, repo *git.Repository, data *GitPullModel, upstream *gitutils.Upstream, local *object.Commit, theirs *object.Commit) (plumbing.Hash, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hash, diverged, err := fastForward(local, theirs)
+	if err != nil {
+		diags.AddError("unable to pull", err.Error())
+		return plumbing.ZeroHash, diags
+	}
+	if !diverged {
+		return hash, diags
+	}
+
+	mode := data.Mode.ValueString()
+	if mode == "" || mode == "ff-only" {
+		diags.AddAttributeError(path.Root("mode"), "unable to pull",
+			fmt.Sprintf("branch %s has diverged from %s, set mode to merge or rebase to pull it",
+				data.Branch.ValueString(), data.Upstream.ValueString()))
+		return plumbing.ZeroHash, diags
+	}
+
+	if mode == "rebase" {
+		if err := r.provider.checkProtectedRef(plumbing.NewBranchReferenceName(data.Branch.ValueString()), "rebase"); err != nil {
+			diags.AddAttributeError(path.Root("mode"), "unable to pull", err.Error())
+			return plumbing.ZeroHash, diags
+		}
+	}
+
+	identity, d := r.provider.commitIdentity(repo, r.identity(data))
+	diags.Append(d...)
+	if diags.HasError() {
+		return plumbing.ZeroHash, diags
+	}
+
+	if mode == "rebase" {
+		hash, err = rebaseCommits(ctx, repo, local, theirs, identity)
+	} else {
+		// Like `git pull`, the message names the branch and the remote URL.
+		message := fmt.Sprintf("Merge branch '%s'", upstream.Merge.Short())
+		if remote, err := repo.Remote(upstream.Remote); err == nil && len(remote.Config().URLs) > 0 {
+			message += " of " + remote.Config().URLs[0]
+		}
+		hash, err = mergeCommit(ctx, repo, local, theirs, message, identity)
+	}
+	if err != nil {
+		diags.AddError("unable to pull", err.Error())
+	}
+
+	return hash, diags
+}
+
+// fastForward returns the commit the branch at local moves to when pulling
+// theirs without creating commits, or whether they have diverged.
+func fastForward(local *object.Commit, theirs *object.Commit) (plumbing.Hash, bool, error) {
+	if local.Hash == theirs.Hash {
+		return local.Hash, false, nil
+	}
+	if ok, err := theirs.IsAncestor(local); err != nil || ok {
+		return local.Hash, false, err
+	}
+	if ok, err := local.IsAncestor(theirs); err != nil || ok {
+		return theirs.Hash, false, err
+	}
+	return plumbing.ZeroHash, true, nil
+}
+
+// identity returns the identity configuration of the commits to create for
+// data.
+func (r *GitPull) identity(data *GitPullModel) commitIdentityConfig {
+	return commitIdentityConfig{
+		AuthorName:           data.AuthorName,
+		AuthorEmail:          data.AuthorEmail,
+		CommitterName:        data.CommitterName,
+		CommitterEmail:       data.CommitterEmail,
+		Sign:                 data.Sign,
+		SigningKey:           data.SigningKey,
+		SigningKeyPassphrase: data.SigningKeyPassphrase,
+	}
+}
+
+// mergeCommit writes the commit merging theirs into ours with message and
+// returns its hash, an error when they conflict.
+func mergeCommit(ctx context.Context, repo *git.Repository, ours *object.Commit, theirs *object.Commit, message string, identity *commitIdentity) (plumbing.Hash, error) {
+	bases, err := ours.MergeBase(theirs)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if len(bases) == 0 {
+		return plumbing.ZeroHash, fmt.Errorf("%s and %s have no common ancestor", ours.Hash.String(), theirs.Hash.String())
+	}
+
+	treeHash, err := applyChanges(ctx, repo, bases[0], theirs, ours)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return writeCommit(repo, &object.Commit{
+		Author:       *identity.Author,
+		Committer:    *identity.Committer,
+		Message:      message + "\n",
+		TreeHash:     treeHash,
+		ParentHashes: []plumbing.Hash{ours.Hash, theirs.Hash},
+	}, identity.SignKey)
+}
+
+// rebaseCommits replays the commits of ours missing from theirs on top of it,
+// like `git rebase` does, and returns the hash of the last one. Commits
+// becoming empty are dropped and merge commits are not supported.
+func rebaseCommits(ctx context.Context, repo *git.Repository, ours *object.Commit, theirs *object.Commit, identity *commitIdentity) (plumbing.Hash, error) {
+	var commits []*object.Commit
+	if err := walkRange(theirs, ours, func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return fmt.Errorf("unable to rebase merge commit %s", c.Hash.String())
+		}
+		commits = append(commits, c)
+		return nil
+	}); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	tip := theirs
+	for i := len(commits) - 1; i >= 0; i-- {
+		c := commits[i]
+
+		parent, err := c.Parent(0)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		treeHash, err := applyChanges(ctx, repo, parent, c, tip)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("unable to rebase %s: %v", c.Hash.String(), err)
+		}
+		if treeHash == tip.TreeHash {
+			tflog.Trace(ctx, fmt.Sprintf("dropping %s, already applied", c.Hash.String()))
+			continue
+		}
+
+		hash, err := writeCommit(repo, &object.Commit{
+			Author:       c.Author,
+			Committer:    *identity.Committer,
+			Message:      c.Message,
+			TreeHash:     treeHash,
+			ParentHashes: []plumbing.Hash{tip.Hash},
+		}, identity.SignKey)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		if tip, err = repo.CommitObject(hash); err != nil {
+			return plumbing.ZeroHash, err
+		}
+	}
+
+	return tip.Hash, nil
+}
+
+// applyChanges writes the tree of onto with the changes between base and
+// changes merged into it and returns its hash, an error when they conflict.
+func applyChanges(ctx context.Context, repo *git.Repository, base *object.Commit, changes *object.Commit, onto *object.Commit) (plumbing.Hash, error) {
+	merge, err := mergeCommitTrees(ctx, base, onto, changes)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if len(merge.Conflicts) > 0 {
+		return plumbing.ZeroHash, fmt.Errorf("conflicts in %s", strings.Join(merge.Conflicts, ", "))
+	}
+
+	tree, err := onto.Tree()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return gitutils.WriteTree(repo.Storer, tree, merge.Changes)
+}
+
+// checkCleanBranch returns an error when branch is checked out in a working
+// tree with local changes to tracked files.
+func checkCleanBranch(repo *git.Repository, branch string) error {
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil || head.Type() != plumbing.SymbolicReference || head.Target() != plumbing.NewBranchReferenceName(branch) {
+		return nil
+	}
+
+	wt, err := repo.Worktree()
+	if errors.Is(err, git.ErrIsBareRepository) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return err
+	}
+
+	for name, file := range status {
+		if file.Worktree != git.Untracked {
+			return fmt.Errorf("working tree has local changes to %s", name)
+		}
+	}
+
+	return nil
+}
+
+// remoteUpstreamHash lists the remote of the upstream of branch and returns
+// the hash of the upstream branch, the zero hash when it does not exist.
+func remoteUpstreamHash(ctx context.Context, repo *git.Repository, branch string, auth transport.AuthMethod) (plumbing.Hash, error) {
+	upstream, err := gitutils.BranchUpstream(repo, branch)
+	if err != nil || upstream == nil {
+		return plumbing.ZeroHash, err
+	}
+
+	if upstream.Remote == "." {
+		ref, err := repo.Reference(upstream.Merge, true)
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return plumbing.ZeroHash, nil
+		}
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		return ref.Hash(), nil
+	}
+
+	remote, err := repo.Remote(upstream.Remote)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	refs, err := listFetchRefs(ctx, remote, auth)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	for _, ref := range refs {
+		if ref.Name() == upstream.Merge {
+			return ref.Hash(), nil
+		}
+	}
+
+	return plumbing.ZeroHash, nil
+}
+
+// notContains returns whether the commit hash does not contain the commit
+// other in its history, or either no longer exists.
+func notContains(repo *git.Repository, hash string, other string) (bool, error) {
+	if hash == other {
+		return false, nil
+	}
+
+	commit, err := repo.CommitObject(plumbing.NewHash(hash))
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	otherCommit, err := repo.CommitObject(plumbing.NewHash(other))
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	ok, err := otherCommit.IsAncestor(commit)
+	return !ok, err
+}
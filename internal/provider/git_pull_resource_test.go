@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitPullResourceConfig(path string, mode string) string {
+	return fmt.Sprintf(`
+resource "git_pull" "test" {
+  path         = %[1]q
+  mode         = %[2]q
+  author_name  = "Test"
+  author_email = "test@example.com"
+}
+`, path, mode)
+}
+
+// testCommitFile writes content to file in the repository at path and
+// commits it.
+func testCommitFile(path string, file string, content string) (*plumbing.Hash, error) {
+	if err := os.WriteFile(filepath.Join(path, file), []byte(content), 0644); err != nil {
+		return nil, err
+	}
+	return testCommitAll(path, fmt.Sprintf("update %s", file))
+}
+
+// testCheckParents checks the parents of the commit the attribute key of the
+// resource name is set to.
+func testCheckParents(path string, name string, key string, parents ...func() string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		commit, err := repo.CommitObject(plumbing.NewHash(s.RootModule().Resources[name].Primary.Attributes[key]))
+		if err != nil {
+			return err
+		}
+
+		if len(commit.ParentHashes) != len(parents) {
+			return fmt.Errorf("expected %d parents, got %d", len(parents), len(commit.ParentHashes))
+		}
+		for i, parent := range parents {
+			if commit.ParentHashes[i].String() != parent() {
+				return fmt.Errorf("expected parent %d to be %s, got %s", i, parent(), commit.ParentHashes[i])
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccGitPullResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	originDir := filepath.Join(tempDir, "origin")
+	cloneDir := filepath.Join(tempDir, "clone")
+
+	head, err := testSetupGit(originDir, "", 1)
+	assert.NoError(t, err)
+
+	_, err = git.PlainClone(cloneDir, false, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	var upstream, local *plumbing.Hash
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitPullResourceConfig(cloneDir, "squash"),
+				ExpectError: regexp.MustCompile("invalid mode"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitPullResourceConfig(cloneDir, "ff-only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_pull.test", "id", cloneDir+":master"),
+					resource.TestCheckResourceAttr("git_pull.test", "branch", "master"),
+					resource.TestCheckResourceAttr("git_pull.test", "upstream", "origin/master"),
+					resource.TestCheckResourceAttr("git_pull.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("git_pull.test", "upstream_commit", head.String()),
+				),
+			},
+			// Fast forward testing
+			{
+				PreConfig: func() {
+					upstream, err = testCommitFile(originDir, "upstream.txt", "upstream 1")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitPullResourceConfig(cloneDir, "ff-only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						return resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("git_pull.test", "commit", upstream.String()),
+							resource.TestCheckResourceAttr("git_pull.test", "upstream_commit", upstream.String()),
+							resource.TestCheckResourceAttr("git_pull.test", "ref_updates.#", "2"),
+							resource.TestCheckResourceAttr("git_pull.test", "ref_updates.0.ref", "refs/heads/master"),
+							resource.TestCheckResourceAttr("git_pull.test", "ref_updates.0.old", head.String()),
+							resource.TestCheckResourceAttr("git_pull.test", "ref_updates.0.new", upstream.String()),
+							resource.TestCheckResourceAttr("git_pull.test", "ref_updates.1.ref", "refs/remotes/origin/master"),
+						)(s)
+					},
+					testCheckWorktreeFile(cloneDir, "upstream.txt", "upstream 1"),
+				),
+			},
+			// Diverged testing
+			{
+				PreConfig: func() {
+					upstream, err = testCommitFile(originDir, "upstream.txt", "upstream 2")
+					assert.NoError(t, err)
+					local, err = testCommitFile(cloneDir, "local.txt", "local 1")
+					assert.NoError(t, err)
+				},
+				Config:      testAccGitPullResourceConfig(cloneDir, "ff-only"),
+				ExpectError: regexp.MustCompile("has diverged"),
+			},
+			{
+				Config: `
+provider "git" {
+  protected_refs = ["refs/heads/*"]
+}
+` + testAccGitPullResourceConfig(cloneDir, "rebase"),
+				ExpectError: regexp.MustCompile("refusing to rebase refs/heads/master"),
+			},
+			// Rebase testing
+			{
+				Config: testAccGitPullResourceConfig(cloneDir, "rebase"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckParents(cloneDir, "git_pull.test", "commit", func() string { return upstream.String() }),
+					testCheckWorktreeFile(cloneDir, "upstream.txt", "upstream 2"),
+					testCheckWorktreeFile(cloneDir, "local.txt", "local 1"),
+				),
+			},
+			// Merge testing
+			{
+				PreConfig: func() {
+					upstream, err = testCommitFile(originDir, "upstream.txt", "upstream 3")
+					assert.NoError(t, err)
+					local, err = testCommitFile(cloneDir, "local.txt", "local 2")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitPullResourceConfig(cloneDir, "merge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckParents(cloneDir, "git_pull.test", "commit",
+						func() string { return local.String() }, func() string { return upstream.String() }),
+					testCheckWorktreeFile(cloneDir, "upstream.txt", "upstream 3"),
+					testCheckWorktreeFile(cloneDir, "local.txt", "local 2"),
+				),
+			},
+			// Line merge testing
+			{
+				PreConfig: func() {
+					upstream, err = testCommitFile(originDir, "lines.txt", "1\n2\n3\n4\n5\n6\n")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitPullResourceConfig(cloneDir, "merge"),
+				Check:  testCheckWorktreeFile(cloneDir, "lines.txt", "1\n2\n3\n4\n5\n6\n"),
+			},
+			{
+				PreConfig: func() {
+					upstream, err = testCommitFile(originDir, "lines.txt", "one\n2\nthree\n4\n5\n6\n")
+					assert.NoError(t, err)
+					local, err = testCommitFile(cloneDir, "lines.txt", "1\n2\n3\n4\n5\nsix\nseven\n")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitPullResourceConfig(cloneDir, "merge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckParents(cloneDir, "git_pull.test", "commit",
+						func() string { return local.String() }, func() string { return upstream.String() }),
+					testCheckWorktreeFile(cloneDir, "lines.txt", "one\n2\nthree\n4\n5\nsix\nseven\n"),
+				),
+			},
+			// Conflict testing
+			{
+				PreConfig: func() {
+					_, err = testCommitFile(originDir, "local.txt", "upstream")
+					assert.NoError(t, err)
+					local, err = testCommitFile(cloneDir, "local.txt", "local 3")
+					assert.NoError(t, err)
+				},
+				Config:      testAccGitPullResourceConfig(cloneDir, "merge"),
+				ExpectError: regexp.MustCompile("conflicts in local.txt"),
+			},
+		},
+	})
+}
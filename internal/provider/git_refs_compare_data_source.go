@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRefsCompare{}
+
+func NewGitRefsCompare() datasource.DataSource {
+	return &GitRefsCompare{}
+}
+
+// GitRefsCompare defines the data source implementation.
+type GitRefsCompare struct {
+	provider *GitProviderData
+}
+
+// GitRefsCompareModel describes the data source data model.
+type GitRefsCompareModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Source       types.String   `tfsdk:"source"`
+	Target       types.String   `tfsdk:"target"`
+	Username     types.String   `tfsdk:"username"`
+	Password     types.String   `tfsdk:"password"`
+	Namespaces   []types.String `tfsdk:"namespaces"`
+	OnlyInSource []types.String `tfsdk:"only_in_source"`
+	OnlyInTarget []types.String `tfsdk:"only_in_target"`
+	Different    []types.String `tfsdk:"different"`
+	InSync       types.Bool     `tfsdk:"in_sync"`
+}
+
+func (d *GitRefsCompare) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refs_compare"
+}
+
+func (d *GitRefsCompare) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Refs Compare data source, compares the branches and tags, or any other namespace of " +
+			"references, of two repositories, ie. to check a mirror is up to date",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path or URL of the source repository",
+				Required:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Path or URL of the target repository (ie. the mirror)",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication against remote repositories",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication against remote repositories",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"namespaces": schema.ListAttribute{
+				MarkdownDescription: "Namespaces or globs of the references to compare, ie. `refs/pull` to also compare the pull " +
+					"requests of a forge mirror (default: `[\"refs/heads\", \"refs/tags\"]`)",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"only_in_source": schema.ListAttribute{
+				MarkdownDescription: "References only present in the source repository",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"only_in_target": schema.ListAttribute{
+				MarkdownDescription: "References only present in the target repository",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"different": schema.ListAttribute{
+				MarkdownDescription: "References present in both repositories pointing at different objects",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"in_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not both repositories have the same references pointing at the same objects",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitRefsCompare) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRefsCompare) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRefsCompareModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Namespaces) == 0 {
+		data.Namespaces = []types.String{types.StringValue("refs/heads"), types.StringValue("refs/tags")}
+	}
+
+	namespaces := toStrings(data.Namespaces)
+
+	sourceLocation, sourceAuth := d.provider.repositoryLocation(data.Source, data.Username, data.Password)
+	source, err := listComparableRefs(d.provider, sourceLocation, sourceAuth, namespaces)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "unable to list references", err.Error())
+		return
+	}
+
+	targetLocation, targetAuth := d.provider.repositoryLocation(data.Target, data.Username, data.Password)
+	target, err := listComparableRefs(d.provider, targetLocation, targetAuth, namespaces)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("target"), "unable to list references", err.Error())
+		return
+	}
+
+	var onlyInSource, onlyInTarget, different []string
+	for name, hash := range source {
+		targetHash, ok := target[name]
+		switch {
+		case !ok:
+			onlyInSource = append(onlyInSource, name)
+		case targetHash != hash:
+			different = append(different, name)
+		}
+	}
+	for name := range target {
+		if _, ok := source[name]; !ok {
+			onlyInTarget = append(onlyInTarget, name)
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("only in source: %v only in target: %v different: %v", onlyInSource, onlyInTarget, different))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Source.ValueString(), data.Target.ValueString()))
+	data.OnlyInSource = sortedStringValues(onlyInSource)
+	data.OnlyInTarget = sortedStringValues(onlyInTarget)
+	data.Different = sortedStringValues(different)
+	data.InSync = types.BoolValue(len(onlyInSource) == 0 && len(onlyInTarget) == 0 && len(different) == 0)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listComparableRefs maps the references of the repository at location, a
+// local path or a remote URL, matching any of namespaces to the hash they
+// point at. Local references are read from loose and packed refs alike.
+func listComparableRefs(p *GitProviderData, location string, auth transport.AuthMethod, namespaces []string) (map[string]plumbing.Hash, error) {
+	var refs []*plumbing.Reference
+
+	if info, err := os.Stat(location); err == nil && info.IsDir() {
+		repo, err := p.openRepository(location)
+		if err != nil {
+			return nil, err
+		}
+
+		iter, err := repo.References()
+		if err != nil {
+			return nil, err
+		}
+
+		err = iter.ForEach(func(ref *plumbing.Reference) error {
+			refs = append(refs, ref)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		refs, err = listRemote(location, auth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hashes := map[string]plumbing.Hash{}
+	for _, ref := range refs {
+		if ref.Type() != plumbing.HashReference || ref.Name() == plumbing.HEAD || !gitutils.MatchPaths(namespaces, nil, ref.Name().String()) {
+			continue
+		}
+		hashes[ref.Name().String()] = ref.Hash()
+	}
+
+	return hashes, nil
+}
+
+func sortedStringValues(values []string) []types.String {
+	sort.Strings(values)
+
+	result := []types.String{}
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitRefsCompareDataSourceConfig(source string, target string) string {
+	return fmt.Sprintf(`
+data "git_refs_compare" "test" {
+  source = %[1]q
+  target = %[2]q
+}
+`, source, target)
+}
+
+func testAccGitRefsCompareDataSourceConfigNamespaces(source string, target string, namespaces string) string {
+	return fmt.Sprintf(`
+data "git_refs_compare" "test" {
+  source     = %[1]q
+  target     = %[2]q
+  namespaces = %[3]s
+}
+`, source, target, namespaces)
+}
+
+func TestAccGitRefsCompareDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	targetDir := filepath.Join(tempDir, "target")
+
+	_, err = testSetupGit(sourceDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	source, err := git.PlainOpen(sourceDir)
+	assert.NoError(t, err)
+	head, err := source.Head()
+	assert.NoError(t, err)
+	tag, err := source.Tag("v1.0.0")
+	assert.NoError(t, err)
+	tagged, err := resolveCommit(source, "v1.0.0")
+	assert.NoError(t, err)
+
+	// Only references are compared so the target does not need the objects
+	target, err := git.PlainInit(targetDir, false)
+	assert.NoError(t, err)
+	assert.NoError(t, target.Storer.SetReference(plumbing.NewHashReference(head.Name(), tagged.Hash)))
+	assert.NoError(t, target.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("stale"), tagged.Hash)))
+	assert.NoError(t, target.Storer.SetReference(plumbing.NewHashReference(tag.Name(), tag.Hash())))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRefsCompareDataSourceConfig(sourceDir, targetDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "only_in_source.#", "0"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "only_in_target.#", "1"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "only_in_target.0", "refs/heads/stale"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "different.#", "1"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "different.0", head.Name().String()),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "in_sync", "false"),
+				),
+			},
+			{
+				Config: testAccGitRefsCompareDataSourceConfig(sourceDir, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "different.#", "0"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "in_sync", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRefsCompareDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	targetDir := filepath.Join(tempDir, "target")
+
+	hash, err := testSetupGit(sourceDir, "", 0)
+	assert.NoError(t, err)
+
+	// Forges store pull requests outside of branches and tags, mirrors often
+	// only have them in packed-refs.
+	assert.NoError(t, os.WriteFile(filepath.Join(sourceDir, ".git", "packed-refs"), []byte(fmt.Sprintf("# pack-refs with: peeled fully-peeled sorted\n%s refs/pull/1/head\n", hash.String())), 0644))
+
+	target, err := git.PlainInit(targetDir, false)
+	assert.NoError(t, err)
+	assert.NoError(t, target.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("master"), *hash)))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRefsCompareDataSourceConfig(sourceDir, targetDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "in_sync", "true"),
+				),
+			},
+			{
+				Config: testAccGitRefsCompareDataSourceConfigNamespaces(sourceDir, targetDir, `["refs/heads", "refs/pull/*/head"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "only_in_source.#", "1"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "only_in_source.0", "refs/pull/1/head"),
+					resource.TestCheckResourceAttr("data.git_refs_compare.test", "in_sync", "false"),
+				),
+			},
+		},
+	})
+}
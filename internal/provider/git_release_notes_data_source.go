@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitReleaseNotes{}
+
+func NewGitReleaseNotes() datasource.DataSource {
+	return &GitReleaseNotes{}
+}
+
+// GitReleaseNotes defines the data source implementation.
+type GitReleaseNotes struct {
+	provider *GitProviderData
+}
+
+// GitReleaseNotesModel describes the data source data model.
+type GitReleaseNotesModel struct {
+	Id              types.String                 `tfsdk:"id"`
+	Path            types.String                 `tfsdk:"path"`
+	FromRef         types.String                 `tfsdk:"from_ref"`
+	ToRef           types.String                 `tfsdk:"to_ref"`
+	Version         types.String                 `tfsdk:"version"`
+	Sections        []GitReleaseNotesSection     `tfsdk:"sections"`
+	BreakingTitle   types.String                 `tfsdk:"breaking_title"`
+	OtherTitle      types.String                 `tfsdk:"other_title"`
+	HeaderTemplate  types.String                 `tfsdk:"header_template"`
+	SectionTemplate types.String                 `tfsdk:"section_template"`
+	CommitTemplate  types.String                 `tfsdk:"commit_template"`
+	Commit          types.String                 `tfsdk:"commit"`
+	CommitCount     types.Int64                  `tfsdk:"commit_count"`
+	Commits         []GitReleaseNotesCommitModel `tfsdk:"commits"`
+	Notes           types.String                 `tfsdk:"notes"`
+}
+
+// GitReleaseNotesSection describes the section listing the commits of a type.
+type GitReleaseNotesSection struct {
+	Type  types.String `tfsdk:"type"`
+	Title types.String `tfsdk:"title"`
+}
+
+// GitReleaseNotesCommitModel describes a commit of the range.
+type GitReleaseNotesCommitModel struct {
+	Commit   types.String `tfsdk:"commit"`
+	Type     types.String `tfsdk:"type"`
+	Scope    types.String `tfsdk:"scope"`
+	Subject  types.String `tfsdk:"subject"`
+	Breaking types.Bool   `tfsdk:"breaking"`
+	Section  types.String `tfsdk:"section"`
+}
+
+func (d *GitReleaseNotes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release_notes"
+}
+
+func (d *GitReleaseNotes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Release Notes data source, renders markdown release notes from the commits between two " +
+			"references (`from_ref..to_ref`), grouped by [Conventional Commits](https://www.conventionalcommits.org) type. " +
+			"Breaking changes are listed first, in their own section, merge commits are left out",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, usually the previous release tag " +
+					"(default: the whole history)",
+				Optional: true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Include commits reachable from this reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version the notes are for, the `{version}` of `header_template` (default: `to_ref`)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"sections": schema.ListNestedAttribute{
+				MarkdownDescription: "Sections in order, commits of other types are left out unless `other_title` is set " +
+					"(default: `feat` as Features, `fix` as Bug Fixes and `perf` as Performance Improvements)",
+				Optional: true,
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Commit type listed in the section (ie. `feat`)",
+							Required:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the section (ie. `Features`)",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"breaking_title": schema.StringAttribute{
+				MarkdownDescription: "Title of the section listing breaking changes (default: Breaking Changes)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"other_title": schema.StringAttribute{
+				MarkdownDescription: "Title of the section listing the commits not matching any section, including those not " +
+					"following Conventional Commits, they are left out when empty (default: \"\")",
+				Optional: true,
+				Computed: true,
+			},
+			"header_template": schema.StringAttribute{
+				MarkdownDescription: "Template of the first line of the notes, `{version}` and `{date}` (the committer date of " +
+					"`to_ref`, ie. `2023-01-31`) are replaced, an empty string leaves the header out " +
+					"(default: `## {version} ({date})`)",
+				Optional: true,
+				Computed: true,
+			},
+			"section_template": schema.StringAttribute{
+				MarkdownDescription: "Template of the title of each section, `{title}` is replaced (default: `### {title}`)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit_template": schema.StringAttribute{
+				MarkdownDescription: "Template of each commit line, `{type}`, `{scope}`, `{scope_prefix}` (ie. `**api:** `, " +
+					"empty without scope), `{subject}`, `{summary}` (the first line of the message), `{commit}`, `{short}`, " +
+					"`{author_name}` and `{author_email}` are replaced (default: `- {scope_prefix}{subject} ({short})`)",
+				Optional: true,
+				Computed: true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `to_ref` resolved to",
+				Computed:            true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits in the range, merge commits excluded",
+				Computed:            true,
+			},
+			"commits": schema.ListNestedAttribute{
+				MarkdownDescription: "Commits in the range, newest first, merge commits excluded",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Conventional Commits type, lowercase, empty when the message does not follow it",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Conventional Commits scope, empty without scope",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "Subject of the commit, the first line of the message when it does not follow " +
+								"Conventional Commits",
+							Computed: true,
+						},
+						"breaking": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the commit is a breaking change",
+							Computed:            true,
+						},
+						"section": schema.StringAttribute{
+							MarkdownDescription: "Title of the section the commit is listed in, empty when it is left out",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"notes": schema.StringAttribute{
+				MarkdownDescription: "Rendered release notes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitReleaseNotes) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitReleaseNotes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitReleaseNotesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+	if data.Version.ValueString() == "" {
+		data.Version = data.ToRef
+	}
+	setReleaseNotesDefaults(&data)
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var from *object.Commit
+	if data.FromRef.ValueString() != "" {
+		from, err = resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	if err := renderReleaseNotes(ctx, from, to, &data); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), to.Hash.String()))
+	data.Commit = types.StringValue(to.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setReleaseNotesDefaults sets the options of data left unset to their
+// default.
+func setReleaseNotesDefaults(data *GitReleaseNotesModel) {
+	if data.Sections == nil {
+		data.Sections = []GitReleaseNotesSection{
+			{Type: types.StringValue("feat"), Title: types.StringValue("Features")},
+			{Type: types.StringValue("fix"), Title: types.StringValue("Bug Fixes")},
+			{Type: types.StringValue("perf"), Title: types.StringValue("Performance Improvements")},
+		}
+	}
+	if data.BreakingTitle.IsNull() {
+		data.BreakingTitle = types.StringValue("Breaking Changes")
+	}
+	if data.OtherTitle.IsNull() {
+		data.OtherTitle = types.StringValue("")
+	}
+	if data.HeaderTemplate.IsNull() {
+		data.HeaderTemplate = types.StringValue("## {version} ({date})")
+	}
+	if data.SectionTemplate.IsNull() {
+		data.SectionTemplate = types.StringValue("### {title}")
+	}
+	if data.CommitTemplate.IsNull() {
+		data.CommitTemplate = types.StringValue("- {scope_prefix}{subject} ({short})")
+	}
+}
+
+// renderReleaseNotes renders the notes of the commits between from, which may
+// be nil, and to into data, according to its options.
+func renderReleaseNotes(ctx context.Context, from *object.Commit, to *object.Commit, data *GitReleaseNotesModel) error {
+	sectionTitles := map[string]string{}
+	for _, section := range data.Sections {
+		sectionTitles[strings.ToLower(section.Type.ValueString())] = section.Title.ValueString()
+	}
+
+	lines := map[string][]string{}
+	data.Commits = []GitReleaseNotesCommitModel{}
+	if err := walkRange(from, to, func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return nil
+		}
+
+		summary := strings.SplitN(strings.TrimSpace(c.Message), "\n", 2)[0]
+		model := GitReleaseNotesCommitModel{
+			Commit:   types.StringValue(c.Hash.String()),
+			Type:     types.StringValue(""),
+			Scope:    types.StringValue(""),
+			Subject:  types.StringValue(summary),
+			Breaking: types.BoolValue(false),
+			Section:  types.StringValue(data.OtherTitle.ValueString()),
+		}
+
+		if conventional := gitutils.ParseConventionalCommit(c.Message); conventional != nil {
+			model.Type = types.StringValue(conventional.Type)
+			model.Scope = types.StringValue(conventional.Scope)
+			model.Subject = types.StringValue(conventional.Subject)
+			model.Breaking = types.BoolValue(conventional.Breaking)
+
+			if title, ok := sectionTitles[conventional.Type]; ok {
+				model.Section = types.StringValue(title)
+			}
+			if conventional.Breaking {
+				model.Section = data.BreakingTitle
+			}
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("commit: %s section: %s", c.Hash.String(), model.Section.ValueString()))
+
+		if section := model.Section.ValueString(); section != "" {
+			scopePrefix := ""
+			if model.Scope.ValueString() != "" {
+				scopePrefix = fmt.Sprintf("**%s:** ", model.Scope.ValueString())
+			}
+
+			lines[section] = append(lines[section], strings.NewReplacer(
+				"{type}", model.Type.ValueString(),
+				"{scope}", model.Scope.ValueString(),
+				"{scope_prefix}", scopePrefix,
+				"{subject}", model.Subject.ValueString(),
+				"{summary}", summary,
+				"{commit}", c.Hash.String(),
+				"{short}", c.Hash.String()[0:7],
+				"{author_name}", c.Author.Name,
+				"{author_email}", c.Author.Email,
+			).Replace(data.CommitTemplate.ValueString()))
+		}
+
+		data.Commits = append(data.Commits, model)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var blocks []string
+	if data.HeaderTemplate.ValueString() != "" {
+		blocks = append(blocks, strings.NewReplacer(
+			"{version}", data.Version.ValueString(),
+			"{date}", to.Committer.When.UTC().Format("2006-01-02"),
+		).Replace(data.HeaderTemplate.ValueString()))
+	}
+
+	titles := []string{data.BreakingTitle.ValueString()}
+	for _, section := range data.Sections {
+		titles = append(titles, section.Title.ValueString())
+	}
+	titles = append(titles, data.OtherTitle.ValueString())
+
+	rendered := map[string]bool{}
+	for _, title := range titles {
+		if len(lines[title]) == 0 || rendered[title] {
+			continue
+		}
+		rendered[title] = true
+
+		blocks = append(blocks,
+			strings.ReplaceAll(data.SectionTemplate.ValueString(), "{title}", title),
+			strings.Join(lines[title], "\n"),
+		)
+	}
+
+	data.CommitCount = types.Int64Value(int64(len(data.Commits)))
+	data.Notes = types.StringValue("")
+	if len(blocks) > 0 {
+		data.Notes = types.StringValue(strings.Join(blocks, "\n\n") + "\n")
+	}
+
+	return nil
+}
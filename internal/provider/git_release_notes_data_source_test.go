@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitReleaseNotesDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_release_notes" "test" {
+  path     = %[1]q
+  from_ref = "v1.0.0"
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitReleaseNotesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCommitMessages(tempDir,
+		"docs: typo",
+		"fix(api): handle empty body",
+		"feat: add export",
+		"refactor!: drop v1 endpoints",
+		"update dependencies",
+	))
+
+	head, err := testCommitAll(tempDir, "feat(cli): add --json")
+	assert.NoError(t, err)
+	short := head.String()[0:7]
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitReleaseNotesDataSourceConfig(tempDir, `
+  version         = "v2.0.0"
+  header_template = "## {version}"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commit_count", "6"),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commits.0.type", "feat"),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commits.0.scope", "cli"),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commits.0.section", "Features"),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commits.1.type", ""),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commits.1.section", ""),
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "commits.2.breaking", "true"),
+					resource.TestMatchResourceAttr("data.git_release_notes.test", "notes", regexp.MustCompile(
+						"^"+regexp.QuoteMeta("## v2.0.0\n\n### Breaking Changes\n\n- drop v1 endpoints ("),
+					)),
+					resource.TestMatchResourceAttr("data.git_release_notes.test", "notes", regexp.MustCompile(
+						regexp.QuoteMeta("### Features\n\n- **cli:** add --json ("+short+")\n- add export ("),
+					)),
+				),
+			},
+			{
+				Config: testAccGitReleaseNotesDataSourceConfig(tempDir, `
+  header_template  = ""
+  section_template = "{title}:"
+  commit_template  = "* {summary}"
+  other_title      = "Other"
+  sections = [
+    { type = "fix", title = "Fixes" },
+  ]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_release_notes.test", "notes",
+						"Breaking Changes:\n\n* refactor!: drop v1 endpoints\n\n"+
+							"Fixes:\n\n* fix(api): handle empty body\n\n"+
+							"Other:\n\n* feat(cli): add --json\n* update dependencies\n* feat: add export\n* docs: typo\n"),
+				),
+			},
+		},
+	})
+}
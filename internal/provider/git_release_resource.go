@@ -0,0 +1,624 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitRelease{}
+var _ resource.ResourceWithValidateConfig = &GitRelease{}
+
+func NewGitRelease() resource.Resource {
+	return &GitRelease{}
+}
+
+// GitRelease defines the resource implementation.
+type GitRelease struct {
+	provider *GitProviderData
+}
+
+// GitReleaseModel describes the resource data model.
+type GitReleaseModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Path                 types.String `tfsdk:"path"`
+	Name                 types.String `tfsdk:"name"`
+	Reference            types.String `tfsdk:"ref"`
+	FromRef              types.String `tfsdk:"from_ref"`
+	Notes                types.String `tfsdk:"notes"`
+	NotesRef             types.String `tfsdk:"notes_ref"`
+	Message              types.String `tfsdk:"message"`
+	AuthorName           types.String `tfsdk:"author_name"`
+	AuthorEmail          types.String `tfsdk:"author_email"`
+	CommitterName        types.String `tfsdk:"committer_name"`
+	CommitterEmail       types.String `tfsdk:"committer_email"`
+	Sign                 types.Bool   `tfsdk:"sign"`
+	SigningKey           types.String `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String `tfsdk:"signing_key_passphrase"`
+	Remote               types.String `tfsdk:"remote"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	Commit               types.String `tfsdk:"commit"`
+	Tag                  types.String `tfsdk:"tag"`
+	RefUpdates           types.List   `tfsdk:"ref_updates"`
+}
+
+func (r *GitRelease) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release"
+}
+
+func (r *GitRelease) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Release resource, creates an annotated tag, optionally signed, holding release notes " +
+			"rendered like the `git_release_notes` data source does, either as its message or as a note of the tagged " +
+			"commit, and pushes them to a remote. Git can not push them atomically, the note is pushed first and the tag " +
+			"last: when a push is rejected the tag and note are undone locally, but a note already pushed stays on the " +
+			"remote until the release is applied again. Any change of the release replaces it. Destroying the resource deletes the tag, locally " +
+			"and from the remote, but leaves the note",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag, without `refs/tags/` (ie. `v1.2.0`), also the version of the notes",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to release, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Previous release the notes start from (default: the closest tag reachable from `ref`, " +
+					"or the whole history when there is none)",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				MarkdownDescription: "Release notes, rendered from the commits between `from_ref` and `ref` with the defaults " +
+					"of the `git_release_notes` data source when unset",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notes_ref": schema.StringAttribute{
+				MarkdownDescription: "Notes reference to attach the notes to the released commit under, like `git notes " +
+					"add` does (ie. `refs/notes/commits`), instead of using them as the message of the tag",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message of the tag (default: the notes, or `Release <name>` when `notes_ref` is set or " +
+					"the notes are empty)",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tagger, and author of the note, defaults to `user.name` of the git config",
+				Optional:            true,
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the tagger, and author of the note, defaults to `user.email` of the git config",
+				Optional:            true,
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the committer of the note, defaults to the author",
+				Optional:            true,
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the committer of the note, defaults to the author",
+				Optional:            true,
+			},
+			"sign": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to sign the tag, false disables signing even when the provider sets a " +
+					"`signing_key`, true requires a signing key (default: signed when a signing key is set)",
+				Optional: true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key to sign the tag with, overriding the provider `signing_key`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote to push the tag and note to (ie. `origin`), by default they stay local",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit released",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Hash of the tag object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Only the credentials and author can change in place, which does not
+			// move any reference.
+			"ref_updates": refUpdatesAttribute(listplanmodifier.UseStateForUnknown()),
+		},
+	}
+}
+
+func (r *GitRelease) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitRelease) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitReleaseModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.IsUnknown() {
+		if err := gitutils.ValidRefName(data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "invalid name", err.Error())
+		}
+	}
+
+	if notesRef := data.NotesRef.ValueString(); !data.NotesRef.IsUnknown() && notesRef != "" {
+		if err := gitutils.ValidRefName(strings.TrimPrefix(notesRef, "refs/")); err != nil || !strings.HasPrefix(notesRef, "refs/notes/") {
+			resp.Diagnostics.AddAttributeError(path.Root("notes_ref"), "invalid notes_ref",
+				fmt.Sprintf("%q must be a reference under refs/notes/", notesRef))
+		}
+	}
+}
+
+func (r *GitRelease) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitReleaseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+
+	repo, err := r.provider.openRepository(repoPath)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	tagName := plumbing.NewTagReferenceName(data.Name.ValueString())
+	if _, err := repo.Reference(tagName, false); err == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "tag already exists",
+			fmt.Sprintf("%s already exists", data.Name.ValueString()))
+		return
+	} else if !errors.Is(err, plumbing.ErrReferenceNotFound) {
+		resp.Diagnostics.AddError("unable to read tag", err.Error())
+		return
+	}
+
+	rev := data.Reference.ValueString()
+	if rev == "" {
+		rev = "HEAD"
+	}
+	target, err := resolveCommit(repo, rev)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	if data.Notes.IsUnknown() {
+		notes, err := releaseNotes(ctx, repo, target, &data)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to render notes", err.Error())
+			return
+		}
+		data.Notes = types.StringValue(notes)
+	}
+
+	if data.Message.IsUnknown() {
+		data.Message = data.Notes
+		if data.NotesRef.ValueString() != "" || strings.TrimSpace(data.Notes.ValueString()) == "" {
+			data.Message = types.StringValue(fmt.Sprintf("Release %s", data.Name.ValueString()))
+		}
+	}
+
+	identity, diags := r.provider.commitIdentity(repo, commitIdentityConfig{
+		AuthorName:           data.AuthorName,
+		AuthorEmail:          data.AuthorEmail,
+		CommitterName:        data.CommitterName,
+		CommitterEmail:       data.CommitterEmail,
+		Sign:                 data.Sign,
+		SigningKey:           data.SigningKey,
+		SigningKeyPassphrase: data.SigningKeyPassphrase,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote := data.Remote.ValueString()
+	auth := r.provider.repositoryAuth(data.Path, data.Username, data.Password)
+	notesRef := plumbing.ReferenceName(data.NotesRef.ValueString())
+
+	snapshot, err := snapshotRefs(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read references", err.Error())
+		return
+	}
+
+	// The note is added on top of the notes of the remote, which the push would
+	// otherwise reject.
+	var remoteNotes plumbing.Hash
+	if remote != "" && notesRef != "" {
+		err := repo.FetchContext(ctx, &git.FetchOptions{
+			RemoteName: remote,
+			RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("%[1]s:%[1]s", notesRef))},
+			Auth:       auth,
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) && !errors.Is(err, git.NoMatchingRefSpecError{}) {
+			resp.Diagnostics.AddAttributeError(path.Root("notes_ref"), "unable to fetch notes", err.Error())
+			return
+		}
+		if err == nil || errors.Is(err, git.NoErrAlreadyUpToDate) {
+			if ref, err := repo.Reference(notesRef, true); err == nil {
+				remoteNotes = ref.Hash()
+			}
+		}
+	}
+
+	tagRef, err := repo.CreateTag(data.Name.ValueString(), target.Hash, &git.CreateTagOptions{
+		Tagger:  identity.Author,
+		Message: data.Message.ValueString(),
+		SignKey: identity.SignKey,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create tag", err.Error())
+		return
+	}
+
+	var notesTip *object.Commit
+	if notesRef != "" {
+		notesTip, err = branchTip(repo, notesRef)
+		if err == nil {
+			err = addNote(repo, notesRef, notesTip, target.Hash, data.Notes.ValueString(), identity)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("unable to add note", err.Error())
+			undoRelease(ctx, repo, tagName, notesRef, notesTip)
+			return
+		}
+	}
+
+	// go-git can not push atomically, the note is pushed before the tag so a
+	// rejected push never leaves the tag on the remote without its note.
+	if remote != "" {
+		var refNames []plumbing.ReferenceName
+		if notesRef != "" {
+			refNames = append(refNames, notesRef)
+		}
+		refNames = append(refNames, tagName)
+
+		for _, refName := range refNames {
+			err := repo.PushContext(ctx, &git.PushOptions{
+				RemoteName: remote,
+				RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("%[1]s:%[1]s", refName))},
+				Auth:       auth,
+			})
+			if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+				resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to push release",
+					fmt.Sprintf("unable to push %s to %s: %v", refName, remote, err))
+				undoRelease(ctx, repo, tagName, notesRef, notesTip)
+				return
+			}
+		}
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", repoPath, data.Name.ValueString()))
+	data.Commit = types.StringValue(target.Hash.String())
+	data.Tag = types.StringValue(tagRef.Hash().String())
+
+	updates, err := snapshot.updates(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read references", err.Error())
+		return
+	}
+	if remote != "" {
+		if notesRef != "" {
+			ref, err := repo.Reference(notesRef, true)
+			if err != nil {
+				resp.Diagnostics.AddError("unable to read notes", err.Error())
+				return
+			}
+			updates = append(updates, refUpdate{Remote: remote, Name: notesRef, Old: remoteNotes, New: ref.Hash()})
+		}
+		updates = append(updates, refUpdate{Remote: remote, Name: tagName, New: tagRef.Hash()})
+	}
+	data.RefUpdates = refUpdatesValue(updates)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRelease) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitReleaseModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	ref, err := repo.Reference(plumbing.NewTagReferenceName(data.Name.ValueString()), false)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("tag %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tag", err.Error())
+		return
+	}
+
+	if ref.Hash().String() != data.Tag.ValueString() {
+		tflog.Warn(ctx, fmt.Sprintf("tag %s was replaced outside of Terraform, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRelease) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitReleaseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credentials and author can change in place, nothing to do.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRelease) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitReleaseModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	tagName := plumbing.NewTagReferenceName(data.Name.ValueString())
+	if err := r.provider.checkProtectedRef(tagName, "delete"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "unable to delete tag", err.Error())
+		return
+	}
+
+	if remote := data.Remote.ValueString(); remote != "" {
+		err := repo.PushContext(ctx, &git.PushOptions{
+			RemoteName: remote,
+			RefSpecs:   []config.RefSpec{config.RefSpec(":" + tagName)},
+			Auth:       r.provider.repositoryAuth(data.Path, data.Username, data.Password),
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to delete remote tag", err.Error())
+			return
+		}
+	}
+
+	if err := repo.Storer.RemoveReference(tagName); err != nil {
+		resp.Diagnostics.AddError("unable to delete tag", err.Error())
+		return
+	}
+}
+
+// releaseNotes renders the notes of the commits between from_ref, or the tag
+// closest to target, and target.
+func releaseNotes(ctx context.Context, repo *git.Repository, target *object.Commit, data *GitReleaseModel) (string, error) {
+	var from *object.Commit
+	if data.FromRef.ValueString() != "" {
+		c, err := resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			return "", err
+		}
+		from = c
+	} else {
+		describe, err := gitutils.DescribeCommit(repo, target, gitutils.DescribeCommitOptions{Tags: true})
+		if err != nil && !errors.Is(err, gitutils.ErrNoDescribeNames) {
+			return "", err
+		}
+		if err == nil {
+			from, err = resolveCommit(repo, describe.Tag)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	notes := &GitReleaseNotesModel{Version: data.Name}
+	setReleaseNotesDefaults(notes)
+
+	if err := renderReleaseNotes(ctx, from, target, notes); err != nil {
+		return "", err
+	}
+
+	return notes.Notes.ValueString(), nil
+}
+
+// addNote commits content as the note of target on top of tip, the current
+// commit of notesRef which may be nil, like `git notes add -f` does.
+func addNote(repo *git.Repository, notesRef plumbing.ReferenceName, tip *object.Commit, target plumbing.Hash, content string, identity *commitIdentity) error {
+	var tree *object.Tree
+	var parents []plumbing.Hash
+
+	// Notes are named after the commit they annotate, possibly fanned out in
+	// directories of the first characters of the hash by git.
+	name := target.String()
+	if tip != nil {
+		var err error
+		if tree, err = tip.Tree(); err != nil {
+			return err
+		}
+		parents = append(parents, tip.Hash)
+
+		if entry, err := commitTreeEntry(tip, name[:2]+"/"+name[2:]); err != nil {
+			return err
+		} else if entry != nil {
+			name = name[:2] + "/" + name[2:]
+		}
+	}
+
+	treeHash, err := gitutils.WriteTree(repo.Storer, tree, map[string]*gitutils.TreeChange{
+		name: {Content: []byte(content)},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to write tree: %v", err)
+	}
+
+	// Like `git notes`, the notes commit itself is not signed.
+	hash, err := writeCommit(repo, &object.Commit{
+		Author:       *identity.Author,
+		Committer:    *identity.Committer,
+		Message:      "Notes added by 'git notes add'\n",
+		TreeHash:     treeHash,
+		ParentHashes: parents,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return repo.Storer.SetReference(plumbing.NewHashReference(notesRef, hash))
+}
+
+// undoRelease deletes the tag and resets the notes reference to tip, which
+// may be nil, after a failed release.
+func undoRelease(ctx context.Context, repo *git.Repository, tagName plumbing.ReferenceName, notesRef plumbing.ReferenceName, tip *object.Commit) {
+	if err := repo.Storer.RemoveReference(tagName); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to delete tag %s: %v", tagName.Short(), err))
+	}
+
+	if notesRef == "" {
+		return
+	}
+
+	var err error
+	if tip != nil {
+		err = repo.Storer.SetReference(plumbing.NewHashReference(notesRef, tip.Hash))
+	} else {
+		err = repo.Storer.RemoveReference(notesRef)
+	}
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to reset %s: %v", notesRef, err))
+	}
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitReleaseResourceConfig(path string, name string, options string) string {
+	return fmt.Sprintf(`
+resource "git_release" "test" {
+  path         = %[1]q
+  name         = %[2]q
+  remote       = "origin"
+  author_name  = "Release"
+  author_email = "release@example.com"
+  %[3]s
+}
+`, path, name, options)
+}
+
+// testArmoredPrivateKey returns the armored private key of entity.
+func testArmoredPrivateKey(entity *openpgp.Entity) (string, error) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := entity.SerializePrivate(w, nil); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func TestAccGitReleaseResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	sourceDir := filepath.Join(tempDir, "source")
+	originDir := filepath.Join(tempDir, "origin.git")
+	cloneDir := filepath.Join(tempDir, "clone.git")
+
+	_, err = testSetupGit(sourceDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(sourceDir, "feature.txt"), []byte("feature"), 0644))
+	_, err = testCommitAll(sourceDir, "feat: add feature")
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(sourceDir, "feature.txt"), []byte("fixed feature"), 0644))
+	head, err := testCommitAll(sourceDir, "fix: fix feature")
+	assert.NoError(t, err)
+
+	_, err = git.PlainClone(originDir, true, &git.CloneOptions{URL: sourceDir})
+	assert.NoError(t, err)
+	_, err = git.PlainClone(cloneDir, true, &git.CloneOptions{URL: originDir})
+	assert.NoError(t, err)
+
+	entity, err := openpgp.NewEntity("Release", "", "release@example.com", nil)
+	assert.NoError(t, err)
+	signingKey, err := testArmoredPrivateKey(entity)
+	assert.NoError(t, err)
+	keyring, err := testArmoredPublicKey(entity)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			for _, dir := range []string{cloneDir, originDir} {
+				if err := testCheckReleaseTag(dir, "v1.1.0", nil, "")(s); err == nil {
+					return fmt.Errorf("expected v1.1.0 to be deleted from %s", dir)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitReleaseResourceConfig(cloneDir, "v1.1.0..", ""),
+				ExpectError: regexp.MustCompile("invalid name"),
+			},
+			{
+				Config:      testAccGitReleaseResourceConfig(cloneDir, "v1.1.0", `notes_ref = "refs/heads/notes"`),
+				ExpectError: regexp.MustCompile("invalid notes_ref"),
+			},
+			// Rejected push testing
+			{
+				PreConfig: func() {
+					origin, err := git.PlainOpen(originDir)
+					assert.NoError(t, err)
+					_, err = origin.CreateTag("v1.1.0", *head, &git.CreateTagOptions{
+						Tagger:  &object.Signature{Name: "Other", Email: "other@example.com"},
+						Message: "Other release",
+					})
+					assert.NoError(t, err)
+				},
+				Config:      testAccGitReleaseResourceConfig(cloneDir, "v1.1.0", `notes_ref = "refs/notes/commits"`),
+				ExpectError: regexp.MustCompile("unable to push refs/tags/v1.1.0"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					clone, err := git.PlainOpen(cloneDir)
+					assert.NoError(t, err)
+					_, err = clone.Tag("v1.1.0")
+					assert.ErrorIs(t, err, git.ErrTagNotFound)
+					notes, err := branchTip(clone, "refs/notes/commits")
+					assert.NoError(t, err)
+					assert.Nil(t, notes)
+
+					assert.NoError(t, testCheckReleaseTag(originDir, "v1.1.0", []string{"Other release"}, "")(nil))
+					origin, err := git.PlainOpen(originDir)
+					assert.NoError(t, err)
+					assert.NoError(t, origin.DeleteTag("v1.1.0"))
+				},
+				Config: testAccGitReleaseResourceConfig(cloneDir, "v1.1.0", fmt.Sprintf("signing_key = %q", signingKey)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_release.test", "commit", head.String()),
+					resource.TestMatchResourceAttr("git_release.test", "notes", regexp.MustCompile(
+						`^## v1\.1\.0 \(\d{4}-\d{2}-\d{2}\)\n\n### Features\n\n- add feature \([0-9a-f]{7}\)\n\n### Bug Fixes\n\n- fix feature \([0-9a-f]{7}\)\n$`)),
+					resource.TestCheckResourceAttrPair("git_release.test", "message", "git_release.test", "notes"),
+					testCheckReleaseTag(originDir, "v1.1.0", []string{"### Features", "- add feature"}, keyring),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitReleaseResourceConfig(cloneDir, "v1.1.0", `
+  notes     = "Custom notes"
+  notes_ref = "refs/notes/commits"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_release.test", "message", "Release v1.1.0"),
+					testCheckReleaseTag(originDir, "v1.1.0", []string{"Release v1.1.0"}, ""),
+					testCheckNote(originDir, "refs/notes/commits", head.String(), "Custom notes"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.#", "4"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.0.ref", "refs/notes/commits"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.0.old", ""),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.1.ref", "refs/tags/v1.1.0"),
+					resource.TestCheckResourceAttrPair("git_release.test", "ref_updates.1.new", "git_release.test", "tag"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.2.remote", "origin"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.2.ref", "refs/notes/commits"),
+					resource.TestCheckResourceAttrSet("git_release.test", "ref_updates.2.old"),
+					resource.TestCheckResourceAttrPair("git_release.test", "ref_updates.2.new", "git_release.test", "ref_updates.0.new"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.3.remote", "origin"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.3.ref", "refs/tags/v1.1.0"),
+					resource.TestCheckResourceAttr("git_release.test", "ref_updates.3.old", ""),
+					resource.TestCheckResourceAttrPair("git_release.test", "ref_updates.3.new", "git_release.test", "tag"),
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					for _, dir := range []string{cloneDir, originDir} {
+						repo, err := git.PlainOpen(dir)
+						assert.NoError(t, err)
+						assert.NoError(t, repo.DeleteTag("v1.1.0"))
+					}
+				},
+				Config: testAccGitReleaseResourceConfig(cloneDir, "v1.1.0", `
+  notes     = "Custom notes"
+  notes_ref = "refs/notes/commits"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckReleaseTag(originDir, "v1.1.0", []string{"Release v1.1.0"}, ""),
+				),
+			},
+		},
+	})
+}
+
+// testCheckReleaseTag checks the annotated tag name of the repository at path
+// holds each of contains in its message, and is signed by keyring when set.
+func testCheckReleaseTag(path string, name string, contains []string, keyring string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		ref, err := repo.Reference(plumbing.NewTagReferenceName(name), false)
+		if err != nil {
+			return err
+		}
+
+		tag, err := repo.TagObject(ref.Hash())
+		if err != nil {
+			return err
+		}
+
+		for _, content := range contains {
+			if !strings.Contains(tag.Message, content) {
+				return fmt.Errorf("expected the message of %s to contain %q, got %q", name, content, tag.Message)
+			}
+		}
+
+		if keyring != "" {
+			if _, err := tag.Verify(keyring); err != nil {
+				return fmt.Errorf("unable to verify %s: %v", name, err)
+			}
+		} else if tag.PGPSignature != "" {
+			return fmt.Errorf("expected %s not to be signed", name)
+		}
+
+		return nil
+	}
+}
+
+// testCheckNote checks the note of commit under notesRef of the repository at
+// path.
+func testCheckNote(path string, notesRef string, commit string, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		tip, err := branchTip(repo, plumbing.ReferenceName(notesRef))
+		if err != nil {
+			return err
+		}
+		if tip == nil {
+			return fmt.Errorf("expected %s to exist", notesRef)
+		}
+
+		file, err := tip.File(commit)
+		if err != nil {
+			return err
+		}
+		actual, err := file.Contents()
+		if err != nil {
+			return err
+		}
+		if actual != content {
+			return fmt.Errorf("expected the note of %s to be %q, got %q", commit, content, actual)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRemoteFile{}
+
+func NewGitRemoteFile() datasource.DataSource {
+	return &GitRemoteFile{}
+}
+
+// GitRemoteFile defines the data source implementation.
+type GitRemoteFile struct {
+	provider *GitProviderData
+}
+
+// GitRemoteFileModel describes the data source data model.
+type GitRemoteFileModel struct {
+	Id            types.String `tfsdk:"id"`
+	URL           types.String `tfsdk:"url"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	File          types.String `tfsdk:"file"`
+	Reference     types.String `tfsdk:"ref"`
+	Commit        types.String `tfsdk:"commit"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Size          types.Int64  `tfsdk:"size"`
+	Mode          types.String `tfsdk:"mode"`
+	Hash          types.String `tfsdk:"hash"`
+	MaxSize       types.Int64  `tfsdk:"max_size"`
+}
+
+func (d *GitRemoteFile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_file"
+}
+
+func (d *GitRemoteFile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Remote File data source, reads a file from a remote repository without a local clone. " +
+			"Only the commit `ref` points at is fetched (`--depth 1`), so `ref` must be a branch or tag advertised by the " +
+			"remote rather than an arbitrary commit. It is held in memory, up to `max_size` bytes, so nothing is written to disk",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the remote repository",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file relative to the root of the repository",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch or tag to read the file at, short (ie. `main`) or full (ie. `refs/tags/v1.0.0`) " +
+					"(default: HEAD, the default branch of the remote)",
+				Optional: true,
+				Computed: true,
+			},
+			"max_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of bytes of the uncompressed objects of the commit held in memory, " +
+					"the read fails beyond it, 0 disables the limit (default: 104857600, 100 MiB)",
+				Optional: true,
+				Computed: true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the file",
+				Computed:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded content of the file, for binary files",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the file in bytes",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Git file mode of the file (ie. `0100644`)",
+				Computed:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the blob holding the file content",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitRemoteFile) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRemoteFile) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRemoteFileModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	maxSize, err := remoteMaxSize(data.MaxSize)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_size"), "invalid max_size", err.Error())
+		return
+	}
+	data.MaxSize = types.Int64Value(maxSize)
+
+	url, auth := d.provider.repositoryLocation(data.URL, data.Username, data.Password)
+
+	refs, err := advertisedReferences(ctx, url, auth)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list remote references", err.Error())
+		return
+	}
+
+	name, hash, err := remoteReference(refs, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("fetching %s from %s", name.String(), url))
+
+	commit, release, err := fetchRemoteCommit(ctx, d.provider, url, auth, name, hash, maxSize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to fetch remote reference", err.Error())
+		return
+	}
+	defer release()
+
+	file, err := commit.File(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "unable to find file", fmt.Sprintf("%s at %s: %s", data.File.ValueString(), commit.Hash.String(), err.Error()))
+		return
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read file", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("file: %s blob: %s", file.Name, file.Hash.String()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.URL.ValueString(), commit.Hash.String(), file.Name))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.Content = types.StringValue(content)
+	data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString([]byte(content)))
+	data.Size = types.Int64Value(file.Size)
+	data.Mode = types.StringValue(file.Mode.String())
+	data.Hash = types.StringValue(file.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// remoteReference finds the advertised reference ref names, trying it as a
+// full reference name, then as a branch and then as a tag like git does, and
+// returns the hash it is advertised with.
+func remoteReference(refs *packp.AdvRefs, ref string) (plumbing.ReferenceName, plumbing.Hash, error) {
+	if ref == "HEAD" {
+		if refs.Head == nil {
+			return "", plumbing.ZeroHash, fmt.Errorf("the remote does not advertise HEAD")
+		}
+		return plumbing.HEAD, *refs.Head, nil
+	}
+
+	for _, name := range []string{ref, "refs/heads/" + ref, "refs/tags/" + ref} {
+		if hash, ok := refs.References[name]; ok {
+			return plumbing.ReferenceName(name), hash, nil
+		}
+	}
+
+	return "", plumbing.ZeroHash, fmt.Errorf("%q is not a branch or tag of the remote, commits can not be fetched on their own", ref)
+}
+
+// defaultRemoteMaxSize is the default max_size of remote data sources.
+const defaultRemoteMaxSize = 100 * 1024 * 1024
+
+// remoteMaxSize returns the max_size configured, the default when unset.
+func remoteMaxSize(maxSize types.Int64) (int64, error) {
+	if maxSize.IsNull() || maxSize.IsUnknown() {
+		return defaultRemoteMaxSize, nil
+	}
+	if maxSize.ValueInt64() < 0 {
+		return 0, fmt.Errorf("max_size must be at least 0")
+	}
+	return maxSize.ValueInt64(), nil
+}
+
+// limitedStorage is a memory storage refusing objects once their total size
+// exceeds max, 0 meaning no limit.
+type limitedStorage struct {
+	*memory.Storage
+
+	max  int64
+	size int64
+}
+
+func (s *limitedStorage) SetEncodedObject(obj plumbing.EncodedObject) (plumbing.Hash, error) {
+	s.size += obj.Size()
+	if s.max > 0 && s.size > s.max {
+		return plumbing.ZeroHash, fmt.Errorf("the commit is larger than max_size, %d bytes", s.max)
+	}
+
+	return s.Storage.SetEncodedObject(obj)
+}
+
+// fetchRemoteCommit fetches the commit name points at, advertised as hash,
+// from the remote at url into memory, without history, failing once the
+// objects fetched exceed maxSize bytes. Returns the commit along with the
+// function releasing it: data sources reading the same commit at the same time
+// share a single fetch, which is dropped once they all released it.
+func fetchRemoteCommit(ctx context.Context, p *GitProviderData, url string, auth transport.AuthMethod, name plumbing.ReferenceName, hash plumbing.Hash, maxSize int64) (*object.Commit, func(), error) {
+	key := fmt.Sprintf("remote-commit|%s|%s|%s|%d", url, name.String(), hash.String(), maxSize)
+
+	cached, release, err := p.cache().share(key, func() (interface{}, error) {
+		repo, err := git.CloneContext(ctx, &limitedStorage{Storage: memory.NewStorage(), max: maxSize}, nil, &git.CloneOptions{
+			URL:           url,
+			Auth:          auth,
+			ReferenceName: name,
+			SingleBranch:  true,
+			Depth:         1,
+			Tags:          git.NoTags,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resolveCommit(repo, "HEAD")
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cached.(*object.Commit), release, nil
+}
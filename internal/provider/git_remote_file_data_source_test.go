@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitRemoteFileDataSourceConfig(url string, options string) string {
+	return fmt.Sprintf(`
+data "git_remote_file" "test" {
+  url = %[1]q
+  %[2]s
+}
+`, url, options)
+}
+
+func TestAccGitRemoteFileDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, `file = "README.md"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "ref", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "content", "testing 00"),
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "size", "10"),
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "mode", "0100644"),
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "max_size", "104857600"),
+				),
+			},
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, `file = "README.md"
+  ref  = "v1.0.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "content", "testing"),
+				),
+			},
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, `file = "README.md"
+  ref  = "refs/heads/master"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "commit", hash.String()),
+				),
+			},
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, `file = "README.md"
+  max_size = 0`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_file.test", "content", "testing 00"),
+				),
+			},
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, `file = "README.md"
+  max_size = 64`),
+				ExpectError: regexp.MustCompile("larger than max_size"),
+			},
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, `file = "README.md"
+  max_size = -1`),
+				ExpectError: regexp.MustCompile("invalid max_size"),
+			},
+			{
+				Config:      testAccGitRemoteFileDataSourceConfig(tempDir, `file = "missing.txt"`),
+				ExpectError: regexp.MustCompile("unable to find file"),
+			},
+			{
+				Config: testAccGitRemoteFileDataSourceConfig(tempDir, fmt.Sprintf(`file = "README.md"
+  ref  = %q`, hash.String())),
+				ExpectError: regexp.MustCompile("unable to resolve reference"),
+			},
+		},
+	})
+}
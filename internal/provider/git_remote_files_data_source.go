@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRemoteFiles{}
+
+func NewGitRemoteFiles() datasource.DataSource {
+	return &GitRemoteFiles{}
+}
+
+// GitRemoteFiles defines the data source implementation.
+type GitRemoteFiles struct {
+	provider *GitProviderData
+}
+
+// GitRemoteFilesModel describes the data source data model.
+type GitRemoteFilesModel struct {
+	Id           types.String                             `tfsdk:"id"`
+	URLs         []types.String                           `tfsdk:"urls"`
+	Username     types.String                             `tfsdk:"username"`
+	Password     types.String                             `tfsdk:"password"`
+	File         types.String                             `tfsdk:"file"`
+	Reference    types.String                             `tfsdk:"ref"`
+	Concurrency  types.Int64                              `tfsdk:"concurrency"`
+	MaxSize      types.Int64                              `tfsdk:"max_size"`
+	Repositories map[string]GitRemoteFilesRepositoryModel `tfsdk:"repositories"`
+}
+
+// GitRemoteFilesRepositoryModel describes the file read from a single remote.
+type GitRemoteFilesRepositoryModel struct {
+	Reference     types.String `tfsdk:"ref"`
+	Commit        types.String `tfsdk:"commit"`
+	Exists        types.Bool   `tfsdk:"exists"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Size          types.Int64  `tfsdk:"size"`
+	Hash          types.String `tfsdk:"hash"`
+}
+
+func (d *GitRemoteFiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_files"
+}
+
+func (d *GitRemoteFiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Remote Files data source, reads the same file from many remote repositories, ie. to " +
+			"inventory a `service.yaml` across an organization. Remotes are fetched like `git_remote_file`, in memory, " +
+			"and read concurrently so up to `concurrency` times `max_size` bytes may be held at once",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"urls": schema.ListAttribute{
+				MarkdownDescription: "URLs of the remote repositories",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication, used for every remote",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication, used for every remote",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of the file relative to the root of the repositories",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch or tag to read the file at, a glob pattern (ie. `v*`) picks the highest version " +
+					"tag matching it in each repository (default: HEAD, the default branch of each remote)",
+				Optional: true,
+				Computed: true,
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of remotes read at the same time (default: 8)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of bytes of the uncompressed objects of each commit held in memory, " +
+					"the read fails beyond it, 0 disables the limit (default: 104857600, 100 MiB)",
+				Optional: true,
+				Computed: true,
+			},
+			"repositories": schema.MapNestedAttribute{
+				MarkdownDescription: "File read from each remote, keyed by URL",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ref": schema.StringAttribute{
+							MarkdownDescription: "Full name of the reference the file was read at (ie. `refs/tags/v1.2.0`)",
+							Computed:            true,
+						},
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Commit the reference resolved to",
+							Computed:            true,
+						},
+						"exists": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the file exists at the reference",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Content of the file, empty when it does not exist",
+							Computed:            true,
+						},
+						"content_base64": schema.StringAttribute{
+							MarkdownDescription: "Base64 encoded content of the file, for binary files",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the file in bytes",
+							Computed:            true,
+						},
+						"hash": schema.StringAttribute{
+							MarkdownDescription: "Hash of the blob holding the file content",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitRemoteFiles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRemoteFiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRemoteFilesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.Concurrency.IsNull() || data.Concurrency.IsUnknown() {
+		data.Concurrency = types.Int64Value(8)
+	}
+	if data.Concurrency.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("concurrency"), "invalid concurrency", "concurrency must be at least 1")
+		return
+	}
+	maxSize, err := remoteMaxSize(data.MaxSize)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_size"), "invalid max_size", err.Error())
+		return
+	}
+	data.MaxSize = types.Int64Value(maxSize)
+
+	urls := toStrings(data.URLs)
+
+	results := make([]GitRemoteFilesRepositoryModel, len(urls))
+	errs := make([]error, len(urls))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, data.Concurrency.ValueInt64())
+	for i, url := range urls {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			location, auth := d.provider.repositoryLocation(types.StringValue(url), data.Username, data.Password)
+			results[i], errs[i] = d.readRemoteFile(ctx, location, auth, data.Reference.ValueString(), data.File.ValueString(), maxSize)
+		}(i, url)
+	}
+	wg.Wait()
+
+	data.Repositories = map[string]GitRemoteFilesRepositoryModel{}
+	for i, url := range urls {
+		if errs[i] != nil {
+			resp.Diagnostics.AddError("unable to read remote file", fmt.Sprintf("%s: %s", url, errs[i].Error()))
+			continue
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("url: %s commit: %s exists: %t", url, results[i].Commit.ValueString(), results[i].Exists.ValueBool()))
+
+		data.Repositories[url] = results[i]
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Reference.ValueString(), data.File.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readRemoteFile reads file at ref from the remote at url, a file missing from
+// the commit is not an error.
+func (d *GitRemoteFiles) readRemoteFile(ctx context.Context, url string, auth transport.AuthMethod, ref string, file string, maxSize int64) (GitRemoteFilesRepositoryModel, error) {
+	result := GitRemoteFilesRepositoryModel{
+		Exists:        types.BoolValue(false),
+		Content:       types.StringValue(""),
+		ContentBase64: types.StringValue(""),
+		Size:          types.Int64Value(0),
+		Hash:          types.StringValue(""),
+	}
+
+	refs, err := advertisedReferences(ctx, url, auth)
+	if err != nil {
+		return result, err
+	}
+
+	var name plumbing.ReferenceName
+	var hash plumbing.Hash
+	if strings.ContainsAny(ref, "*?[") {
+		latest := latestRemoteTag(refs, []string{ref}, nil)
+		if latest == nil {
+			return result, fmt.Errorf("no version tag matches %q", ref)
+		}
+		name = plumbing.NewTagReferenceName(latest.Name)
+		hash = refs.References[name.String()]
+	} else {
+		name, hash, err = remoteReference(refs, ref)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	commit, release, err := fetchRemoteCommit(ctx, d.provider, url, auth, name, hash, maxSize)
+	if err != nil {
+		return result, err
+	}
+	defer release()
+
+	result.Reference = types.StringValue(name.String())
+	result.Commit = types.StringValue(commit.Hash.String())
+
+	f, err := commit.File(file)
+	if err == object.ErrFileNotFound {
+		return result, nil
+	}
+	if err != nil {
+		return result, err
+	}
+
+	content, err := f.Contents()
+	if err != nil {
+		return result, err
+	}
+
+	result.Exists = types.BoolValue(true)
+	result.Content = types.StringValue(content)
+	result.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString([]byte(content)))
+	result.Size = types.Int64Value(f.Size)
+	result.Hash = types.StringValue(f.Hash.String())
+
+	return result, nil
+}
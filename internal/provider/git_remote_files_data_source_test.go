@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitRemoteFilesDataSourceConfig(first string, second string, options string) string {
+	return fmt.Sprintf(`
+data "git_remote_files" "test" {
+  urls = [%[1]q, %[2]q]
+  file = "service.yaml"
+  %[3]s
+}
+`, first, second, options)
+}
+
+func TestAccGitRemoteFilesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	first := filepath.Join(tempDir, "first")
+	second := filepath.Join(tempDir, "second")
+
+	_, err = testSetupGit(first, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(first, "service.yaml"), []byte("name: first"), 0644))
+	tagged, err := testCommitAll(first, "add service")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(first)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v1.1.0", *tagged, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(first, "service.yaml"), []byte("name: unreleased"), 0644))
+	head, err := testCommitAll(first, "unreleased")
+	assert.NoError(t, err)
+
+	_, err = testSetupGit(second, "v0.1.0", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemoteFilesDataSourceConfig(first, second, `ref = "v*"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_files.test", "repositories.%", "2"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.ref", first), "refs/tags/v1.1.0"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.commit", first), tagged.String()),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.exists", first), "true"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.content", first), "name: first"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.ref", second), "refs/tags/v0.1.0"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.exists", second), "false"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.content", second), ""),
+				),
+			},
+			{
+				Config: testAccGitRemoteFilesDataSourceConfig(first, second, `concurrency = 1`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_files.test", "ref", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.commit", first), head.String()),
+					resource.TestCheckResourceAttr("data.git_remote_files.test", fmt.Sprintf("repositories.%s.content", first), "name: unreleased"),
+				),
+			},
+			{
+				Config:      testAccGitRemoteFilesDataSourceConfig(first, second, `ref = "release-*"`),
+				ExpectError: regexp.MustCompile("no version tag matches"),
+			},
+			{
+				Config:      testAccGitRemoteFilesDataSourceConfig(first, second, `concurrency = 0`),
+				ExpectError: regexp.MustCompile("invalid concurrency"),
+			},
+		},
+	})
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRemoteRefs{}
+
+func NewGitRemoteRefs() datasource.DataSource {
+	return &GitRemoteRefs{}
+}
+
+// GitRemoteRefs defines the data source implementation.
+type GitRemoteRefs struct {
+	provider *GitProviderData
+}
+
+// GitRemoteRefsModel describes the data source data model.
+type GitRemoteRefsModel struct {
+	Id       types.String            `tfsdk:"id"`
+	URL      types.String            `tfsdk:"url"`
+	Username types.String            `tfsdk:"username"`
+	Password types.String            `tfsdk:"password"`
+	Head     types.String            `tfsdk:"head"`
+	HeadRef  types.String            `tfsdk:"head_ref"`
+	Branches map[string]types.String `tfsdk:"branches"`
+	Tags     map[string]types.String `tfsdk:"tags"`
+	Watch    types.String            `tfsdk:"watch"`
+	Trigger  types.String            `tfsdk:"trigger"`
+
+	Namespaces []types.String          `tfsdk:"namespaces"`
+	Refs       map[string]types.String `tfsdk:"refs"`
+}
+
+func (d *GitRemoteRefs) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_refs"
+}
+
+func (d *GitRemoteRefs) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Remote Refs data source, lists the references of a remote repository without cloning it (`git ls-remote`)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the remote repository",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"head": schema.StringAttribute{
+				MarkdownDescription: "Hash the remote HEAD points to",
+				Computed:            true,
+			},
+			"head_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference the remote HEAD points to (ie. `refs/heads/main`)",
+				Computed:            true,
+			},
+			"branches": schema.MapAttribute{
+				MarkdownDescription: "Map of branch name to commit hash",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Map of tag name to object hash",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"namespaces": schema.ListAttribute{
+				MarkdownDescription: "Only list references in `refs` matching any of these namespaces or globs, ie. " +
+					"`refs/pull` or `refs/merge-requests/*/head` for the pull requests mirrored from a forge (default: every reference)",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"refs": schema.MapAttribute{
+				MarkdownDescription: "Map of full reference name to object hash for every advertised reference matching " +
+					"`namespaces`, including the ones outside of branches and tags (ie. `refs/pull/12/head` or `refs/notes/commits`)",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"watch": schema.StringAttribute{
+				MarkdownDescription: "Reference to watch, `HEAD`, a branch or tag, short (ie. `main`) or full (ie. `refs/tags/v1.0.0` " +
+					"or `refs/pull/12/head`, which must match `namespaces`)",
+				Optional: true,
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "Hash of the `watch` reference, empty when nothing is watched. It only changes when the " +
+					"reference moves, pass it to a `terraform_data` resource referenced by `replace_triggered_by` to replace " +
+					"resources exactly when the remote moves",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *GitRemoteRefs) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRemoteRefs) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRemoteRefsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refs, err := listRemote(d.provider.repositoryLocation(data.URL, data.Username, data.Password))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list remote references", err.Error())
+		return
+	}
+
+	data.Head = types.StringValue("")
+	data.HeadRef = types.StringValue("")
+	data.Branches = map[string]types.String{}
+	data.Tags = map[string]types.String{}
+	data.Refs = map[string]types.String{}
+
+	namespaces := toStrings(data.Namespaces)
+	for _, ref := range refs {
+		tflog.Trace(ctx, fmt.Sprintf("remote ref: %s", ref.String()))
+
+		if ref.Type() == plumbing.HashReference && ref.Name() != plumbing.HEAD && gitutils.MatchPaths(namespaces, nil, ref.Name().String()) {
+			data.Refs[ref.Name().String()] = types.StringValue(ref.Hash().String())
+		}
+
+		switch {
+		case ref.Name() == plumbing.HEAD && ref.Type() == plumbing.SymbolicReference:
+			data.HeadRef = types.StringValue(ref.Target().String())
+		case ref.Name() == plumbing.HEAD:
+			data.Head = types.StringValue(ref.Hash().String())
+		case ref.Name().IsBranch():
+			data.Branches[ref.Name().Short()] = types.StringValue(ref.Hash().String())
+		case ref.Name().IsTag():
+			data.Tags[ref.Name().Short()] = types.StringValue(ref.Hash().String())
+		}
+	}
+
+	// Servers advertise HEAD as a symbolic reference, resolve it against the
+	// advertised branches when no hash was advertised directly.
+	if data.Head.ValueString() == "" && data.HeadRef.ValueString() != "" {
+		if hash, ok := data.Branches[plumbing.ReferenceName(data.HeadRef.ValueString()).Short()]; ok {
+			data.Head = hash
+		}
+	}
+
+	data.Trigger = types.StringValue("")
+	if watch := data.Watch.ValueString(); watch != "" {
+		hash, ok := watchedReference(&data, watch)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("watch"), "unable to resolve reference", fmt.Sprintf("%q is not advertised by the remote", watch))
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("watch: %s hash: %s", watch, hash))
+
+		data.Trigger = types.StringValue(hash)
+	}
+
+	data.Id = types.StringValue(data.URL.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// watchedReference returns the hash of the advertised reference ref names,
+// trying it as HEAD, a branch or tag, a full reference name in any namespace,
+// then a short branch and then a short tag name like git does.
+func watchedReference(data *GitRemoteRefsModel, ref string) (string, bool) {
+	if ref == "HEAD" {
+		return data.Head.ValueString(), data.Head.ValueString() != ""
+	}
+
+	name := plumbing.ReferenceName(ref)
+	switch {
+	case name.IsBranch():
+		hash, ok := data.Branches[name.Short()]
+		return hash.ValueString(), ok
+	case name.IsTag():
+		hash, ok := data.Tags[name.Short()]
+		return hash.ValueString(), ok
+	case strings.HasPrefix(ref, "refs/"):
+		hash, ok := data.Refs[ref]
+		return hash.ValueString(), ok
+	}
+
+	if hash, ok := data.Branches[ref]; ok {
+		return hash.ValueString(), true
+	}
+	if hash, ok := data.Tags[ref]; ok {
+		return hash.ValueString(), true
+	}
+
+	return "", false
+}
+
+// listRemote performs the equivalent of `git ls-remote` against url using an
+// in-memory remote so nothing is written to disk.
+func listRemote(url string, auth transport.AuthMethod) ([]*plumbing.Reference, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	})
+
+	return remote.List(&git.ListOptions{
+		Auth: auth,
+	})
+}
+
+// remoteAuth returns HTTP basic authentication when credentials are provided,
+// otherwise go-git falls back to its defaults (ie. the SSH agent).
+func remoteAuth(username types.String, password types.String) transport.AuthMethod {
+	if username.ValueString() == "" && password.ValueString() == "" {
+		return nil
+	}
+
+	return &githttp.BasicAuth{
+		Username: username.ValueString(),
+		Password: password.ValueString(),
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitRemoteRefsDataSourceConfig(url string, options string) string {
+	return fmt.Sprintf(`
+data "git_remote_refs" "test" {
+  url = %[1]q
+  %[2]s
+}
+`, url, options)
+}
+
+func testAccGitRemoteRefsDataSourceConfigRepository(repository string) string {
+	return fmt.Sprintf(`
+provider "git" {
+  repositories = {
+    upstream = {
+      %[1]s
+    }
+  }
+}
+
+data "git_remote_refs" "test" {
+  url = "upstream"
+}
+`, repository)
+}
+
+func TestAccGitRemoteRefsDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemoteRefsDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "head", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "head_ref", "refs/heads/master"),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "branches.%", "1"),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "branches.master", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "tags.%", "1"),
+					resource.TestCheckResourceAttrSet("data.git_remote_refs.test", "tags.v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "trigger", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRemoteRefsDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	reg, err := regexp.Compile("unable to list remote references")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitRemoteRefsDataSourceConfig(filepath.Join(tempDir, "missing"), ""),
+				ExpectError: reg,
+			},
+		},
+	})
+}
+
+func TestAccGitRemoteRefsDataSource3(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	var moved *plumbing.Hash
+
+	reg, err := regexp.Compile("unable to resolve reference")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemoteRefsDataSourceConfig(tempDir, `watch = "master"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "trigger", hash.String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "moved.txt"), []byte("moved"), 0644))
+					moved, err = testCommitAll(tempDir, "moved")
+					assert.NoError(t, err)
+				},
+				Config: testAccGitRemoteRefsDataSourceConfig(tempDir, `watch = "refs/heads/master"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("data.git_remote_refs.test", "trigger", moved.String())(s)
+					},
+				),
+			},
+			{
+				Config:      testAccGitRemoteRefsDataSourceConfig(tempDir, `watch = "missing"`),
+				ExpectError: reg,
+			},
+		},
+	})
+}
+
+func TestAccGitRemoteRefsDataSource4(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".git", "packed-refs"), []byte(fmt.Sprintf("%[1]s refs/pull/1/head\n%[1]s refs/notes/commits\n", hash.String())), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemoteRefsDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "refs.%", "4"),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "refs.refs/heads/master", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "refs.refs/pull/1/head", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "refs.refs/notes/commits", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "branches.%", "1"),
+				),
+			},
+			{
+				Config: testAccGitRemoteRefsDataSourceConfig(tempDir, "namespaces = [\"refs/pull\"]\n  watch = \"refs/pull/1/head\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "refs.%", "1"),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "refs.refs/pull/1/head", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "trigger", hash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRemoteRefsDataSource5(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitRemoteRefsDataSourceConfigRepository(""),
+				ExpectError: regexp.MustCompile("path or url must be set"),
+			},
+			{
+				Config: testAccGitRemoteRefsDataSourceConfigRepository(fmt.Sprintf("url = %q", tempDir)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "head", hash.String()),
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "branches.master", hash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRemoteRefsDataSource6(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(filepath.Join(tempDir, "repo"), "", 0)
+	assert.NoError(t, err)
+
+	t.Setenv("PWD", tempDir)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Relative repository path testing
+			{
+				Config: `
+provider "git" {
+  base = "cwd"
+
+  repositories = {
+    upstream = {
+      path = "repo"
+    }
+  }
+}
+
+data "git_remote_refs" "test" {
+  url = "upstream"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_refs.test", "head", hash.String()),
+				),
+			},
+		},
+	})
+}
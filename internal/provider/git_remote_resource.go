@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitRemoteResource{}
+var _ resource.ResourceWithImportState = &GitRemoteResource{}
+
+func NewGitRemoteResource() resource.Resource {
+	return &GitRemoteResource{}
+}
+
+// GitRemoteResource defines the resource implementation.
+type GitRemoteResource struct {
+	provider *GitProviderData
+}
+
+// GitRemoteResourceModel describes the resource data model.
+type GitRemoteResourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Path     types.String   `tfsdk:"path"`
+	Name     types.String   `tfsdk:"name"`
+	URL      types.String   `tfsdk:"url"`
+	PushURLs []types.String `tfsdk:"push_urls"`
+	Fetch    []types.String `tfsdk:"fetch"`
+	Push     []types.String `tfsdk:"push"`
+}
+
+func (r *GitRemoteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote"
+}
+
+func (r *GitRemoteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Remote resource, manages a remote of a repository like `git remote`. Changes made to the " +
+			"remote outside of Terraform are reverted on the next apply. Renaming the remote moves its remote tracking " +
+			"branches and the upstream of the branches tracking it, like `git remote rename`, and destroying the resource " +
+			"removes them like `git remote remove`. Existing remotes can be imported with `<path>:<name>`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the remote (ie. `origin`), changing it renames the remote",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the remote, used to fetch and to push unless `push_urls` is set",
+				Required:            true,
+			},
+			"push_urls": schema.ListAttribute{
+				MarkdownDescription: "URLs to push to instead of `url` (`remote.<name>.pushurl`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"fetch": schema.ListAttribute{
+				MarkdownDescription: "Refspecs to fetch (`remote.<name>.fetch`, default: `+refs/heads/*:refs/remotes/<name>/*`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"push": schema.ListAttribute{
+				MarkdownDescription: "Refspecs to push by default (`remote.<name>.push`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *GitRemoteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitRemoteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitRemoteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+
+	repo, err := r.provider.openRepository(repoPath)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+
+	if _, ok := cfg.Remotes[data.Name.ValueString()]; ok {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "remote already exists",
+			fmt.Sprintf("remote %s already exists, import it to manage it", data.Name.ValueString()))
+		return
+	}
+
+	if err := setRemoteConfig(repo, cfg, &data); err != nil {
+		resp.Diagnostics.AddError("unable to write git config", err.Error())
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", repoPath, data.Name.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRemoteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitRemoteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	remote, ok := cfg.Remotes[name]
+	if !ok {
+		tflog.Warn(ctx, fmt.Sprintf("remote %s no longer exists, removing it from state", name))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.URL = types.StringValue("")
+	if len(remote.URLs) > 0 {
+		data.URL = types.StringValue(remote.URLs[0])
+	}
+
+	raw := cfg.Raw.Section("remote").Subsection(name)
+	data.PushURLs = remoteOptionValues(data.PushURLs, raw.Options.GetAll("pushurl"), nil)
+	data.Push = remoteOptionValues(data.Push, raw.Options.GetAll("push"), nil)
+	data.Fetch = remoteOptionValues(data.Fetch, raw.Options.GetAll("fetch"), []string{fmt.Sprintf(config.DefaultFetchRefSpec, name)})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRemoteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GitRemoteResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+
+	repo, err := r.provider.openRepository(repoPath)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+
+	oldName, newName := state.Name.ValueString(), data.Name.ValueString()
+	if oldName != newName {
+		if _, ok := cfg.Remotes[newName]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "remote already exists",
+				fmt.Sprintf("unable to rename %s, remote %s already exists", oldName, newName))
+			return
+		}
+
+		delete(cfg.Remotes, oldName)
+		for _, branch := range cfg.Branches {
+			if branch.Remote == oldName {
+				branch.Remote = newName
+			}
+		}
+
+		if err := moveRemoteReferences(repo, oldName, newName); err != nil {
+			resp.Diagnostics.AddError("unable to rename remote tracking branches", err.Error())
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("renamed remote %s to %s", oldName, newName))
+	}
+
+	if err := setRemoteConfig(repo, cfg, &data); err != nil {
+		resp.Diagnostics.AddError("unable to write git config", err.Error())
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", repoPath, newName))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRemoteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitRemoteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	delete(cfg.Remotes, name)
+	for _, branch := range cfg.Branches {
+		if branch.Remote == name {
+			branch.Remote = ""
+			branch.Merge = ""
+		}
+	}
+
+	if err := repo.Storer.SetConfig(cfg); err != nil {
+		resp.Diagnostics.AddError("unable to write git config", err.Error())
+		return
+	}
+
+	if err := moveRemoteReferences(repo, name, ""); err != nil {
+		resp.Diagnostics.AddError("unable to remove remote tracking branches", err.Error())
+		return
+	}
+}
+
+func (r *GitRemoteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Paths may hold colons, remote names do not.
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError("invalid import id", fmt.Sprintf("expected <path>:<name>, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID[i+1:])...)
+}
+
+// setRemoteConfig writes the remote described by data to cfg and saves it.
+func setRemoteConfig(repo *git.Repository, cfg *config.Config, data *GitRemoteResourceModel) error {
+	remote := &config.RemoteConfig{
+		Name: data.Name.ValueString(),
+		URLs: []string{data.URL.ValueString()},
+	}
+	for _, refSpec := range toStrings(data.Fetch) {
+		remote.Fetch = append(remote.Fetch, config.RefSpec(refSpec))
+	}
+	if err := remote.Validate(); err != nil {
+		return err
+	}
+
+	if existing, ok := cfg.Remotes[remote.Name]; ok {
+		// Keep the raw configuration and with it any option go-git does not
+		// know about.
+		existing.URLs = remote.URLs
+		existing.Fetch = remote.Fetch
+	} else {
+		cfg.Remotes[remote.Name] = remote
+	}
+
+	// go-git does not know about pushurl and push, set them on the raw
+	// configuration once the remotes have been marshaled into it.
+	if _, err := cfg.Marshal(); err != nil {
+		return err
+	}
+	raw := cfg.Raw.Section("remote").Subsection(remote.Name)
+	raw.RemoveOption("pushurl")
+	if pushURLs := toStrings(data.PushURLs); len(pushURLs) > 0 {
+		raw.SetOption("pushurl", pushURLs...)
+	}
+	raw.RemoveOption("push")
+	if push := toStrings(data.Push); len(push) > 0 {
+		raw.SetOption("push", push...)
+	}
+
+	return repo.Storer.SetConfig(cfg)
+}
+
+// moveRemoteReferences moves the remote tracking references of remote from to
+// remote to, removing them when to is empty.
+func moveRemoteReferences(repo *git.Repository, from string, to string) error {
+	refs, err := repo.References()
+	if err != nil {
+		return err
+	}
+
+	prefix := fmt.Sprintf("refs/remotes/%s/", from)
+
+	var moved []*plumbing.Reference
+	if err := refs.ForEach(func(ref *plumbing.Reference) error {
+		if strings.HasPrefix(ref.Name().String(), prefix) {
+			moved = append(moved, ref)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, ref := range moved {
+		if to != "" {
+			name := plumbing.ReferenceName(fmt.Sprintf("refs/remotes/%s/%s", to, strings.TrimPrefix(ref.Name().String(), prefix)))
+
+			newRef := plumbing.NewHashReference(name, ref.Hash())
+			if ref.Type() == plumbing.SymbolicReference {
+				// refs/remotes/<remote>/HEAD points at a branch of the remote.
+				target := strings.Replace(ref.Target().String(), prefix, fmt.Sprintf("refs/remotes/%s/", to), 1)
+				newRef = plumbing.NewSymbolicReference(name, plumbing.ReferenceName(target))
+			}
+			if err := repo.Storer.SetReference(newRef); err != nil {
+				return err
+			}
+		}
+
+		if err := repo.Storer.RemoveReference(ref.Name()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remoteOptionValues returns the values of a remote option to save into state,
+// keeping prior null when they are the defaults.
+func remoteOptionValues(prior []types.String, values []string, defaults []string) []types.String {
+	if prior == nil && strings.Join(values, "\n") == strings.Join(defaults, "\n") {
+		return nil
+	}
+
+	result := []types.String{}
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitRemoteResourceConfig(path string, name string, options string) string {
+	return fmt.Sprintf(`
+resource "git_remote" "test" {
+  path = %[1]q
+  name = %[2]q
+  %[3]s
+}
+`, path, name, options)
+}
+
+func TestAccGitRemoteResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	head, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{"https://example.com/origin.git"}})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckRemote(tempDir, "mirror", ""),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitRemoteResourceConfig(tempDir, "origin", `url = "https://example.com/other.git"`),
+				ExpectError: regexp.MustCompile("remote origin already exists"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitRemoteResourceConfig(tempDir, "upstream", `
+  url       = "https://example.com/upstream.git"
+  push_urls = ["git@example.com:upstream.git"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_remote.test", "id", tempDir+":upstream"),
+					resource.TestCheckNoResourceAttr("git_remote.test", "fetch"),
+					testCheckRemote(tempDir, "upstream", "https://example.com/upstream.git"),
+					testCheckRemoteOption(tempDir, "upstream", "pushurl", "git@example.com:upstream.git"),
+					testCheckRemoteOption(tempDir, "upstream", "fetch", "+refs/heads/*:refs/remotes/upstream/*"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "git_remote.test",
+				ImportState:       true,
+				ImportStateId:     tempDir + ":upstream",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("upstream", "master"), *head)))
+					assert.NoError(t, repo.CreateBranch(&config.Branch{Name: "master", Remote: "upstream", Merge: plumbing.NewBranchReferenceName("master")}))
+				},
+				Config: testAccGitRemoteResourceConfig(tempDir, "mirror", `
+  url   = "https://example.com/mirror.git"
+  fetch = ["+refs/heads/main:refs/remotes/mirror/main"]
+  push  = ["refs/heads/main:refs/heads/main"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRemote(tempDir, "upstream", ""),
+					testCheckRemote(tempDir, "mirror", "https://example.com/mirror.git"),
+					testCheckRemoteOption(tempDir, "mirror", "pushurl", ""),
+					testCheckRemoteOption(tempDir, "mirror", "fetch", "+refs/heads/main:refs/remotes/mirror/main"),
+					testCheckRemoteOption(tempDir, "mirror", "push", "refs/heads/main:refs/heads/main"),
+					func(s *terraform.State) error {
+						if _, err := repo.Reference(plumbing.NewRemoteReferenceName("mirror", "master"), false); err != nil {
+							return fmt.Errorf("expected the remote tracking branch to be renamed: %v", err)
+						}
+						cfg, err := repo.Config()
+						if err != nil {
+							return err
+						}
+						if cfg.Branches["master"].Remote != "mirror" {
+							return fmt.Errorf("expected master to track mirror, got %q", cfg.Branches["master"].Remote)
+						}
+						return nil
+					},
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					cfg, err := repo.Config()
+					assert.NoError(t, err)
+					cfg.Remotes["mirror"].URLs = []string{"https://example.com/changed.git"}
+					assert.NoError(t, repo.Storer.SetConfig(cfg))
+				},
+				Config: testAccGitRemoteResourceConfig(tempDir, "mirror", `
+  url   = "https://example.com/mirror.git"
+  fetch = ["+refs/heads/main:refs/remotes/mirror/main"]
+  push  = ["refs/heads/main:refs/heads/main"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRemote(tempDir, "mirror", "https://example.com/mirror.git"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckRemote checks the URL of remote name of the repository at path, an
+// empty URL checking the remote does not exist.
+func testCheckRemote(path string, name string, url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		remote, err := repo.Remote(name)
+		if err == git.ErrRemoteNotFound && url == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if remote.Config().URLs[0] != url {
+			return fmt.Errorf("expected remote %s to have url %q, got %q", name, url, remote.Config().URLs[0])
+		}
+
+		return nil
+	}
+}
+
+// testCheckRemoteOption checks the raw value of an option of remote name of
+// the repository at path.
+func testCheckRemoteOption(path string, name string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := repo.Config()
+		if err != nil {
+			return err
+		}
+
+		if actual := cfg.Raw.Section("remote").Subsection(name).Option(key); actual != value {
+			return fmt.Errorf("expected remote.%s.%s to be %q, got %q", name, key, value, actual)
+		}
+
+		return nil
+	}
+}
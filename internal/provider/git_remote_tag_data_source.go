@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRemoteTag{}
+
+func NewGitRemoteTag() datasource.DataSource {
+	return &GitRemoteTag{}
+}
+
+// GitRemoteTag defines the data source implementation.
+type GitRemoteTag struct {
+	provider *GitProviderData
+}
+
+// GitRemoteTagModel describes the data source data model.
+type GitRemoteTagModel struct {
+	Id         types.String   `tfsdk:"id"`
+	URL        types.String   `tfsdk:"url"`
+	Username   types.String   `tfsdk:"username"`
+	Password   types.String   `tfsdk:"password"`
+	Constraint types.String   `tfsdk:"constraint"`
+	Match      []types.String `tfsdk:"match"`
+	Tag        types.String   `tfsdk:"tag"`
+	Version    types.String   `tfsdk:"version"`
+	Commit     types.String   `tfsdk:"commit"`
+}
+
+func (d *GitRemoteTag) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_tag"
+}
+
+func (d *GitRemoteTag) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Remote Tag data source, finds the highest version tagged on a remote repository without " +
+			"cloning it, ie. to pin module sources. Tags that are not versions are ignored, prereleases are only considered " +
+			"when `constraint` includes one (ie. `>= 2.0.0-0`)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the remote repository",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"constraint": schema.StringAttribute{
+				MarkdownDescription: "Only consider versions satisfying this constraint (ie. `>= 1.2, < 2.0` or `~> 1.2`)",
+				Optional:            true,
+			},
+			"match": schema.ListAttribute{
+				MarkdownDescription: "Only consider tags matching at least one of these glob patterns (ie. `api/v*`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Name of the highest matching tag (ie. `v1.4.2`)",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of `tag` without prefix (ie. `1.4.2`)",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `tag` points at, annotated tags are peeled",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitRemoteTag) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRemoteTag) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRemoteTagModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var constraint *semver.Constraints
+	if data.Constraint.ValueString() != "" {
+		c, err := semver.NewConstraint(data.Constraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("constraint"), "invalid constraint", err.Error())
+			return
+		}
+		constraint = c
+	}
+
+	url, auth := d.provider.repositoryLocation(data.URL, data.Username, data.Password)
+
+	refs, err := advertisedReferences(ctx, url, auth)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list remote references", err.Error())
+		return
+	}
+
+	latest := latestRemoteTag(refs, toStrings(data.Match), constraint)
+	if latest == nil {
+		resp.Diagnostics.AddError("unable to find matching tag", fmt.Sprintf("no tag of %s matches the constraint and patterns", url))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("tag: %s commit: %s", latest.Name, latest.Commit.String()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.URL.ValueString(), latest.Name))
+	data.Tag = types.StringValue(latest.Name)
+	data.Version = types.StringValue(latest.Version.String())
+	data.Commit = types.StringValue(latest.Commit.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// versionTag is a tag naming a version.
+type versionTag struct {
+	Name    string
+	Version *semver.Version
+	Commit  plumbing.Hash
+}
+
+// latestRemoteTag returns the highest version among the advertised tags
+// matching one of the match glob patterns, when given, and the constraint,
+// nil when there is none. Prereleases are only considered when the
+// constraint includes one.
+func latestRemoteTag(refs *packp.AdvRefs, match []string, constraint *semver.Constraints) *versionTag {
+	var latest *versionTag
+	for name, hash := range refs.References {
+		if !strings.HasPrefix(name, "refs/tags/") {
+			continue
+		}
+
+		tag := plumbing.ReferenceName(name).Short()
+		if !gitutils.MatchTag(match, nil, tag) {
+			continue
+		}
+
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if constraint != nil && !constraint.Check(version) || constraint == nil && version.Prerelease() != "" {
+			continue
+		}
+
+		// Equal versions (ie. 1.2 and v1.2.0) are ordered by name to keep
+		// the result stable.
+		if latest == nil || version.GreaterThan(latest.Version) || version.Equal(latest.Version) && tag < latest.Name {
+			latest = &versionTag{Name: tag, Version: version, Commit: hash}
+			if peeled, ok := refs.Peeled[name]; ok {
+				latest.Commit = peeled
+			}
+		}
+	}
+
+	return latest
+}
+
+// advertisedReferences returns the references advertised by the remote at
+// url, unlike listRemote it keeps the commits annotated tags peel to.
+func advertisedReferences(ctx context.Context, url string, auth transport.AuthMethod) (*packp.AdvRefs, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewClient(ep)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := c.NewUploadPackSession(ep, auth)
+	if err != nil {
+		return nil, err
+	}
+	//noinspection GoUnhandledErrorResult
+	defer s.Close()
+
+	return s.AdvertisedReferencesContext(ctx)
+}
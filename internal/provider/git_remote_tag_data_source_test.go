@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitRemoteTagDataSourceConfig(url string, options string) string {
+	return fmt.Sprintf(`
+data "git_remote_tag" "test" {
+  url = %[1]q
+  %[2]s
+}
+`, url, options)
+}
+
+func TestAccGitRemoteTagDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	first, err := testSetupGit(tempDir, "v1.2.0", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "CHANGELOG.md"), []byte("second"), 0644))
+	second, err := testCommitAll(tempDir, "second")
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v1.3.1", *second, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "CHANGELOG.md"), []byte("third"), 0644))
+	third, err := testCommitAll(tempDir, "third")
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v2.0.0", *third, nil)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v2.1.0-rc.1", *third, nil)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("nightly", *third, nil)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemoteTagDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "tag", "v2.0.0"),
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "version", "2.0.0"),
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "commit", third.String()),
+				),
+			},
+			{
+				Config: testAccGitRemoteTagDataSourceConfig(tempDir, `constraint = ">= 1.2, < 2.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "tag", "v1.3.1"),
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "commit", second.String()),
+				),
+			},
+			{
+				// v1.2.0 is an annotated tag, commit is the peeled commit.
+				Config: testAccGitRemoteTagDataSourceConfig(tempDir, `constraint = "~1.2.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "tag", "v1.2.0"),
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "commit", first.String()),
+				),
+			},
+			{
+				Config: testAccGitRemoteTagDataSourceConfig(tempDir, `constraint = ">= 2.1.0-0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "tag", "v2.1.0-rc.1"),
+				),
+			},
+			{
+				Config: testAccGitRemoteTagDataSourceConfig(tempDir, `match = ["v1.*"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remote_tag.test", "tag", "v1.3.1"),
+				),
+			},
+			{
+				Config:      testAccGitRemoteTagDataSourceConfig(tempDir, `constraint = ">= 3.0"`),
+				ExpectError: regexp.MustCompile("unable to find matching tag"),
+			},
+			{
+				Config:      testAccGitRemoteTagDataSourceConfig(tempDir, `constraint = "latest"`),
+				ExpectError: regexp.MustCompile("invalid constraint"),
+			},
+		},
+	})
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/config"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRemotes{}
+
+func NewGitRemotes() datasource.DataSource {
+	return &GitRemotes{}
+}
+
+// GitRemotes defines the data source implementation.
+type GitRemotes struct {
+	provider *GitProviderData
+}
+
+// GitRemotesModel describes the data source data model.
+type GitRemotesModel struct {
+	Id      types.String              `tfsdk:"id"`
+	Path    types.String              `tfsdk:"path"`
+	Names   []types.String            `tfsdk:"names"`
+	Remotes map[string]GitRemoteModel `tfsdk:"remotes"`
+}
+
+// GitRemoteModel describes a single configured remote.
+type GitRemoteModel struct {
+	Name     types.String   `tfsdk:"name"`
+	FetchURL types.String   `tfsdk:"fetch_url"`
+	PushURL  types.String   `tfsdk:"push_url"`
+	URLs     []types.String `tfsdk:"urls"`
+	PushURLs []types.String `tfsdk:"push_urls"`
+	Fetch    []types.String `tfsdk:"fetch"`
+}
+
+func (d *GitRemotes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remotes"
+}
+
+func (d *GitRemotes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Remotes data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the remotes configured in the repository, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"remotes": schema.MapNestedAttribute{
+				MarkdownDescription: "Remotes configured in the repository, keyed by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the remote",
+							Computed:            true,
+						},
+						"fetch_url": schema.StringAttribute{
+							MarkdownDescription: "URL used to fetch from the remote",
+							Computed:            true,
+						},
+						"push_url": schema.StringAttribute{
+							MarkdownDescription: "URL used to push to the remote, same as `fetch_url` unless `pushurl` is configured",
+							Computed:            true,
+						},
+						"urls": schema.ListAttribute{
+							MarkdownDescription: "All URLs configured for the remote",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"push_urls": schema.ListAttribute{
+							MarkdownDescription: "All push URLs configured for the remote",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"fetch": schema.ListAttribute{
+							MarkdownDescription: "Fetch refspecs of the remote",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitRemotes) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRemotes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRemotesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+
+	names := make([]string, 0, len(cfg.Remotes))
+	for name := range cfg.Remotes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data.Names = []types.String{}
+	data.Remotes = map[string]GitRemoteModel{}
+	for _, name := range names {
+		remote := cfg.Remotes[name]
+
+		tflog.Trace(ctx, fmt.Sprintf("remote: %s urls: %v", name, remote.URLs))
+
+		data.Names = append(data.Names, types.StringValue(name))
+		data.Remotes[name] = newGitRemoteModel(cfg, remote)
+	}
+
+	data.Id = types.StringValue(data.Path.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newGitRemoteModel converts a remote configuration into its model, go-git
+// does not parse pushurl so it is read from the raw configuration.
+func newGitRemoteModel(cfg *config.Config, remote *config.RemoteConfig) GitRemoteModel {
+	pushURLs := cfg.Raw.Section("remote").Subsection(remote.Name).Options.GetAll("pushurl")
+	if len(pushURLs) == 0 {
+		pushURLs = remote.URLs
+	}
+
+	model := GitRemoteModel{
+		Name:     types.StringValue(remote.Name),
+		FetchURL: types.StringValue(""),
+		PushURL:  types.StringValue(""),
+		URLs:     []types.String{},
+		PushURLs: []types.String{},
+		Fetch:    []types.String{},
+	}
+
+	if len(remote.URLs) > 0 {
+		model.FetchURL = types.StringValue(remote.URLs[0])
+	}
+	if len(pushURLs) > 0 {
+		model.PushURL = types.StringValue(pushURLs[0])
+	}
+
+	for _, u := range remote.URLs {
+		model.URLs = append(model.URLs, types.StringValue(u))
+	}
+	for _, u := range pushURLs {
+		model.PushURLs = append(model.PushURLs, types.StringValue(u))
+	}
+	for _, refspec := range remote.Fetch {
+		model.Fetch = append(model.Fetch, types.StringValue(refspec.String()))
+	}
+
+	return model
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitRemotesDataSourceConfig(path string) string {
+	return fmt.Sprintf(`
+data "git_remotes" "test" {
+  path = %[1]q
+}
+`, path)
+}
+
+func TestAccGitRemotesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	_, err = repo.CreateRemote(&config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{"https://github.com/ekristen/terraform-provider-git.git"},
+	})
+	assert.NoError(t, err)
+
+	_, err = repo.CreateRemote(&config.RemoteConfig{
+		Name: "mirror",
+		URLs: []string{"https://example.com/mirror.git"},
+	})
+	assert.NoError(t, err)
+
+	cfg, err := repo.Config()
+	assert.NoError(t, err)
+	cfg.Raw.Section("remote").Subsection("mirror").SetOption("pushurl", "git@example.com:mirror.git")
+	assert.NoError(t, repo.Storer.SetConfig(cfg))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRemotesDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_remotes.test", "remotes.%", "2"),
+					resource.TestCheckResourceAttr("data.git_remotes.test", "names.0", "mirror"),
+					resource.TestCheckResourceAttr("data.git_remotes.test", "remotes.mirror.fetch_url", "https://example.com/mirror.git"),
+					resource.TestCheckResourceAttr("data.git_remotes.test", "remotes.mirror.push_url", "git@example.com:mirror.git"),
+					resource.TestCheckResourceAttr("data.git_remotes.test", "remotes.origin.name", "origin"),
+					resource.TestCheckResourceAttr("data.git_remotes.test", "remotes.origin.push_url", "https://github.com/ekristen/terraform-provider-git.git"),
+					resource.TestCheckResourceAttr("data.git_remotes.test", "remotes.origin.fetch.0", "+refs/heads/*:refs/remotes/origin/*"),
+				),
+			},
+		},
+	})
+}
@@ -3,13 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
-	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -25,27 +24,61 @@ func NewGitRepository() datasource.DataSource {
 
 // GitRepository defines the data source implementation.
 type GitRepository struct {
-	client *http.Client
+	provider *GitProviderData
 }
 
 // GitRepositoryModel describes the data source data model.
 type GitRepositoryModel struct {
-	Id                   types.String `tfsdk:"id"`
-	Path                 types.String `tfsdk:"path"`
-	Reference            types.String `tfsdk:"ref"`
-	ReferenceShort       types.String `tfsdk:"ref_short"`
-	Summary              types.String `tfsdk:"summary"`
-	Branch               types.String `tfsdk:"branch"`
-	Tag                  types.String `tfsdk:"tag"`
-	IsDirty              types.Bool   `tfsdk:"is_dirty"`
-	IsTag                types.Bool   `tfsdk:"is_tag"`
-	IsBranch             types.Bool   `tfsdk:"is_branch"`
-	IsRemote             types.Bool   `tfsdk:"is_remote"`
-	HasTag               types.Bool   `tfsdk:"has_tag"`
-	CommitCount          types.Int64  `tfsdk:"commit_count"`
-	Semver               types.String `tfsdk:"semver"`
-	SemverFallbackTag    types.String `tfsdk:"semver_fallback_tag"`
-	ReferenceShortLength types.Int64  `tfsdk:"ref_short_length"`
+	Id                   types.String   `tfsdk:"id"`
+	Path                 types.String   `tfsdk:"path"`
+	Reference            types.String   `tfsdk:"ref"`
+	ReferenceShort       types.String   `tfsdk:"ref_short"`
+	Summary              types.String   `tfsdk:"summary"`
+	Branch               types.String   `tfsdk:"branch"`
+	Tag                  types.String   `tfsdk:"tag"`
+	IsDirty              types.Bool     `tfsdk:"is_dirty"`
+	IsTag                types.Bool     `tfsdk:"is_tag"`
+	IsBranch             types.Bool     `tfsdk:"is_branch"`
+	IsRemote             types.Bool     `tfsdk:"is_remote"`
+	HasTag               types.Bool     `tfsdk:"has_tag"`
+	CommitCount          types.Int64    `tfsdk:"commit_count"`
+	Semver               types.String   `tfsdk:"semver"`
+	SemverFallbackTag    types.String   `tfsdk:"semver_fallback_tag"`
+	SemverFallback       types.String   `tfsdk:"semver_fallback"`
+	ReferenceShortLength types.Int64    `tfsdk:"ref_short_length"`
+	ReferenceShortUnique types.Bool     `tfsdk:"ref_short_unique"`
+	TagMessage           types.String   `tfsdk:"tag_message"`
+	TaggerName           types.String   `tfsdk:"tagger_name"`
+	TaggerEmail          types.String   `tfsdk:"tagger_email"`
+	TagDate              types.String   `tfsdk:"tag_date"`
+	TagDateUnix          types.Int64    `tfsdk:"tag_date_unix"`
+	TagSigned            types.Bool     `tfsdk:"tag_signed"`
+	TagSignature         types.String   `tfsdk:"tag_signature_type"`
+	TagKeyID             types.String   `tfsdk:"tag_signature_key_id"`
+	TagFingerprint       types.String   `tfsdk:"tag_signature_fingerprint"`
+	TagSignerEmail       types.String   `tfsdk:"tag_signer_email"`
+	IncludePaths         []types.String `tfsdk:"include_paths"`
+	ExcludePaths         []types.String `tfsdk:"exclude_paths"`
+	RootCommit           types.String   `tfsdk:"root_commit"`
+	RootCommitDate       types.String   `tfsdk:"root_commit_date"`
+	RootCommitDateUnix   types.Int64    `tfsdk:"root_commit_date_unix"`
+	AgeDays              types.Int64    `tfsdk:"age_days"`
+	BuildEpoch           types.Int64    `tfsdk:"build_epoch"`
+	BuildDate            types.String   `tfsdk:"build_date"`
+	TreeHash             types.String   `tfsdk:"tree_hash"`
+
+	IncludeSubmodules types.Bool                             `tfsdk:"include_submodules"`
+	Submodules        map[string]GitRepositorySubmoduleModel `tfsdk:"submodules"`
+
+	MetadataOnly types.Bool `tfsdk:"metadata_only"`
+}
+
+// GitRepositorySubmoduleModel summarizes a submodule of the repository.
+type GitRepositorySubmoduleModel struct {
+	Commit        types.String `tfsdk:"commit"`
+	CurrentCommit types.String `tfsdk:"current_commit"`
+	InSync        types.Bool   `tfsdk:"in_sync"`
+	IsDirty       types.Bool   `tfsdk:"is_dirty"`
 }
 
 func (d *GitRepository) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,8 +96,9 @@ func (d *GitRepository) Schema(ctx context.Context, req datasource.SchemaRequest
 				Computed:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Path to Git Repository",
-				Required:            true,
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
 			},
 			"summary": schema.StringAttribute{
 				MarkdownDescription: "Git Summary",
@@ -78,6 +112,46 @@ func (d *GitRepository) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "Current Tag of Repository",
 				Computed:            true,
 			},
+			"tag_message": schema.StringAttribute{
+				MarkdownDescription: "Annotation message of the tag pointing at the current reference",
+				Computed:            true,
+			},
+			"tagger_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tagger of the tag pointing at the current reference",
+				Computed:            true,
+			},
+			"tagger_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the tagger of the tag pointing at the current reference",
+				Computed:            true,
+			},
+			"tag_date": schema.StringAttribute{
+				MarkdownDescription: "Date the tag pointing at the current reference was created (RFC3339)",
+				Computed:            true,
+			},
+			"tag_date_unix": schema.Int64Attribute{
+				MarkdownDescription: "Date the tag pointing at the current reference was created (Unix timestamp), 0 for lightweight tags",
+				Computed:            true,
+			},
+			"tag_signed": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the tag pointing at the current reference carries a signature, the signature is not verified",
+				Computed:            true,
+			},
+			"tag_signature_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the tag signature, one of `gpg`, `ssh`, `x509` or `unknown`, empty when unsigned",
+				Computed:            true,
+			},
+			"tag_signature_key_id": schema.StringAttribute{
+				MarkdownDescription: "Key ID the tag claims to be signed with, the long key ID for `gpg` and the SHA256 fingerprint for `ssh`",
+				Computed:            true,
+			},
+			"tag_signature_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Fingerprint of the key the tag claims to be signed with, when the signature includes it",
+				Computed:            true,
+			},
+			"tag_signer_email": schema.StringAttribute{
+				MarkdownDescription: "Signer email embedded in the tag signature, when the signature includes it",
+				Computed:            true,
+			},
 			"ref": schema.StringAttribute{
 				MarkdownDescription: "Current reference of the repository",
 				Computed:            true,
@@ -90,6 +164,11 @@ func (d *GitRepository) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "Length of the short version of the current reference (default: 7)",
 				Optional:            true,
 			},
+			"ref_short_unique": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to lengthen `ref_short` until no other object of the repository starts with it, " +
+					"like `git rev-parse --short`, `ref_short_length` is then the minimum length (default: false)",
+				Optional: true,
+			},
 			"is_branch": schema.BoolAttribute{
 				MarkdownDescription: "Whether or not the current reference is a branch",
 				Computed:            true,
@@ -122,6 +201,93 @@ func (d *GitRepository) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "Fallback Tag for SEMVER Generation",
 				Optional:            true,
 			},
+			"semver_fallback": schema.StringAttribute{
+				MarkdownDescription: "What `semver` is set to when no tag is reachable, one of `tag` (`semver_fallback_tag` with " +
+					"the commit count and short hash as prerelease), `hash` (`ref_short`, like `git describe --always`) " +
+					"or `error` to fail instead (default: tag)",
+				Optional: true,
+				Computed: true,
+			},
+			"include_paths": schema.ListAttribute{
+				MarkdownDescription: "Only count commits touching at least one of these paths or globs (ie. `services/api/**`) " +
+					"towards `commit_count` and the describe distance",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"exclude_paths": schema.ListAttribute{
+				MarkdownDescription: "Do not count commits that only touch these paths or globs (ie. `docs/**`) " +
+					"towards `commit_count` and the describe distance",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"root_commit": schema.StringAttribute{
+				MarkdownDescription: "Hash of the initial commit reached by following first parents from HEAD, " +
+					"stable across forks and mirrors of the repository",
+				Computed: true,
+			},
+			"root_commit_date": schema.StringAttribute{
+				MarkdownDescription: "Author date of the root commit (RFC3339)",
+				Computed:            true,
+			},
+			"root_commit_date_unix": schema.Int64Attribute{
+				MarkdownDescription: "Author date of the root commit (Unix timestamp)",
+				Computed:            true,
+			},
+			"age_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of whole days since the root commit",
+				Computed:            true,
+			},
+			"build_epoch": schema.Int64Attribute{
+				MarkdownDescription: "Committer date of the current reference (Unix timestamp), to pass as `SOURCE_DATE_EPOCH` " +
+					"to reproducible builds. Uncommitted changes are not taken into account",
+				Computed: true,
+			},
+			"build_date": schema.StringAttribute{
+				MarkdownDescription: "Committer date of the current reference in UTC (RFC3339), `build_epoch` formatted for labels " +
+					"like `org.opencontainers.image.created`",
+				Computed: true,
+			},
+			"tree_hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the tree of the current reference, identical for commits with the same content " +
+					"regardless of their history, author or date",
+				Computed: true,
+			},
+			"metadata_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to only read HEAD, for very large repositories where only the commit is " +
+					"needed. The worktree status, tags, describe and the root commit are skipped so only `ref`, `ref_short`, " +
+					"`branch`, `is_branch`, `is_tag`, `is_remote`, `tree_hash`, `build_epoch` and `build_date` are set, " +
+					"every other attribute is null (default: false)",
+				Optional: true,
+			},
+			"include_submodules": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to summarize the submodules in `submodules`, see the `git_submodules` " +
+					"data source for details (default: false)",
+				Optional: true,
+			},
+			"submodules": schema.MapNestedAttribute{
+				MarkdownDescription: "Submodules keyed by path, requires `include_submodules`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "Commit the superproject pins the submodule to",
+							Computed:            true,
+						},
+						"current_commit": schema.StringAttribute{
+							MarkdownDescription: "Commit checked out in the submodule, empty when it is not initialized",
+							Computed:            true,
+						},
+						"in_sync": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the checked out commit is the pinned commit",
+							Computed:            true,
+						},
+						"is_dirty": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the submodule has uncommitted changes",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -132,18 +298,18 @@ func (d *GitRepository) Configure(ctx context.Context, req datasource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	providerData, ok := req.ProviderData.(*GitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.provider = providerData
 }
 
 func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -159,11 +325,30 @@ func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, re
 	if data.SemverFallbackTag.ValueString() == "" {
 		data.SemverFallbackTag = types.StringValue("v0.0.0")
 	}
+	if data.SemverFallback.ValueString() == "" {
+		data.SemverFallback = types.StringValue("tag")
+	}
 	if data.ReferenceShortLength.ValueInt64() == 0 {
 		data.ReferenceShortLength = types.Int64Value(7)
 	}
 
-	repo, err := git.PlainOpen(data.Path.ValueString())
+	switch data.SemverFallback.ValueString() {
+	case "tag", "hash", "error":
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("semver_fallback"), "invalid semver_fallback", "semver_fallback must be one of: tag, hash, error")
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	tflog.Trace(ctx, fmt.Sprintf("resolved path: %s", repoPath))
+
+	repo, err := d.provider.openRepository(repoPath)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to open git repository", err.Error())
 		return
@@ -175,23 +360,98 @@ func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	tagName, counter, headHash, err := gitutils.Describe(*repo)
+	headCommit, err := repo.CommitObject(head.Hash())
 	if err != nil {
-		resp.Diagnostics.AddError("unable to run git describe", err.Error())
+		resp.Diagnostics.AddError("unable to read head commit", err.Error())
 		return
 	}
 
+	data.Id = types.StringValue(data.Path.ValueString())
+	data.Branch = types.StringValue(head.Name().String())
+	data.IsTag = types.BoolValue(head.Name().IsTag())
+	data.IsBranch = types.BoolValue(head.Name().IsBranch())
+	data.IsRemote = types.BoolValue(head.Name().IsRemote())
+
+	data.BuildEpoch = types.Int64Value(headCommit.Committer.When.Unix())
+	data.BuildDate = types.StringValue(headCommit.Committer.When.UTC().Format(time.RFC3339))
+	data.TreeHash = types.StringValue(headCommit.TreeHash.String())
+
 	data.Reference = types.StringValue(head.Hash().String())
 	data.ReferenceShort = types.StringValue(head.Hash().String()[0:data.ReferenceShortLength.ValueInt64()])
-	data.CommitCount = types.Int64Value(int64(*counter))
+	if data.ReferenceShortUnique.ValueBool() {
+		short, err := gitutils.UniqueAbbrev(repo, head.Hash(), int(data.ReferenceShortLength.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("unable to abbreviate reference", err.Error())
+			return
+		}
+		data.ReferenceShort = types.StringValue(short)
+	}
+
+	if data.MetadataOnly.ValueBool() {
+		tflog.Trace(ctx, fmt.Sprintf("metadata only, head: %s", head.Hash().String()))
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 
-	result, err := gitutils.GenerateVersion(*tagName, *counter, *headHash, time.Now(), gitutils.GenerateVersionOptions{
-		FallbackTagName: data.SemverFallbackTag.ValueString(),
+	tags, err := tagsFingerprint(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list tags", err.Error())
+		return
+	}
+
+	describeOpts := gitutils.DescribeOptions{
+		IncludePaths: toStrings(data.IncludePaths),
+		ExcludePaths: toStrings(data.ExcludePaths),
+	}
+	describeKey := fmt.Sprintf("repository-describe|%s|%s|%s|%q|%q", repoPath, head.Hash().String(), tags, describeOpts.IncludePaths, describeOpts.ExcludePaths)
+
+	cached, err := d.provider.cache().get(describeKey, func() (interface{}, error) {
+		tagName, counter, headHash, err := gitutils.DescribeWithOptions(*repo, describeOpts)
+		return &repositoryDescribe{tagName: tagName, counter: counter, headHash: headHash}, err
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("unable to generate version", err.Error())
+		resp.Diagnostics.AddError("unable to run git describe", err.Error())
 		return
 	}
+	described := cached.(*repositoryDescribe)
+	tagName, counter, headHash := described.tagName, described.counter, described.headHash
+
+	root, err := gitutils.RootCommit(headCommit)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to find root commit", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("root_commit: %s", root.Hash.String()))
+
+	data.RootCommit = types.StringValue(root.Hash.String())
+	data.RootCommitDate = types.StringValue(root.Author.When.Format(time.RFC3339))
+	data.RootCommitDateUnix = types.Int64Value(root.Author.When.Unix())
+	data.AgeDays = types.Int64Value(int64(time.Since(root.Author.When) / (24 * time.Hour)))
+
+	data.CommitCount = types.Int64Value(int64(*counter))
+
+	var result *string
+	switch {
+	case toString(tagName) == "" && data.SemverFallback.ValueString() == "hash":
+		short := data.ReferenceShort.ValueString()
+		result = &short
+	case toString(tagName) == "" && data.SemverFallback.ValueString() == "error":
+		resp.Diagnostics.AddError("unable to generate version", fmt.Sprintf("no tag is reachable from %s", head.Hash().String()))
+		return
+	default:
+		result, err = gitutils.GenerateVersion(*tagName, *counter, *headHash, time.Now(), gitutils.GenerateVersionOptions{
+			FallbackTagName: data.SemverFallbackTag.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("unable to generate version", err.Error())
+			return
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("semver: %s fallback: %s", *result, data.SemverFallback.ValueString()))
 
 	worktree, err := repo.Worktree()
 	if err != nil {
@@ -224,8 +484,24 @@ func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, re
 	tflog.Trace(ctx, fmt.Sprintf("head_ref: %s", head.Hash().String()))
 
 	data.HasTag = types.BoolValue(false) // default
+	data.Tag = types.StringValue("")
+	data.TagMessage = types.StringValue("")
+	data.TaggerName = types.StringValue("")
+	data.TaggerEmail = types.StringValue("")
+	data.TagDate = types.StringValue("")
+	data.TagDateUnix = types.Int64Value(0)
+	data.TagSigned = types.BoolValue(false)
+	data.TagSignature = types.StringValue("")
+	data.TagKeyID = types.StringValue("")
+	data.TagFingerprint = types.StringValue("")
+	data.TagSignerEmail = types.StringValue("")
 
 	iter, err := repo.Tags()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list tags", err.Error())
+		return
+	}
+
 	if err := iter.ForEach(func(ref *plumbing.Reference) error {
 		if ref == nil {
 			return nil
@@ -234,9 +510,53 @@ func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, re
 		tflog.Trace(ctx, fmt.Sprintf("tag_ref: %s", ref.Hash().String()))
 		tflog.Trace(ctx, fmt.Sprintf("ref_obj: %+v", ref))
 
-		if ref.Hash().String() == head.Hash().String() {
-			tflog.Trace(ctx, "HERE1")
-			data.HasTag = types.BoolValue(true)
+		// Annotated tags reference a tag object rather than the commit itself.
+		tag, err := repo.TagObject(ref.Hash())
+		if err != nil && err != plumbing.ErrObjectNotFound {
+			return err
+		}
+
+		target := ref.Hash()
+		if tag != nil {
+			target = tag.Target
+		}
+
+		if target != head.Hash() {
+			return nil
+		}
+
+		// Prefer the tag picked by describe when several tags point at HEAD.
+		if data.HasTag.ValueBool() && ref.Name().Short() != toString(tagName) {
+			return nil
+		}
+
+		data.HasTag = types.BoolValue(true)
+		data.Tag = types.StringValue(ref.Name().Short())
+		data.TagMessage = types.StringValue("")
+		data.TaggerName = types.StringValue("")
+		data.TaggerEmail = types.StringValue("")
+		data.TagDate = types.StringValue("")
+		data.TagDateUnix = types.Int64Value(0)
+		data.TagSigned = types.BoolValue(false)
+		data.TagSignature = types.StringValue("")
+		data.TagKeyID = types.StringValue("")
+		data.TagFingerprint = types.StringValue("")
+		data.TagSignerEmail = types.StringValue("")
+
+		if tag != nil {
+			data.TagMessage = types.StringValue(tag.Message)
+			data.TaggerName = types.StringValue(tag.Tagger.Name)
+			data.TaggerEmail = types.StringValue(tag.Tagger.Email)
+			data.TagDate = types.StringValue(tag.Tagger.When.Format(time.RFC3339))
+			data.TagDateUnix = types.Int64Value(tag.Tagger.When.Unix())
+
+			if sig := parseSignature(ctx, tag.PGPSignature); sig != nil {
+				data.TagSigned = types.BoolValue(true)
+				data.TagSignature = types.StringValue(sig.Type)
+				data.TagKeyID = types.StringValue(sig.KeyID)
+				data.TagFingerprint = types.StringValue(sig.Fingerprint)
+				data.TagSignerEmail = types.StringValue(sig.SignerEmail)
+			}
 		}
 
 		return nil
@@ -245,13 +565,28 @@ func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	data.Id = types.StringValue(data.Path.ValueString())
+	data.Submodules = map[string]GitRepositorySubmoduleModel{}
+	if data.IncludeSubmodules.ValueBool() {
+		submodules, err := readSubmodules(repo, repoPath)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read submodules", err.Error())
+			return
+		}
+
+		for _, submodule := range submodules {
+			tflog.Trace(ctx, fmt.Sprintf("submodule: %s commit: %s", submodule.Path.ValueString(), submodule.ExpectedCommit.ValueString()))
+
+			data.Submodules[submodule.Path.ValueString()] = GitRepositorySubmoduleModel{
+				Commit:        submodule.ExpectedCommit,
+				CurrentCommit: submodule.CurrentCommit,
+				InSync:        submodule.InSync,
+				IsDirty:       submodule.IsDirty,
+			}
+		}
+	}
+
 	data.Semver = types.StringValue(*result)
-	data.Branch = types.StringValue(head.Name().String())
 	data.IsDirty = types.BoolValue(dirty)
-	data.IsTag = types.BoolValue(head.Name().IsTag())
-	data.IsBranch = types.BoolValue(head.Name().IsBranch())
-	data.IsRemote = types.BoolValue(head.Name().IsRemote())
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -261,6 +596,13 @@ func (d *GitRepository) Read(ctx context.Context, req datasource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// repositoryDescribe is the cached result of gitutils.DescribeWithOptions.
+type repositoryDescribe struct {
+	tagName  *string
+	counter  *int
+	headHash *string
+}
+
 func toString(original *string) string {
 	if original != nil {
 		return *original
@@ -268,6 +610,14 @@ func toString(original *string) string {
 	return ""
 }
 
+func toStrings(original []types.String) []string {
+	values := make([]string, 0, len(original))
+	for _, v := range original {
+		values = append(values, v.ValueString())
+	}
+	return values
+}
+
 func toInt(original *int) int {
 	if original != nil {
 		return *original
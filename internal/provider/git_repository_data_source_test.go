@@ -2,13 +2,16 @@ package provider
 
 import (
 	"fmt"
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"os"
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -30,6 +33,117 @@ data "git_repository" "test" {
 `, path)
 }
 
+func testAccGitRepositoryDataSourceConfigUnique(path string) string {
+	return fmt.Sprintf(`
+data "git_repository" "test" {
+  path             = %[1]q
+  ref_short_length = 4
+  ref_short_unique = true
+}
+`, path)
+}
+
+func testAccGitRepositoryDataSourceConfigProviderBase(path string, base string) string {
+	return fmt.Sprintf(`
+provider "git" {
+  base = %[2]q
+}
+
+data "git_repository" "test" {
+  path = %[1]q
+}
+
+data "git_diff" "test" {
+  path     = %[1]q
+  from_ref = "HEAD"
+}
+`, path, base)
+}
+
+func testAccGitRepositoryDataSourceConfigDefaultPath(path string) string {
+	return fmt.Sprintf(`
+provider "git" {
+  default_path = %[1]q
+}
+
+data "git_repository" "test" {}
+`, path)
+}
+
+func testAccGitRepositoryDataSourceConfigUnsafeOwnership(path string, allow bool) string {
+	return fmt.Sprintf(`
+provider "git" {
+  allow_unsafe_ownership = %[2]t
+}
+
+data "git_repository" "test" {
+  path = %[1]q
+}
+`, path, allow)
+}
+
+func testAccGitRepositoryDataSourceConfigSubmodules(path string) string {
+	return fmt.Sprintf(`
+data "git_repository" "test" {
+  path               = %[1]q
+  include_submodules = true
+}
+`, path)
+}
+
+func testAccGitRepositoryDataSourceConfigSemverFallback(path string, fallback string) string {
+	return fmt.Sprintf(`
+data "git_repository" "test" {
+  path            = %[1]q
+  semver_fallback = %[2]q
+}
+`, path, fallback)
+}
+
+func testAccGitRepositoryDataSourceConfigMetadataOnly(path string) string {
+	return fmt.Sprintf(`
+data "git_repository" "test" {
+  path          = %[1]q
+  metadata_only = true
+}
+`, path)
+}
+
+func testAccGitRepositoryDataSourceConfigRepositories(path string, options string) string {
+	return fmt.Sprintf(`
+provider "git" {
+  default_path = "infra"
+
+  repositories = {
+    infra = {
+      path = %[1]q
+    }
+    remote = {
+      url = "https://example.com/remote.git"
+    }
+  }
+}
+
+data "git_repository" "test" {
+  %[2]s
+}
+`, path, options)
+}
+
+func testAccGitRepositoryDataSourceConfigPaths(path string) string {
+	return fmt.Sprintf(`
+data "git_repository" "test" {
+  path          = %[1]q
+  exclude_paths = ["docs/**"]
+}
+
+data "git_repository" "include" {
+  path          = %[1]q
+  include_paths = ["docs"]
+}
+`, path)
+}
+
 func TestAccGitRepositoryDataSource1(t *testing.T) {
 	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
 	assert.NoError(t, err)
@@ -113,6 +227,8 @@ func TestAccGitRepositoryDataSource3(t *testing.T) {
 					resource.TestCheckResourceAttr("data.git_repository.test", "is_dirty", "false"),
 					resource.TestCheckResourceAttr("data.git_repository.test", "has_tag", "false"),
 					resource.TestCheckResourceAttr("data.git_repository.test", "semver", fmt.Sprintf("v1.0.0-1.g%s", hash.String()[0:7])),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag", ""),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag_message", ""),
 					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
 				),
 			},
@@ -144,6 +260,11 @@ func TestAccGitRepositoryDataSource4(t *testing.T) {
 					resource.TestCheckResourceAttr("data.git_repository.test", "is_dirty", "false"),
 					resource.TestCheckResourceAttr("data.git_repository.test", "semver", "v1.0.0"),
 					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag_message", "v1.0.0\n"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag_signed", "false"),
+					resource.TestCheckResourceAttrSet("data.git_repository.test", "tagger_name"),
+					resource.TestCheckResourceAttrSet("data.git_repository.test", "tag_date"),
 				),
 			},
 		},
@@ -173,6 +294,448 @@ func TestAccGitRepositoryDataSource5(t *testing.T) {
 	})
 }
 
+func TestAccGitRepositoryDataSource8(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigDefaultPath(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "id", tempDir),
+					resource.TestCheckResourceAttr("data.git_repository.test", "path", tempDir),
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource9(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	t.Setenv("GIT_PROVIDER_DEFAULT_PATH", tempDir)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "git_repository" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "path", tempDir),
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource10(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "docs"), 0755))
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "docs", "index.md"), []byte(fmt.Sprintf("docs %02d", i)), 0644))
+		_, err = testCommitAll(tempDir, fmt.Sprintf("docs %02d", i))
+		assert.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigPaths(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "commit_count", "1"),
+					resource.TestCheckResourceAttr("data.git_repository.include", "commit_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource11(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	root, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "main.tf"), []byte("# main"), 0644))
+	hash, err := testCommitAll(tempDir, "main")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigBasic(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+					resource.TestCheckResourceAttr("data.git_repository.test", "root_commit", root.String()),
+					resource.TestMatchResourceAttr("data.git_repository.test", "root_commit_date", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestCheckResourceAttr("data.git_repository.test", "age_days", "0"),
+					resource.TestMatchResourceAttr("data.git_repository.test", "root_commit_date_unix", regexp.MustCompile(`^[1-9]\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource12(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	entity, err := openpgp.NewEntity("Signer", "", "signer@example.com", nil)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v1.0.0", *hash, &git.CreateTagOptions{
+		Message: "v1.0.0",
+		SignKey: entity,
+	})
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigBasic(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag_signed", "true"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag_signature_type", "gpg"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tag_signature_key_id", entity.PrimaryKey.KeyIdString()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource13(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	// store a blob sharing the first 4 digits of HEAD, but not the 5th
+	for i := 0; ; i++ {
+		content := []byte(fmt.Sprintf("collision %d", i))
+		blob := plumbing.ComputeHash(plumbing.BlobObject, content)
+		if blob.String()[:4] != hash.String()[:4] || blob.String()[4] == hash.String()[4] {
+			continue
+		}
+
+		obj := repo.Storer.NewEncodedObject()
+		obj.SetType(plumbing.BlobObject)
+		w, err := obj.Writer()
+		assert.NoError(t, err)
+		_, err = w.Write(content)
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+		_, err = repo.Storer.SetEncodedObject(obj)
+		assert.NoError(t, err)
+		break
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigUnique(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref_short", hash.String()[0:5]),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource14(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	base, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	// same content as the first commit, committed at a fixed date
+	signature := &object.Signature{Name: "builder", Email: "builder@example.com", When: time.Date(2023, 11, 14, 22, 13, 20, 0, time.FixedZone("CET", 3600))}
+	_, err = wt.Commit("rebuild", &git.CommitOptions{
+		Author:    signature,
+		Committer: signature,
+	})
+	assert.NoError(t, err)
+
+	first, err := repo.CommitObject(*base)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigBasic(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "build_epoch", "1699996400"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "build_date", "2023-11-14T21:13:20Z"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "tree_hash", first.TreeHash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource15(t *testing.T) {
+	if os.Geteuid() != 0 || os.Getenv("SUDO_UID") != "" {
+		t.Skip("changing the owner of the repository requires running as root")
+	}
+
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chown(tempDir, 12345, 12345))
+
+	// keep safe.directory from the global configuration of the host out
+	t.Setenv("HOME", tempDir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitRepositoryDataSourceConfigUnsafeOwnership(tempDir, false),
+				ExpectError: regexp.MustCompile("dubious ownership"),
+			},
+			{
+				Config: testAccGitRepositoryDataSourceConfigUnsafeOwnership(tempDir, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource16(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	subHash, err := testSetupSubmodule(tempDir, "modules/sub", nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "modules/sub", "README.md"), []byte("dirty"), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigBasic(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "submodules.%", "0"),
+				),
+			},
+			{
+				Config: testAccGitRepositoryDataSourceConfigSubmodules(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "submodules.%", "1"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "submodules.modules/sub.commit", subHash.String()),
+					resource.TestCheckResourceAttr("data.git_repository.test", "submodules.modules/sub.current_commit", subHash.String()),
+					resource.TestCheckResourceAttr("data.git_repository.test", "submodules.modules/sub.in_sync", "true"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "submodules.modules/sub.is_dirty", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource17(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigBasic(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "semver_fallback", "tag"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "semver", fmt.Sprintf("v0.0.0-1.g%s", hash.String()[0:7])),
+				),
+			},
+			{
+				Config: testAccGitRepositoryDataSourceConfigSemverFallback(tempDir, "hash"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "semver", hash.String()[0:7]),
+				),
+			},
+			{
+				Config:      testAccGitRepositoryDataSourceConfigSemverFallback(tempDir, "error"),
+				ExpectError: regexp.MustCompile("no tag is reachable"),
+			},
+			{
+				Config:      testAccGitRepositoryDataSourceConfigSemverFallback(tempDir, "latest"),
+				ExpectError: regexp.MustCompile("invalid semver_fallback"),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource18(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "v1.0.0", 1)
+	assert.NoError(t, err)
+
+	// A dirty worktree is not reported without status.
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("dirty"), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigMetadataOnly(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref_short", hash.String()[0:7]),
+					resource.TestCheckResourceAttr("data.git_repository.test", "branch", "refs/heads/master"),
+					resource.TestCheckResourceAttr("data.git_repository.test", "is_branch", "true"),
+					resource.TestCheckResourceAttrSet("data.git_repository.test", "tree_hash"),
+					resource.TestCheckNoResourceAttr("data.git_repository.test", "is_dirty"),
+					resource.TestCheckNoResourceAttr("data.git_repository.test", "semver"),
+					resource.TestCheckNoResourceAttr("data.git_repository.test", "tag"),
+					resource.TestCheckNoResourceAttr("data.git_repository.test", "root_commit"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryDataSource19(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigRepositories(tempDir, `path = "infra"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "path", tempDir),
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+				),
+			},
+			{
+				Config: testAccGitRepositoryDataSourceConfigRepositories(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "path", tempDir),
+				),
+			},
+			{
+				Config:      testAccGitRepositoryDataSourceConfigRepositories(tempDir, `path = "remote"`),
+				ExpectError: regexp.MustCompile(`repository "remote" has no path`),
+			},
+		},
+	})
+}
+
 func testSetupGit(path string, tag string, extraCommits int) (*plumbing.Hash, error) {
 	repo, err := git.PlainInit(path, false)
 	if err != nil {
@@ -226,3 +789,63 @@ func testSetupGit(path string, tag string, extraCommits int) (*plumbing.Hash, er
 
 	return &hash, nil
 }
+
+// testCommitAll stages every change in the worktree at path and commits it.
+func testCommitAll(path string, message string) (*plumbing.Hash, error) {
+	repo, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wt.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return nil, err
+	}
+
+	hash, err := wt.Commit(message, &git.CommitOptions{
+		All: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hash, nil
+}
+
+func TestAccGitRepositoryDataSource20(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	hash, err := testSetupGit(filepath.Join(tempDir, "repo"), "", 0)
+	assert.NoError(t, err)
+
+	t.Setenv("PWD", tempDir)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitRepositoryDataSourceConfigProviderBase("repo", "module"),
+				ExpectError: regexp.MustCompile("unsupported base"),
+			},
+			// Read testing
+			{
+				Config: testAccGitRepositoryDataSourceConfigProviderBase("repo", "cwd"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_repository.test", "id", filepath.Join(tempDir, "repo")),
+					resource.TestCheckResourceAttr("data.git_repository.test", "ref", hash.String()),
+					resource.TestCheckResourceAttr("data.git_diff.test", "path", filepath.Join(tempDir, "repo")),
+					resource.TestCheckResourceAttr("data.git_diff.test", "to_commit", hash.String()),
+				),
+			},
+		},
+	})
+}
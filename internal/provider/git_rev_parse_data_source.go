@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRevParse{}
+
+func NewGitRevParse() datasource.DataSource {
+	return &GitRevParse{}
+}
+
+// GitRevParse defines the data source implementation.
+type GitRevParse struct {
+	provider *GitProviderData
+}
+
+// GitRevParseModel describes the data source data model.
+type GitRevParseModel struct {
+	Id        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Revision  types.String `tfsdk:"rev"`
+	Hash      types.String `tfsdk:"hash"`
+	Short     types.String `tfsdk:"short"`
+	Type      types.String `tfsdk:"type"`
+	Commit    types.String `tfsdk:"commit"`
+	Reference types.String `tfsdk:"ref"`
+}
+
+func (d *GitRevParse) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rev_parse"
+}
+
+func (d *GitRevParse) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Rev Parse data source, resolves a revision expression to the full hash and type of " +
+			"the object it names like `git rev-parse`. Supports branches, tags, full and abbreviated hashes, " +
+			"`~` and `^` navigation, `^{/regex}`, `<branch>@{upstream}`, peeling with `^{type}` and `<rev>:<path>`, " +
+			"reflog entries (ie. `HEAD@{1}`) and the index are not supported",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rev": schema.StringAttribute{
+				MarkdownDescription: "Revision expression to resolve (ie. `HEAD~3`, `main@{u}`, `v1.2.0^{commit}` or `HEAD:go.mod`)",
+				Required:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Full hash of the object `rev` names, annotated tags are not peeled unless `rev` asks to " +
+					"(ie. `v1.2.0^{}`)",
+				Computed: true,
+			},
+			"short": schema.StringAttribute{
+				MarkdownDescription: "Shortest unique abbreviation of `hash`, at least 7 characters long",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the object, one of `commit`, `tree`, `blob` or `tag`",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the object peels to, empty for trees and blobs",
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Full name of the reference `rev` names (ie. `refs/heads/main` for `main`, `HEAD` or " +
+					"`main@{u}` tracking a local branch), empty for other expressions",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *GitRevParse) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitRevParse) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRevParseModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	result, err := gitutils.RevParse(repo, data.Revision.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rev"), "unable to resolve revision", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("rev: %s %s: %s", data.Revision.ValueString(), result.Type.String(), result.Hash.String()))
+
+	short, err := gitutils.UniqueAbbrev(repo, result.Hash, 7)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to abbreviate hash", err.Error())
+		return
+	}
+
+	data.Commit = types.StringValue("")
+	if result.Type == plumbing.CommitObject || result.Type == plumbing.TagObject {
+		if commit, err := result.Peel(repo, "commit"); err == nil {
+			data.Commit = types.StringValue(commit.Hash.String())
+		}
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), data.Revision.ValueString()))
+	data.Hash = types.StringValue(result.Hash.String())
+	data.Short = types.StringValue(short)
+	data.Type = types.StringValue(result.Type.String())
+	data.Reference = types.StringValue(result.Reference.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitRevParseDataSourceConfig(path string, rev string) string {
+	return fmt.Sprintf(`
+data "git_rev_parse" "test" {
+  path = %[1]q
+  rev  = %[2]q
+}
+`, path, rev)
+}
+
+func TestAccGitRevParseDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	head, err := testSetupGit(tempDir, "v1.0.0", 2)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	tag, err := repo.Reference(plumbing.NewTagReferenceName("v1.0.0"), true)
+	assert.NoError(t, err)
+	tagObject, err := repo.TagObject(tag.Hash())
+	assert.NoError(t, err)
+	first := tagObject.Target
+
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "main"), first)))
+	assert.NoError(t, repo.CreateBranch(&config.Branch{
+		Name:   "master",
+		Remote: "origin",
+		Merge:  plumbing.NewBranchReferenceName("main"),
+	}))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, "HEAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", head.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "short", head.String()[:7]),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "type", "commit"),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "ref", "refs/heads/master"),
+				),
+			},
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, "HEAD~2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", first.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "type", "commit"),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "ref", ""),
+				),
+			},
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", tag.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "type", "tag"),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "commit", first.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "ref", "refs/tags/v1.0.0"),
+				),
+			},
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, "v1.0.0^{commit}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", first.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "type", "commit"),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "ref", ""),
+				),
+			},
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, "master@{u}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", first.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "ref", "refs/remotes/origin/main"),
+				),
+			},
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, head.String()[:8]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", head.String()),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "ref", ""),
+				),
+			},
+			{
+				Config: testAccGitRevParseDataSourceConfig(tempDir, "v1.0.0:README.md"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "hash", "9a2c7732fab5bcd73ea3ed52d2d9599a4cc47666"),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "type", "blob"),
+					resource.TestCheckResourceAttr("data.git_rev_parse.test", "commit", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRevParseDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccGitRevParseDataSourceConfig(tempDir, "@{u}"),
+				ExpectError: regexp.MustCompile("no upstream configured"),
+			},
+			{
+				Config:      testAccGitRevParseDataSourceConfig(tempDir, "HEAD@{1}"),
+				ExpectError: regexp.MustCompile("reflog entries are not supported"),
+			},
+			{
+				Config:      testAccGitRevParseDataSourceConfig(tempDir, "HEAD:README.md^{commit}"),
+				ExpectError: regexp.MustCompile("unable to resolve revision"),
+			},
+		},
+	})
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitSemver{}
+
+func NewGitSemver() datasource.DataSource {
+	return &GitSemver{}
+}
+
+// GitSemver defines the data source implementation.
+type GitSemver struct {
+	provider *GitProviderData
+}
+
+// GitSemverModel describes the data source data model.
+type GitSemverModel struct {
+	Id             types.String   `tfsdk:"id"`
+	Path           types.String   `tfsdk:"path"`
+	Reference      types.String   `tfsdk:"ref"`
+	Match          []types.String `tfsdk:"match"`
+	MinorTypes     []types.String `tfsdk:"minor_types"`
+	PatchTypes     []types.String `tfsdk:"patch_types"`
+	FallbackTag    types.String   `tfsdk:"fallback_tag"`
+	Commit         types.String   `tfsdk:"commit"`
+	CurrentVersion types.String   `tfsdk:"current_version"`
+	CurrentCommit  types.String   `tfsdk:"current_commit"`
+	NextVersion    types.String   `tfsdk:"next_version"`
+	Bump           types.String   `tfsdk:"bump"`
+	CommitCount    types.Int64    `tfsdk:"commit_count"`
+}
+
+func (d *GitSemver) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_semver"
+}
+
+func (d *GitSemver) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Semver data source, computes the next version from the commits since the latest release tag " +
+			"following [Conventional Commits](https://www.conventionalcommits.org): breaking changes bump the major version, " +
+			"`minor_types` the minor version and `patch_types` the patch version",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compute the next version for, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"match": schema.ListAttribute{
+				MarkdownDescription: "Only consider tags matching at least one of these glob patterns (ie. `api/v*`), " +
+					"by default every tag that is a version without prerelease is a release",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"minor_types": schema.ListAttribute{
+				MarkdownDescription: "Commit types bumping the minor version (default: [\"feat\"])",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"patch_types": schema.ListAttribute{
+				MarkdownDescription: "Commit types bumping the patch version (default: [\"fix\", \"perf\"])",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"fallback_tag": schema.StringAttribute{
+				MarkdownDescription: "Version the bump is applied to when no release tag is found (default: v0.0.0)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"current_version": schema.StringAttribute{
+				MarkdownDescription: "Latest release tag reachable from `ref`, the highest version rather than the closest tag, " +
+					"empty when none was found",
+				Computed: true,
+			},
+			"current_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the latest release tag points at, empty when none was found",
+				Computed:            true,
+			},
+			"next_version": schema.StringAttribute{
+				MarkdownDescription: "Version following `current_version`, or `fallback_tag`, for `bump`, keeping the tag prefix " +
+					"(ie. `v1.3.0`). Same as `current_version` when `bump` is `none`",
+				Computed: true,
+			},
+			"bump": schema.StringAttribute{
+				MarkdownDescription: "Highest bump called for by the commits since the latest release, " +
+					"one of `major`, `minor`, `patch` or `none`",
+				Computed: true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits since the latest release",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitSemver) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitSemver) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitSemverModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.MinorTypes == nil {
+		data.MinorTypes = []types.String{types.StringValue("feat")}
+	}
+	if data.PatchTypes == nil {
+		data.PatchTypes = []types.String{types.StringValue("fix"), types.StringValue("perf")}
+	}
+	if data.FallbackTag.ValueString() == "" {
+		data.FallbackTag = types.StringValue("v0.0.0")
+	}
+
+	fallback := gitutils.SemVerParse(data.FallbackTag.ValueString())
+	if fallback == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fallback_tag"), "invalid format", fmt.Sprintf("%q is not a semantic version", data.FallbackTag.ValueString()))
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	release, err := gitutils.LatestReleaseTag(repo, commit, toStrings(data.Match))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to find release tag", err.Error())
+		return
+	}
+
+	current := *fallback
+	data.CurrentVersion = types.StringValue("")
+	data.CurrentCommit = types.StringValue("")
+
+	var from *object.Commit
+	if release != nil {
+		tflog.Trace(ctx, fmt.Sprintf("release: %s commit: %s", release.Name, release.Commit.Hash.String()))
+
+		current = *release.Version
+		from = release.Commit
+		data.CurrentVersion = types.StringValue(release.Name)
+		data.CurrentCommit = types.StringValue(release.Commit.Hash.String())
+	}
+
+	opts := gitutils.ConventionalBumpOptions{
+		MinorTypes: toStrings(data.MinorTypes),
+		PatchTypes: toStrings(data.PatchTypes),
+	}
+
+	bump := gitutils.BumpNone
+	count := 0
+	if err := walkRange(from, commit, func(c *object.Commit) error {
+		count++
+
+		conventional := gitutils.ParseConventionalCommit(c.Message)
+		if conventional == nil {
+			return nil
+		}
+
+		if b := conventional.Bump(opts); b > bump {
+			tflog.Trace(ctx, fmt.Sprintf("commit: %s type: %s bump: %s", c.Hash.String(), conventional.Type, b))
+			bump = b
+		}
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	next := current.Increment(bump)
+	if release != nil && bump == gitutils.BumpNone {
+		data.NextVersion = types.StringValue(release.Name)
+	} else {
+		data.NextVersion = types.StringValue(next.String())
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.Bump = types.StringValue(bump.String())
+	data.CommitCount = types.Int64Value(int64(count))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitSemverDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_semver" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func testCommitMessages(path string, messages ...string) error {
+	for i, message := range messages {
+		if err := os.WriteFile(filepath.Join(path, "CHANGELOG.md"), []byte(fmt.Sprintf("change %d", i)), 0644); err != nil {
+			return err
+		}
+		if _, err := testCommitAll(path, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAccGitSemverDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	tagged, err := testSetupGit(tempDir, "v1.2.3", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, testCommitMessages(tempDir,
+		"docs: typo",
+		"fix(api): handle empty body",
+		"feat: add export",
+		"refactor: drop v1 endpoints\n\nBREAKING CHANGE: v1 endpoints are gone",
+	))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitSemverDataSourceConfig(tempDir, `ref = "HEAD~3"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_semver.test", "current_version", "v1.2.3"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "current_commit", tagged.String()),
+					resource.TestCheckResourceAttr("data.git_semver.test", "bump", "none"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "next_version", "v1.2.3"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "commit_count", "1"),
+				),
+			},
+			{
+				Config: testAccGitSemverDataSourceConfig(tempDir, `ref = "HEAD~2"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_semver.test", "bump", "patch"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "next_version", "v1.2.4"),
+				),
+			},
+			{
+				Config: testAccGitSemverDataSourceConfig(tempDir, `ref = "HEAD~1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_semver.test", "bump", "minor"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "next_version", "v1.3.0"),
+				),
+			},
+			{
+				Config: testAccGitSemverDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_semver.test", "bump", "major"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "next_version", "v2.0.0"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "commit_count", "4"),
+				),
+			},
+			{
+				Config: testAccGitSemverDataSourceConfig(tempDir, `ref = "HEAD~1"
+  minor_types = []
+  patch_types = ["fix", "feat", "docs"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_semver.test", "bump", "patch"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "next_version", "v1.2.4"),
+				),
+			},
+			{
+				Config: testAccGitSemverDataSourceConfig(tempDir, `match = ["release-*"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_semver.test", "current_version", ""),
+					resource.TestCheckResourceAttr("data.git_semver.test", "next_version", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.git_semver.test", "commit_count", "5"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitShortlog{}
+
+func NewGitShortlog() datasource.DataSource {
+	return &GitShortlog{}
+}
+
+// GitShortlog defines the data source implementation.
+type GitShortlog struct {
+	provider *GitProviderData
+}
+
+// GitShortlogModel describes the data source data model.
+type GitShortlogModel struct {
+	Id          types.String            `tfsdk:"id"`
+	Path        types.String            `tfsdk:"path"`
+	FromRef     types.String            `tfsdk:"from_ref"`
+	ToRef       types.String            `tfsdk:"to_ref"`
+	Group       types.String            `tfsdk:"group"`
+	Email       types.Bool              `tfsdk:"email"`
+	UseMailmap  types.Bool              `tfsdk:"use_mailmap"`
+	CommitCount types.Int64             `tfsdk:"commit_count"`
+	Entries     []GitShortlogEntryModel `tfsdk:"entries"`
+}
+
+// GitShortlogEntryModel describes the commits of a single identity.
+type GitShortlogEntryModel struct {
+	Name        types.String `tfsdk:"name"`
+	Email       types.String `tfsdk:"email"`
+	CommitCount types.Int64  `tfsdk:"commit_count"`
+}
+
+func (d *GitShortlog) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shortlog"
+}
+
+func (d *GitShortlog) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Shortlog data source, counts the commits of each author over a range of commits " +
+			"(`from_ref..to_ref`) like `git shortlog -sn`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Exclude commits reachable from this reference, a branch, tag or commit (default: the whole history)",
+				Optional:            true,
+			},
+			"to_ref": schema.StringAttribute{
+				MarkdownDescription: "Include commits reachable from this reference, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Identity commits are grouped by, `author` or `committer` like `--committer` (default: author)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to group by email as well as name like `-e`, `email` is empty otherwise (default: false)",
+				Optional:            true,
+			},
+			"use_mailmap": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to merge identities according to the `.mailmap` of the repository, the one " +
+					"of the worktree or the one committed at HEAD for bare repositories (default: false)",
+				Optional: true,
+			},
+			"commit_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits in the range",
+				Computed:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Identities of the commits in the range, sorted by commit count then name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the identity",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email of the identity, empty unless `email` is set",
+							Computed:            true,
+						},
+						"commit_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of commits of the identity in the range",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitShortlog) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitShortlog) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitShortlogModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ToRef.ValueString() == "" {
+		data.ToRef = types.StringValue("HEAD")
+	}
+	if data.Group.ValueString() == "" {
+		data.Group = types.StringValue("author")
+	}
+	if data.Group.ValueString() != "author" && data.Group.ValueString() != "committer" {
+		resp.Diagnostics.AddAttributeError(path.Root("group"), "invalid group", "group must be one of author or committer")
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	var from *object.Commit
+	if data.FromRef.ValueString() != "" {
+		from, err = resolveCommit(repo, data.FromRef.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+	}
+
+	to, err := resolveCommit(repo, data.ToRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	var mailmap *gitutils.Mailmap
+	if data.UseMailmap.ValueBool() {
+		mailmap, err = readMailmap(repo)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read mailmap", err.Error())
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("mailmap emails: %d", mailmap.Len()))
+	}
+
+	type identity struct {
+		name  string
+		email string
+	}
+
+	total := int64(0)
+	counts := map[identity]int64{}
+	if err := walkRange(from, to, func(c *object.Commit) error {
+		total++
+
+		sig := c.Author
+		if data.Group.ValueString() == "committer" {
+			sig = c.Committer
+		}
+
+		// A nil mailmap returns the identity as is.
+		name, email := mailmap.Resolve(sig.Name, sig.Email)
+		if !data.Email.ValueBool() {
+			email = ""
+		}
+
+		counts[identity{name: name, email: email}]++
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to walk commits", err.Error())
+		return
+	}
+
+	data.Entries = []GitShortlogEntryModel{}
+	for id, count := range counts {
+		tflog.Trace(ctx, fmt.Sprintf("identity: %s <%s> commits: %d", id.name, id.email, count))
+
+		data.Entries = append(data.Entries, GitShortlogEntryModel{
+			Name:        types.StringValue(id.name),
+			Email:       types.StringValue(id.email),
+			CommitCount: types.Int64Value(count),
+		})
+	}
+
+	sort.Slice(data.Entries, func(i, j int) bool {
+		a, b := data.Entries[i], data.Entries[j]
+		if a.CommitCount.ValueInt64() != b.CommitCount.ValueInt64() {
+			return a.CommitCount.ValueInt64() > b.CommitCount.ValueInt64()
+		}
+		if a.Name.ValueString() != b.Name.ValueString() {
+			return a.Name.ValueString() < b.Name.ValueString()
+		}
+		return a.Email.ValueString() < b.Email.ValueString()
+	})
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s..%s", data.Path.ValueString(), data.FromRef.ValueString(), to.Hash.String()))
+	data.CommitCount = types.Int64Value(total)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitShortlogDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_shortlog" "test" {
+  path     = %[1]q
+  from_ref = "v1.0.0"
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitShortlogDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	committer := object.Signature{Name: "CI", Email: "ci@example.com", When: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)}
+	authors := []object.Signature{
+		{Name: "Jane Doe", Email: "jane@example.com", When: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Jane Doe", Email: "jane@old.example.com", When: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "jdoe", Email: "jane@work.example.com", When: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Bob", Email: "bob@example.com", When: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, author := range authors {
+		author := author
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte(fmt.Sprintf("change %d", i)), 0644))
+		_, err = wt.Commit(fmt.Sprintf("change %d", i), &git.CommitOptions{
+			All:       true,
+			Author:    &author,
+			Committer: &committer,
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".mailmap"), []byte(`Jane Doe <jane@example.com> <jane@old.example.com>
+Jane Doe <jane@example.com> jdoe <jane@work.example.com>
+`), 0644))
+
+	reg, err := regexp.Compile("invalid group")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitShortlogDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "group", "author"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "commit_count", "4"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.#", "3"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.email", ""),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.commit_count", "2"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.1.name", "Bob"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.2.name", "jdoe"),
+				),
+			},
+			{
+				Config: testAccGitShortlogDataSourceConfig(tempDir, "email = true\n  use_mailmap = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.commit_count", "3"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.1.name", "Bob"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.1.email", "bob@example.com"),
+				),
+			},
+			{
+				Config: testAccGitShortlogDataSourceConfig(tempDir, `group = "committer"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.name", "CI"),
+					resource.TestCheckResourceAttr("data.git_shortlog.test", "entries.0.commit_count", "4"),
+				),
+			},
+			{
+				Config:      testAccGitShortlogDataSourceConfig(tempDir, `group = "reviewer"`),
+				ExpectError: reg,
+			},
+		},
+	})
+}
@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitSparseCheckout{}
+var _ resource.ResourceWithValidateConfig = &GitSparseCheckout{}
+var _ resource.ResourceWithImportState = &GitSparseCheckout{}
+
+func NewGitSparseCheckout() resource.Resource {
+	return &GitSparseCheckout{}
+}
+
+// GitSparseCheckout defines the resource implementation.
+type GitSparseCheckout struct {
+	provider *GitProviderData
+}
+
+// GitSparseCheckoutModel describes the resource data model.
+type GitSparseCheckoutModel struct {
+	Id        types.String   `tfsdk:"id"`
+	Path      types.String   `tfsdk:"path"`
+	Serialize types.Bool     `tfsdk:"serialize"`
+	Cone      types.Bool     `tfsdk:"cone"`
+	Patterns  []types.String `tfsdk:"patterns"`
+}
+
+func (r *GitSparseCheckout) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sparse_checkout"
+}
+
+func (r *GitSparseCheckout) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Sparse Checkout resource, restricts the working tree of a repository to a subset of " +
+			"its files like `git sparse-checkout set` does. Files left out are marked skip-worktree in the index and " +
+			"removed from the working tree, unless they have local changes. The working tree is checked on every plan " +
+			"and the patterns applied again when it no longer matches them. Destroying the resource checks out every " +
+			"file again like `git sparse-checkout disable`. Existing sparse checkouts can be imported with their `path`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"cone": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not `patterns` are directories checked out recursively, along with the " +
+					"files at the root and directly in their parent directories, otherwise they are gitignore style " +
+					"patterns of the files to check out (default: true)",
+				Optional: true,
+			},
+			"patterns": schema.ListAttribute{
+				MarkdownDescription: "Directories, or patterns when `cone` is false, to check out (ie. `services/api`)",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *GitSparseCheckout) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitSparseCheckout) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitSparseCheckoutModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Cone.IsUnknown() || (!data.Cone.IsNull() && !data.Cone.ValueBool()) {
+		return
+	}
+
+	for i, pattern := range data.Patterns {
+		if pattern.IsUnknown() {
+			continue
+		}
+		if dir := pattern.ValueString(); strings.HasPrefix(dir, "!") || strings.ContainsAny(dir, "*?[\\") {
+			resp.Diagnostics.AddAttributeError(path.Root("patterns").AtListIndex(i), "invalid patterns",
+				fmt.Sprintf("%q must be a directory in cone mode, set cone to false to use patterns", dir))
+		}
+	}
+}
+
+func (r *GitSparseCheckout) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitSparseCheckoutModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(repoPath)
+
+	repo, err := r.provider.openRepository(repoPath)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+	if cfg.Raw.Section("core").Option("sparseCheckout") == "true" {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "sparse checkout already enabled",
+			fmt.Sprintf("%s already has a sparse checkout, import it to manage it", repoPath))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, repo, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitSparseCheckout) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitSparseCheckoutModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+
+	file, err := sparseCheckoutFile(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to determine git directory", err.Error())
+		return
+	}
+
+	content, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) || cfg.Raw.Section("core").Option("sparseCheckout") != "true" {
+		tflog.Warn(ctx, fmt.Sprintf("sparse checkout of %s is no longer enabled, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read sparse-checkout file", err.Error())
+		return
+	}
+
+	data.Path = data.Id
+
+	cone := cfg.Raw.Section("core").Option("sparseCheckoutCone") == "true"
+	if !data.Cone.IsNull() || !cone {
+		data.Cone = types.BoolValue(cone)
+	}
+
+	// Patterns are only read back when they changed, to keep them as written.
+	if gitutils.SparseCheckoutFile(cone, toStrings(data.Patterns)) != string(content) {
+		data.Patterns = []types.String{}
+		for _, pattern := range gitutils.ParseSparseCheckoutFile(cone, string(content)) {
+			data.Patterns = append(data.Patterns, types.StringValue(pattern))
+		}
+	}
+
+	applied, err := gitutils.SparseCheckoutApplied(repo, gitutils.SparseCheckoutMatcher(string(content)))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to check working tree", err.Error())
+		return
+	}
+
+	// A working tree no longer matching the patterns is planned to have them
+	// applied again.
+	if !applied {
+		tflog.Warn(ctx, fmt.Sprintf("working tree of %s no longer matches its sparse checkout", data.Id.ValueString()))
+		data.Patterns = []types.String{}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitSparseCheckout) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitSparseCheckoutModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	resp.Diagnostics.Append(r.apply(ctx, repo, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitSparseCheckout) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitSparseCheckoutModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	// Every file is checked out again before sparse checkout is disabled.
+	if err := gitutils.ApplySparseCheckout(repo, func(string) bool { return true }); err != nil {
+		resp.Diagnostics.AddError("unable to update working tree", err.Error())
+		return
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+	cfg.Raw.Section("core").RemoveOption("sparseCheckout")
+	cfg.Raw.Section("core").RemoveOption("sparseCheckoutCone")
+	if err := repo.SetConfig(cfg); err != nil {
+		resp.Diagnostics.AddError("unable to write git config", err.Error())
+		return
+	}
+
+	file, err := sparseCheckoutFile(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to determine git directory", err.Error())
+		return
+	}
+	if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+		resp.Diagnostics.AddError("unable to remove sparse-checkout file", err.Error())
+		return
+	}
+}
+
+func (r *GitSparseCheckout) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the sparse-checkout file and config of data, and updates the
+// working tree to match.
+func (r *GitSparseCheckout) apply(ctx context.Context, repo *git.Repository, data *GitSparseCheckoutModel) (diags diag.Diagnostics) {
+	cone := data.Cone.IsNull() || data.Cone.ValueBool()
+	content := gitutils.SparseCheckoutFile(cone, toStrings(data.Patterns))
+
+	file, err := sparseCheckoutFile(repo)
+	if err != nil {
+		diags.AddError("unable to determine git directory", err.Error())
+		return diags
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		diags.AddError("unable to write sparse-checkout file", err.Error())
+		return diags
+	}
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		diags.AddError("unable to write sparse-checkout file", err.Error())
+		return diags
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		diags.AddError("unable to read git config", err.Error())
+		return diags
+	}
+	cfg.Raw.Section("core").SetOption("sparseCheckout", "true")
+	cfg.Raw.Section("core").SetOption("sparseCheckoutCone", fmt.Sprintf("%t", cone))
+	if err := repo.SetConfig(cfg); err != nil {
+		diags.AddError("unable to write git config", err.Error())
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("applying sparse checkout of %s", data.Id.ValueString()))
+
+	if err := gitutils.ApplySparseCheckout(repo, gitutils.SparseCheckoutMatcher(content)); err != nil {
+		diags.AddError("unable to update working tree", err.Error())
+		return diags
+	}
+
+	return diags
+}
+
+// sparseCheckoutFile returns the path of the sparse-checkout file of repo.
+func sparseCheckoutFile(repo *git.Repository) (string, error) {
+	gitDir, err := gitutils.GitDir(repo)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(gitDir, "info", "sparse-checkout"), nil
+}
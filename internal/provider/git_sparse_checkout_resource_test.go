@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitSparseCheckoutResourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+resource "git_sparse_checkout" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitSparseCheckoutResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	files := map[string]string{
+		"services/api/main.go":   "package main\n",
+		"services/web/index.js":  "// web\n",
+		"docs/guide/README.md":   "# Guide\n",
+		"tools/release.sh":       "#!/bin/sh\n",
+		"services/docker-bake.h": "# bake\n",
+	}
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tempDir, name)), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+	}
+	_, err = testCommitAll(tempDir, "add services")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			for name, content := range files {
+				if err := testCheckWorktreeFile(tempDir, name, content)(s); err != nil {
+					return err
+				}
+			}
+			if _, err := os.Stat(filepath.Join(tempDir, ".git", "info", "sparse-checkout")); !os.IsNotExist(err) {
+				return fmt.Errorf("expected the sparse-checkout file to be removed")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitSparseCheckoutResourceConfig(tempDir, `patterns = ["services/*"]`),
+				ExpectError: regexp.MustCompile("invalid patterns"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitSparseCheckoutResourceConfig(tempDir, `patterns = ["services/api"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_sparse_checkout.test", "id", tempDir),
+					testCheckWorktreeFile(tempDir, "README.md", "testing"),
+					testCheckWorktreeFile(tempDir, "services/api/main.go", "package main\n"),
+					testCheckWorktreeFile(tempDir, "services/docker-bake.h", "# bake\n"),
+					testCheckWorktreeFile(tempDir, "services/web/index.js", ""),
+					testCheckWorktreeFile(tempDir, "tools/release.sh", ""),
+					func(s *terraform.State) error {
+						if _, err := os.Stat(filepath.Join(tempDir, "docs")); !os.IsNotExist(err) {
+							return fmt.Errorf("expected docs to be removed")
+						}
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitSparseCheckoutResourceConfig(tempDir, `
+  cone     = false
+  patterns = ["/docs/", "*.sh"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckWorktreeFile(tempDir, "README.md", ""),
+					testCheckWorktreeFile(tempDir, "docs/guide/README.md", "# Guide\n"),
+					testCheckWorktreeFile(tempDir, "tools/release.sh", "#!/bin/sh\n"),
+					testCheckWorktreeFile(tempDir, "services/api/main.go", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "git_sparse_checkout.test",
+				ImportState:       true,
+				ImportStateId:     tempDir,
+				ImportStateVerify: true,
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					assert.NoError(t, os.RemoveAll(filepath.Join(tempDir, "docs")))
+					assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("testing"), 0644))
+				},
+				Config: testAccGitSparseCheckoutResourceConfig(tempDir, `
+  cone     = false
+  patterns = ["/docs/", "*.sh"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckWorktreeFile(tempDir, "README.md", ""),
+					testCheckWorktreeFile(tempDir, "docs/guide/README.md", "# Guide\n"),
+				),
+			},
+		},
+	})
+}
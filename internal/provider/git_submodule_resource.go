@@ -0,0 +1,530 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitSubmoduleResource{}
+var _ resource.ResourceWithValidateConfig = &GitSubmoduleResource{}
+
+func NewGitSubmoduleResource() resource.Resource {
+	return &GitSubmoduleResource{}
+}
+
+// GitSubmoduleResource defines the resource implementation.
+type GitSubmoduleResource struct {
+	provider *GitProviderData
+}
+
+// GitSubmoduleResourceModel describes the resource data model.
+type GitSubmoduleResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Path                 types.String `tfsdk:"path"`
+	Serialize            types.Bool   `tfsdk:"serialize"`
+	Branch               types.String `tfsdk:"branch"`
+	SubmodulePath        types.String `tfsdk:"submodule_path"`
+	Name                 types.String `tfsdk:"name"`
+	URL                  types.String `tfsdk:"url"`
+	SubmoduleBranch      types.String `tfsdk:"submodule_branch"`
+	Commit               types.String `tfsdk:"commit"`
+	CommitMessage        types.String `tfsdk:"commit_message"`
+	AuthorName           types.String `tfsdk:"author_name"`
+	AuthorEmail          types.String `tfsdk:"author_email"`
+	CommitterName        types.String `tfsdk:"committer_name"`
+	CommitterEmail       types.String `tfsdk:"committer_email"`
+	Sign                 types.Bool   `tfsdk:"sign"`
+	SigningKey           types.String `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String `tfsdk:"signing_key_passphrase"`
+	Remote               types.String `tfsdk:"remote"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	SuperprojectCommit   types.String `tfsdk:"superproject_commit"`
+	RefUpdates           types.List   `tfsdk:"ref_updates"`
+}
+
+func (r *GitSubmoduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submodule"
+}
+
+func (r *GitSubmoduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Submodule resource, adds a submodule pinned to a commit to a branch of the superproject " +
+			"like `git submodule add`, committing the gitlink and its `.gitmodules` entry together. Changing the commit " +
+			"commits the new pin, destroying the resource commits the removal of the submodule and deinitializes it from " +
+			"the git config. The submodule itself is never cloned, run `git submodule update --init` to check it out. " +
+			"Commits are created like the `git_file` resource does, including keeping the branch in sync with `remote`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the superproject to commit to, which must exist locally or on `remote`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"submodule_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the submodule in the superproject (ie. `vendor/lib`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the submodule in `.gitmodules`, defaults to `submodule_path`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the submodule repository",
+				Required:            true,
+			},
+			"submodule_branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the submodule repository recorded in `.gitmodules` for `git submodule update --remote`",
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Full hash of the commit of the submodule repository to pin",
+				Required:            true,
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the commits (default: `Add submodule <submodule_path>`, `Update submodule " +
+					"<submodule_path>` or `Remove submodule <submodule_path>`)",
+				Optional: true,
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the author of the commits, and committer unless `committer_name` is set, defaults to `user.name` of the git config",
+				Optional:            true,
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the author of the commits, and committer unless `committer_email` is set, defaults to `user.email` of the git config",
+				Optional:            true,
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the committer of the commits, defaults to the author",
+				Optional:            true,
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the committer of the commits, defaults to the author",
+				Optional:            true,
+			},
+			"sign": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to sign the commits, false disables signing even when the provider sets a " +
+					"`signing_key`, true requires a signing key (default: signed when a signing key is set)",
+				Optional: true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key to sign the commits with, overriding the provider `signing_key`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote to keep the branch in sync with (ie. `origin`), by default commits stay local",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP(S) basic authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for HTTP(S) basic authentication",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"superproject_commit": schema.StringAttribute{
+				MarkdownDescription: "Commit of the superproject that last wrote the submodule",
+				Computed:            true,
+			},
+			"ref_updates": refUpdatesAttribute(),
+		},
+	}
+}
+
+func (r *GitSubmoduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitSubmoduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GitSubmoduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SubmodulePath.IsUnknown() {
+		if err := gitutils.ValidTreePath(data.SubmodulePath.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("submodule_path"), "invalid submodule_path", err.Error())
+		}
+	}
+
+	if !data.Commit.IsUnknown() {
+		if commit := data.Commit.ValueString(); !plumbing.IsHash(commit) || plumbing.NewHash(commit).IsZero() {
+			resp.Diagnostics.AddAttributeError(path.Root("commit"), "invalid commit",
+				fmt.Sprintf("%q must be the full hash of a commit", commit))
+		}
+	}
+}
+
+func (r *GitSubmoduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitSubmoduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", repoPath, data.Branch.ValueString(), data.SubmodulePath.ValueString()))
+
+	if data.Name.ValueString() == "" {
+		data.Name = data.SubmodulePath
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitSubmoduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitSubmoduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	refName := plumbing.NewBranchReferenceName(data.Branch.ValueString())
+	if data.Remote.ValueString() != "" {
+		refName, err = fetchBranch(ctx, repo, data.Remote.ValueString(), data.Branch.ValueString(), r.provider.repositoryAuth(data.Path, data.Username, data.Password))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to fetch", err.Error())
+			return
+		}
+	}
+
+	var entry *object.TreeEntry
+	tip, err := branchTip(repo, refName)
+	if err == nil && tip != nil {
+		entry, err = commitTreeEntry(tip, data.SubmodulePath.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read submodule", err.Error())
+		return
+	}
+	if entry == nil || entry.Mode != filemode.Submodule {
+		tflog.Warn(ctx, fmt.Sprintf("submodule %s no longer exists on %s, removing it from state", data.SubmodulePath.ValueString(), refName.Short()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Commit = types.StringValue(entry.Hash.String())
+
+	content, err := commitGitmodules(tip)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read .gitmodules", err.Error())
+		return
+	}
+
+	modules := config.NewModules()
+	if err := modules.Unmarshal(content); err != nil {
+		resp.Diagnostics.AddError("unable to parse .gitmodules", err.Error())
+		return
+	}
+
+	// A missing or moved entry is written again by the next apply.
+	submodule, ok := modules.Submodules[data.Name.ValueString()]
+	if !ok || submodule.Path != data.SubmodulePath.ValueString() {
+		submodule = &config.Submodule{}
+	}
+	data.URL = types.StringValue(submodule.URL)
+	if submodule.Branch != data.SubmoduleBranch.ValueString() {
+		data.SubmoduleBranch = types.StringValue(submodule.Branch)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitSubmoduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitSubmoduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitSubmoduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitSubmoduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := r.branchCommit(&data)
+	opts.Message = fileCommitMessage(data.CommitMessage, "Remove submodule", data.SubmodulePath.ValueString())
+
+	repo, diags := r.provider.openBranchCommit(data.Path.ValueString(), opts, r.identity(&data))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	_, _, err = commitBranch(ctx, repo, opts, func(parent *object.Commit) (map[string]*gitutils.TreeChange, error) {
+		changes := map[string]*gitutils.TreeChange{}
+
+		entry, err := commitTreeEntry(parent, data.SubmodulePath.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil && entry.Mode == filemode.Submodule {
+			changes[data.SubmodulePath.ValueString()] = &gitutils.TreeChange{}
+		}
+
+		content, err := commitGitmodules(parent)
+		if err != nil {
+			return nil, err
+		}
+		if content != nil {
+			content, err = gitutils.RemoveGitmodule(content, data.Name.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("unable to update .gitmodules: %v", err)
+			}
+			changes[".gitmodules"] = &gitutils.TreeChange{Content: content}
+		}
+
+		return changes, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to commit removal", err.Error())
+		return
+	}
+
+	// Deinitialize the submodule, like `git submodule deinit` does.
+	cfg, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read git config", err.Error())
+		return
+	}
+	if _, ok := cfg.Submodules[data.Name.ValueString()]; ok {
+		delete(cfg.Submodules, data.Name.ValueString())
+		if err := repo.Storer.SetConfig(cfg); err != nil {
+			resp.Diagnostics.AddError("unable to deinitialize submodule", err.Error())
+			return
+		}
+	}
+}
+
+// write commits the submodule described by data, setting the superproject
+// commit that wrote it.
+func (r *GitSubmoduleResource) write(ctx context.Context, data *GitSubmoduleResourceModel, create bool) (diags diag.Diagnostics) {
+	opts := r.branchCommit(data)
+
+	repo, openDiags := r.provider.openBranchCommit(data.Path.ValueString(), opts, r.identity(data))
+	diags.Append(openDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		diags.AddError("unable to lock repository", err.Error())
+		return diags
+	}
+	defer unlock()
+
+	submodulePath := data.SubmodulePath.ValueString()
+	commit, updates, err := commitBranch(ctx, repo, opts, func(parent *object.Commit) (map[string]*gitutils.TreeChange, error) {
+		opts.Message = fileCommitMessage(data.CommitMessage, "Add submodule", submodulePath)
+
+		entry, err := commitTreeEntry(parent, submodulePath)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			if create || entry.Mode != filemode.Submodule {
+				return nil, fmt.Errorf("%s already exists on %s", submodulePath, data.Branch.ValueString())
+			}
+			opts.Message = fileCommitMessage(data.CommitMessage, "Update submodule", submodulePath)
+		}
+
+		content, err := commitGitmodules(parent)
+		if err != nil {
+			return nil, err
+		}
+		content, err = gitutils.SetGitmodule(content, data.Name.ValueString(), submodulePath, data.URL.ValueString(), data.SubmoduleBranch.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("unable to update .gitmodules: %v", err)
+		}
+
+		return map[string]*gitutils.TreeChange{
+			".gitmodules": {Content: content},
+			submodulePath: {Submodule: plumbing.NewHash(data.Commit.ValueString())},
+		}, nil
+	})
+	if err != nil {
+		diags.AddError("unable to commit submodule", err.Error())
+		return diags
+	}
+
+	data.SuperprojectCommit = types.StringValue(commit.Hash.String())
+	data.RefUpdates = refUpdatesValue(updates)
+
+	return diags
+}
+
+// identity returns the identity configuration of the commits to create for
+// data.
+func (r *GitSubmoduleResource) identity(data *GitSubmoduleResourceModel) commitIdentityConfig {
+	return commitIdentityConfig{
+		AuthorName:           data.AuthorName,
+		AuthorEmail:          data.AuthorEmail,
+		CommitterName:        data.CommitterName,
+		CommitterEmail:       data.CommitterEmail,
+		Sign:                 data.Sign,
+		SigningKey:           data.SigningKey,
+		SigningKeyPassphrase: data.SigningKeyPassphrase,
+	}
+}
+
+// branchCommit returns the options of the commits to create for data.
+func (r *GitSubmoduleResource) branchCommit(data *GitSubmoduleResourceModel) *branchCommit {
+	return &branchCommit{
+		Branch: data.Branch.ValueString(),
+		Remote: data.Remote.ValueString(),
+		Auth:   r.provider.repositoryAuth(data.Path, data.Username, data.Password),
+	}
+}
+
+// commitGitmodules returns the content of `.gitmodules` in commit, nil when
+// there is none.
+func commitGitmodules(commit *object.Commit) ([]byte, error) {
+	file, err := commit.File(".gitmodules")
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+func testAccGitSubmoduleResourceConfig(path string, submodulePath string, commit string, options string) string {
+	return fmt.Sprintf(`
+resource "git_submodule" "test" {
+  path           = %[1]q
+  branch         = "master"
+  submodule_path = %[2]q
+  url            = "https://example.com/lib.git"
+  commit         = %[3]q
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+  %[4]s
+}
+`, path, submodulePath, commit, options)
+}
+
+func TestAccGitSubmoduleResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	superDir := filepath.Join(tempDir, "super")
+	libDir := filepath.Join(tempDir, "lib")
+
+	_, err = testSetupGit(superDir, "", 0)
+	assert.NoError(t, err)
+	first, err := testSetupGit(libDir, "", 0)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(libDir, "lib.go"), []byte("package lib\n"), 0644))
+	second, err := testCommitAll(libDir, "add lib")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testCheckSubmoduleCommit(superDir, "master", "vendor/lib", ""),
+			testCheckBranchFile(superDir, "master", ".gitmodules", ""),
+			testCheckCommitMessage(superDir, "master", "Remove submodule vendor/lib"),
+			func(s *terraform.State) error {
+				repo, err := git.PlainOpen(superDir)
+				if err != nil {
+					return err
+				}
+				cfg, err := repo.Config()
+				if err != nil {
+					return err
+				}
+				if _, ok := cfg.Submodules["vendor/lib"]; ok {
+					return fmt.Errorf("expected the submodule to be deinitialized")
+				}
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitSubmoduleResourceConfig(superDir, "vendor/lib", "main", ""),
+				ExpectError: regexp.MustCompile("invalid commit"),
+			},
+			{
+				Config:      testAccGitSubmoduleResourceConfig(superDir, "README.md", first.String(), ""),
+				ExpectError: regexp.MustCompile("README.md already exists on master"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitSubmoduleResourceConfig(superDir, "vendor/lib", first.String(), ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_submodule.test", "name", "vendor/lib"),
+					resource.TestCheckResourceAttrSet("git_submodule.test", "superproject_commit"),
+					testCheckSubmoduleCommit(superDir, "master", "vendor/lib", first.String()),
+					testCheckBranchFile(superDir, "master", ".gitmodules",
+						"[submodule \"vendor/lib\"]\n\tpath = vendor/lib\n\turl = https://example.com/lib.git\n"),
+					testCheckCommitMessage(superDir, "master", "Add submodule vendor/lib"),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					// Initialize the submodule like `git submodule init` does.
+					repo, err := git.PlainOpen(superDir)
+					assert.NoError(t, err)
+					cfg, err := repo.Config()
+					assert.NoError(t, err)
+					cfg.Submodules["vendor/lib"] = &config.Submodule{Name: "vendor/lib", URL: "https://example.com/lib.git"}
+					assert.NoError(t, repo.Storer.SetConfig(cfg))
+				},
+				Config: testAccGitSubmoduleResourceConfig(superDir, "vendor/lib", second.String(), `submodule_branch = "main"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckSubmoduleCommit(superDir, "master", "vendor/lib", second.String()),
+					testCheckBranchFile(superDir, "master", ".gitmodules",
+						"[submodule \"vendor/lib\"]\n\tpath = vendor/lib\n\turl = https://example.com/lib.git\n\tbranch = main\n"),
+					testCheckCommitMessage(superDir, "master", "Update submodule vendor/lib"),
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					repo, err := git.PlainOpen(superDir)
+					assert.NoError(t, err)
+					sig := &object.Signature{Name: "Manual", Email: "manual@example.com", When: time.Now()}
+					opts := &branchCommit{
+						Branch:   "master",
+						Message:  "manual change",
+						Identity: &commitIdentity{Author: sig, Committer: sig},
+					}
+					_, _, err = commitBranch(context.Background(), repo, opts, func(*object.Commit) (map[string]*gitutils.TreeChange, error) {
+						gitmodules := "[submodule \"vendor/lib\"]\n\tpath = vendor/lib\n\turl = https://example.com/other.git\n"
+						return map[string]*gitutils.TreeChange{".gitmodules": {Content: []byte(gitmodules)}}, nil
+					})
+					assert.NoError(t, err)
+				},
+				Config: testAccGitSubmoduleResourceConfig(superDir, "vendor/lib", second.String(), `submodule_branch = "main"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckSubmoduleCommit(superDir, "master", "vendor/lib", second.String()),
+					testCheckBranchFile(superDir, "master", ".gitmodules",
+						"[submodule \"vendor/lib\"]\n\tpath = vendor/lib\n\turl = https://example.com/lib.git\n\tbranch = main\n"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckSubmoduleCommit checks the commit the submodule at submodulePath
+// is pinned to on branch, an empty commit checking there is no submodule.
+func testCheckSubmoduleCommit(path string, branch string, submodulePath string, commit string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, err := git.PlainOpen(path)
+		if err != nil {
+			return err
+		}
+
+		tip, err := resolveCommit(repo, branch)
+		if err != nil {
+			return err
+		}
+
+		tree, err := tip.Tree()
+		if err != nil {
+			return err
+		}
+
+		entry, err := tree.FindEntry(submodulePath)
+		if (err == object.ErrEntryNotFound || err == object.ErrDirectoryNotFound) && commit == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if entry.Mode != filemode.Submodule {
+			return fmt.Errorf("expected %s on %s to be a submodule, got mode %s", submodulePath, branch, entry.Mode)
+		}
+		if entry.Hash.String() != commit {
+			return fmt.Errorf("expected %s on %s to be pinned to %s, got %s", submodulePath, branch, commit, entry.Hash)
+		}
+
+		return nil
+	}
+}
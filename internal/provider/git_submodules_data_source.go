@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitSubmodules{}
+
+func NewGitSubmodules() datasource.DataSource {
+	return &GitSubmodules{}
+}
+
+// GitSubmodules defines the data source implementation.
+type GitSubmodules struct {
+	provider *GitProviderData
+}
+
+// GitSubmodulesModel describes the data source data model.
+type GitSubmodulesModel struct {
+	Id         types.String        `tfsdk:"id"`
+	Path       types.String        `tfsdk:"path"`
+	InSync     types.Bool          `tfsdk:"in_sync"`
+	IsDirty    types.Bool          `tfsdk:"is_dirty"`
+	Submodules []GitSubmoduleModel `tfsdk:"submodules"`
+}
+
+// GitSubmoduleModel describes the status of a single submodule.
+type GitSubmoduleModel struct {
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	URL            types.String `tfsdk:"url"`
+	Branch         types.String `tfsdk:"branch"`
+	ExpectedCommit types.String `tfsdk:"expected_commit"`
+	CurrentCommit  types.String `tfsdk:"current_commit"`
+	IsInitialized  types.Bool   `tfsdk:"is_initialized"`
+	InSync         types.Bool   `tfsdk:"in_sync"`
+	IsDirty        types.Bool   `tfsdk:"is_dirty"`
+}
+
+func (d *GitSubmodules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submodules"
+}
+
+func (d *GitSubmodules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Submodules data source, reports whether each submodule matches the commit recorded by the superproject",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"in_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not every submodule is checked out at the commit recorded by the superproject",
+				Computed:            true,
+			},
+			"is_dirty": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not any submodule worktree is in a dirty state",
+				Computed:            true,
+			},
+			"submodules": schema.ListNestedAttribute{
+				MarkdownDescription: "Submodules declared in `.gitmodules`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the submodule",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the submodule relative to the superproject",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the submodule",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "Branch configured for the submodule",
+							Computed:            true,
+						},
+						"expected_commit": schema.StringAttribute{
+							MarkdownDescription: "Commit recorded by the superproject (gitlink)",
+							Computed:            true,
+						},
+						"current_commit": schema.StringAttribute{
+							MarkdownDescription: "Commit currently checked out in the submodule, empty when not checked out",
+							Computed:            true,
+						},
+						"is_initialized": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the submodule is checked out",
+							Computed:            true,
+						},
+						"in_sync": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the checked out commit matches the recorded commit",
+							Computed:            true,
+						},
+						"is_dirty": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the submodule worktree is in a dirty state",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitSubmodules) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitSubmodules) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitSubmodulesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	submodules, err := readSubmodules(repo, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read submodules", err.Error())
+		return
+	}
+
+	inSync := true
+	dirty := false
+	for _, s := range submodules {
+		tflog.Trace(ctx, fmt.Sprintf("submodule: %s expected: %s current: %s", s.Path.ValueString(), s.ExpectedCommit.ValueString(), s.CurrentCommit.ValueString()))
+
+		inSync = inSync && s.InSync.ValueBool()
+		dirty = dirty || s.IsDirty.ValueBool()
+	}
+
+	data.Id = types.StringValue(data.Path.ValueString())
+	data.InSync = types.BoolValue(inSync)
+	data.IsDirty = types.BoolValue(dirty)
+	data.Submodules = submodules
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readSubmodules compares every submodule declared in .gitmodules against the
+// gitlink recorded in the superproject index. Submodules are opened directly
+// from their checkout rather than through go-git, which would initialize a
+// missing submodule as a side effect.
+func readSubmodules(repo *git.Repository, path string) ([]GitSubmoduleModel, error) {
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	submodules, err := worktree.Submodules()
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []GitSubmoduleModel{}
+	for _, submodule := range submodules {
+		cfg := submodule.Config()
+
+		result := GitSubmoduleModel{
+			Name:           types.StringValue(cfg.Name),
+			Path:           types.StringValue(cfg.Path),
+			URL:            types.StringValue(cfg.URL),
+			Branch:         types.StringValue(cfg.Branch),
+			ExpectedCommit: types.StringValue(""),
+			CurrentCommit:  types.StringValue(""),
+			IsInitialized:  types.BoolValue(false),
+			InSync:         types.BoolValue(false),
+			IsDirty:        types.BoolValue(false),
+		}
+
+		entry, err := idx.Entry(cfg.Path)
+		if err != nil && err != index.ErrEntryNotFound {
+			return nil, err
+		}
+		if entry != nil {
+			result.ExpectedCommit = types.StringValue(entry.Hash.String())
+		}
+
+		subRepo, err := openRepository(filepath.Join(path, cfg.Path))
+		if err == nil {
+			head, err := subRepo.Head()
+			if err != nil {
+				return nil, fmt.Errorf("unable to read head of submodule %s: %v", cfg.Path, err)
+			}
+
+			subWorktree, err := subRepo.Worktree()
+			if err != nil {
+				return nil, err
+			}
+
+			status, err := subWorktree.Status()
+			if err != nil {
+				return nil, fmt.Errorf("unable to get worktree status of submodule %s: %v", cfg.Path, err)
+			}
+
+			result.CurrentCommit = types.StringValue(head.Hash().String())
+			result.IsInitialized = types.BoolValue(true)
+			result.InSync = types.BoolValue(entry != nil && entry.Hash == head.Hash())
+			result.IsDirty = types.BoolValue(!status.IsClean())
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitSubmodulesDataSourceConfig(path string) string {
+	return fmt.Sprintf(`
+data "git_submodules" "test" {
+  path = %[1]q
+}
+`, path)
+}
+
+func TestAccGitSubmodulesDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	subHash, err := testSetupSubmodule(tempDir, "modules/sub", nil)
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitSubmodulesDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_submodules.test", "in_sync", "true"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "is_dirty", "false"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.#", "1"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.path", "modules/sub"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.expected_commit", subHash.String()),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.current_commit", subHash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitSubmodulesDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	recorded := plumbing.NewHash("0123456789012345678901234567890123456789")
+	subHash, err := testSetupSubmodule(tempDir, "modules/sub", &recorded)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "modules/sub", "README.md"), []byte("dirty"), 0644))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitSubmodulesDataSourceConfig(tempDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_submodules.test", "in_sync", "false"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "is_dirty", "true"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.expected_commit", recorded.String()),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.current_commit", subHash.String()),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.in_sync", "false"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.0.is_dirty", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testSetupSubmodule creates a repository at subPath inside the superproject
+// and records it as a submodule. When recorded is nil the gitlink points at the
+// submodule head.
+func testSetupSubmodule(path string, subPath string, recorded *plumbing.Hash) (*plumbing.Hash, error) {
+	hash, err := testSetupGit(filepath.Join(path, subPath), "", 0)
+	if err != nil {
+		return nil, err
+	}
+	if recorded == nil {
+		recorded = hash
+	}
+
+	gitmodules := fmt.Sprintf("[submodule %[1]q]\n\tpath = %[1]s\n\turl = https://example.com/%[1]s.git\n", subPath)
+	if err := os.WriteFile(filepath.Join(path, ".gitmodules"), []byte(gitmodules), 0644); err != nil {
+		return nil, err
+	}
+
+	repo, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := wt.Add(".gitmodules"); err != nil {
+		return nil, err
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := idx.Add(subPath)
+	entry.Mode = filemode.Submodule
+	entry.Hash = *recorded
+
+	if err := repo.Storer.SetIndex(idx); err != nil {
+		return nil, err
+	}
+
+	if _, err := wt.Commit("add submodule", &git.CommitOptions{}); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
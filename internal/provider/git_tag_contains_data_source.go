@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitTagContains{}
+
+func NewGitTagContains() datasource.DataSource {
+	return &GitTagContains{}
+}
+
+// GitTagContains defines the data source implementation.
+type GitTagContains struct {
+	provider *GitProviderData
+}
+
+// GitTagContainsModel describes the data source data model.
+type GitTagContainsModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Path       types.String   `tfsdk:"path"`
+	Reference  types.String   `tfsdk:"ref"`
+	SemverOnly types.Bool     `tfsdk:"semver_only"`
+	Commit     types.String   `tfsdk:"commit"`
+	Tags       []types.String `tfsdk:"tags"`
+	FirstTag   types.String   `tfsdk:"first_tag"`
+}
+
+func (d *GitTagContains) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_contains"
+}
+
+func (d *GitTagContains) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Tag Contains data source, lists the tags containing a commit like `git tag --contains`, " +
+			"ie. to find the release that first shipped it",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Commit to look for, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"semver_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to only consider tags that are semantic versions (ie. `v1.2.0`) (default: false)",
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags containing the commit, semantic versions first in version order, then the other tags by name",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"first_tag": schema.StringAttribute{
+				MarkdownDescription: "Lowest semantic version tag containing the commit, empty when there is none",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitTagContains) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitTagContains) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitTagContainsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	type containingTag struct {
+		name    string
+		version *semver.Version
+	}
+
+	var tags []containingTag
+	iter, err := repo.Tags()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list tags", err.Error())
+		return
+	}
+
+	if err := iter.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+
+		version, err := semver.NewVersion(name)
+		if err != nil {
+			version = nil
+		}
+		if version == nil && data.SemverOnly.ValueBool() {
+			return nil
+		}
+
+		target, err := tagCommit(repo, ref)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return nil
+		}
+
+		contains, err := commit.IsAncestor(target)
+		if err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("tag: %s commit: %s contains: %t", name, target.Hash.String(), contains))
+
+		if contains {
+			tags = append(tags, containingTag{name: name, version: version})
+		}
+
+		return nil
+	}); err != nil {
+		resp.Diagnostics.AddError("unable to check tags", err.Error())
+		return
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		a, b := tags[i], tags[j]
+		switch {
+		case a.version != nil && b.version != nil && !a.version.Equal(b.version):
+			return a.version.LessThan(b.version)
+		case a.version != nil && b.version == nil:
+			return true
+		case a.version == nil && b.version != nil:
+			return false
+		}
+		return a.name < b.name
+	})
+
+	data.Tags = []types.String{}
+	data.FirstTag = types.StringValue("")
+	for _, tag := range tags {
+		if tag.version != nil && data.FirstTag.ValueString() == "" {
+			data.FirstTag = types.StringValue(tag.name)
+		}
+		data.Tags = append(data.Tags, types.StringValue(tag.name))
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), commit.Hash.String()))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// tagCommit returns the commit the tag ref points at, peeling annotated tags,
+// nil when the tag points at another kind of object.
+func tagCommit(repo *git.Repository, ref *plumbing.Reference) (*object.Commit, error) {
+	hash := ref.Hash()
+
+	tag, err := repo.TagObject(hash)
+	switch err {
+	case nil:
+		if tag.TargetType != plumbing.CommitObject {
+			return nil, nil
+		}
+		hash = tag.Target
+	case plumbing.ErrObjectNotFound:
+	default:
+		return nil, err
+	}
+
+	commit, err := repo.CommitObject(hash)
+	if err == plumbing.ErrObjectNotFound || err == object.ErrUnsupportedObject {
+		return nil, nil
+	}
+	return commit, err
+}
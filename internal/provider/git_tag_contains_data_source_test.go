@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitTagContainsDataSourceConfig(path string, ref string, options string) string {
+	return fmt.Sprintf(`
+data "git_tag_contains" "test" {
+  path = %[1]q
+  ref  = %[2]q
+  %[3]s
+}
+`, path, ref, options)
+}
+
+func TestAccGitTagContainsDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "fix.txt"), []byte("fix"), 0644))
+	fix, err := testCommitAll(tempDir, "fix")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("nightly", *fix, nil)
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v1.10.0", *fix, &git.CreateTagOptions{Message: "v1.10.0"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.txt"), []byte("feature"), 0644))
+	feature, err := testCommitAll(tempDir, "feature")
+	assert.NoError(t, err)
+	_, err = repo.CreateTag("v1.9.0", *feature, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "unreleased.txt"), []byte("unreleased"), 0644))
+	head, err := testCommitAll(tempDir, "unreleased")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitTagContainsDataSourceConfig(tempDir, fix.String(), ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "commit", fix.String()),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.#", "3"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.0", "v1.9.0"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.1", "v1.10.0"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.2", "nightly"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "first_tag", "v1.9.0"),
+				),
+			},
+			{
+				Config: testAccGitTagContainsDataSourceConfig(tempDir, fix.String(), "semver_only = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "first_tag", "v1.9.0"),
+				),
+			},
+			{
+				Config: testAccGitTagContainsDataSourceConfig(tempDir, "v1.0.0", "semver_only = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.#", "3"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "first_tag", "v1.0.0"),
+				),
+			},
+			{
+				Config: testAccGitTagContainsDataSourceConfig(tempDir, head.String(), ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("data.git_tag_contains.test", "first_tag", ""),
+				),
+			},
+		},
+	})
+}
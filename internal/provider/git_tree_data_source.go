@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitTree{}
+
+func NewGitTree() datasource.DataSource {
+	return &GitTree{}
+}
+
+// GitTree defines the data source implementation.
+type GitTree struct {
+	provider *GitProviderData
+}
+
+// GitTreeModel describes the data source data model.
+type GitTreeModel struct {
+	Id           types.String        `tfsdk:"id"`
+	Path         types.String        `tfsdk:"path"`
+	Reference    types.String        `tfsdk:"ref"`
+	Directory    types.String        `tfsdk:"directory"`
+	Recursive    types.Bool          `tfsdk:"recursive"`
+	ExportIgnore types.Bool          `tfsdk:"export_ignore"`
+	Commit       types.String        `tfsdk:"commit"`
+	Entries      []GitTreeEntryModel `tfsdk:"entries"`
+}
+
+// GitTreeEntryModel describes a single entry of a tree.
+type GitTreeEntryModel struct {
+	Name types.String `tfsdk:"name"`
+	Path types.String `tfsdk:"path"`
+	Type types.String `tfsdk:"type"`
+	Mode types.String `tfsdk:"mode"`
+	Hash types.String `tfsdk:"hash"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+func (d *GitTree) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tree"
+}
+
+func (d *GitTree) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Tree data source, lists the files and directories of a tree at a reference",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to list the tree at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "Directory to list relative to the root of the repository (default: the root)",
+				Optional:            true,
+			},
+			"recursive": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to list subdirectories recursively (default: false)",
+				Optional:            true,
+			},
+			"export_ignore": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to leave out paths with the `export-ignore` attribute, listing the same files as `git archive` (default: false)",
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Entries of the tree",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the entry",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the entry relative to the root of the repository",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the entry, one of `blob`, `tree` or `commit` (submodule)",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							MarkdownDescription: "Git file mode of the entry (ie. `0100644`)",
+							Computed:            true,
+						},
+						"hash": schema.StringAttribute{
+							MarkdownDescription: "Hash of the object the entry points at",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes of blobs, 0 for other entries",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitTree) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitTree) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitTreeModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read tree", err.Error())
+		return
+	}
+
+	var exportIgnore *gitutils.ExportIgnore
+	if data.ExportIgnore.ValueBool() {
+		exportIgnore, err = gitutils.NewExportIgnore(tree)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read gitattributes", err.Error())
+			return
+		}
+	}
+
+	directory := filepath.ToSlash(filepath.Clean(data.Directory.ValueString()))
+	if directory != "." && directory != "/" {
+		tree, err = tree.Tree(directory)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("directory"), "unable to find directory", fmt.Sprintf("%s at %s: %s", directory, commit.Hash.String(), err.Error()))
+			return
+		}
+	} else {
+		directory = ""
+	}
+
+	walker := object.NewTreeWalker(tree, data.Recursive.ValueBool(), nil)
+	defer walker.Close()
+
+	data.Entries = []GitTreeEntryModel{}
+	for {
+		name, entry, err := walker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("unable to walk tree", err.Error())
+			return
+		}
+
+		if exportIgnore != nil && exportIgnore.Match(joinTreePath(directory, name)) {
+			continue
+		}
+
+		model := GitTreeEntryModel{
+			Name: types.StringValue(entry.Name),
+			Path: types.StringValue(joinTreePath(directory, name)),
+			Type: types.StringValue(treeEntryType(entry.Mode)),
+			Mode: types.StringValue(entry.Mode.String()),
+			Hash: types.StringValue(entry.Hash.String()),
+			Size: types.Int64Value(0),
+		}
+
+		if entry.Mode.IsFile() {
+			blob, err := repo.BlobObject(entry.Hash)
+			if err != nil {
+				resp.Diagnostics.AddError("unable to read blob", fmt.Sprintf("%s: %s", name, err.Error()))
+				return
+			}
+			model.Size = types.Int64Value(blob.Size)
+		}
+
+		data.Entries = append(data.Entries, model)
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("tree: %s entries: %d", tree.Hash.String(), len(data.Entries)))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", commit.Hash.String(), directory))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func joinTreePath(directory string, name string) string {
+	if directory == "" {
+		return name
+	}
+	return directory + "/" + name
+}
+
+func treeEntryType(mode filemode.FileMode) string {
+	switch mode {
+	case filemode.Dir:
+		return "tree"
+	case filemode.Submodule:
+		return "commit"
+	default:
+		return "blob"
+	}
+}
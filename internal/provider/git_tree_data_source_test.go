@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitTreeDataSourceConfig(path string, directory string, recursive bool) string {
+	return fmt.Sprintf(`
+data "git_tree" "test" {
+  path      = %[1]q
+  directory = %[2]q
+  recursive = %[3]t
+}
+`, path, directory, recursive)
+}
+
+func TestAccGitTreeDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "services", "api"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", "api", "main.tf"), []byte("# api"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", "web.tf"), []byte("# web"), 0644))
+	hash, err := testCommitAll(tempDir, "services")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitTreeDataSourceConfig(tempDir, "", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "commit", hash.String()),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.0.path", "README.md"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.0.type", "blob"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.0.size", "7"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.1.path", "services"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.1.type", "tree"),
+				),
+			},
+			{
+				Config: testAccGitTreeDataSourceConfig(tempDir, "services", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.#", "3"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.0.path", "services/api"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.1.path", "services/api/main.tf"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.1.name", "main.tf"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.2.path", "services/web.tf"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitTreeDataSourceConfigExportIgnore(path string, exportIgnore bool) string {
+	return fmt.Sprintf(`
+data "git_tree" "test" {
+  path          = %[1]q
+  recursive     = true
+  export_ignore = %[2]t
+}
+`, path, exportIgnore)
+}
+
+func TestAccGitTreeDataSource2(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "tests"), 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "lib"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".gitattributes"), []byte("/tests export-ignore\n*.md export-ignore\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "tests", "main_test.go"), []byte("package main"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "lib", ".gitattributes"), []byte("secret.txt export-ignore\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "lib", "secret.txt"), []byte("secret"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "lib", "lib.go"), []byte("package lib"), 0644))
+	_, err = testCommitAll(tempDir, "attributes")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitTreeDataSourceConfigExportIgnore(tempDir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.#", "8"),
+				),
+			},
+			{
+				Config: testAccGitTreeDataSourceConfigExportIgnore(tempDir, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.#", "4"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.0.path", ".gitattributes"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.1.path", "lib"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.2.path", "lib/.gitattributes"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.3.path", "lib/lib.go"),
+				),
+			},
+		},
+	})
+}
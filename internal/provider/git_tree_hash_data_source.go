@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitTreeHash{}
+
+func NewGitTreeHash() datasource.DataSource {
+	return &GitTreeHash{}
+}
+
+// GitTreeHash defines the data source implementation.
+type GitTreeHash struct {
+	provider *GitProviderData
+}
+
+// GitTreeHashModel describes the data source data model.
+type GitTreeHashModel struct {
+	Id        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Reference types.String `tfsdk:"ref"`
+	Directory types.String `tfsdk:"directory"`
+	Commit    types.String `tfsdk:"commit"`
+	Exists    types.Bool   `tfsdk:"exists"`
+	Hash      types.String `tfsdk:"hash"`
+}
+
+func (d *GitTreeHash) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tree_hash"
+}
+
+func (d *GitTreeHash) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Tree Hash data source, returns the hash of the tree object of a directory at a reference " +
+			"like `git rev-parse <ref>:<directory>`. The hash only changes when a file under the directory does, the " +
+			"cheapest way to tell whether a component changed between runs",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to read the tree at, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "Directory relative to the root of the repository (ie. `modules/vpc`) (default: the root)",
+				Optional:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the reference resolved to",
+				Computed:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the directory exists at the reference",
+				Computed:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the tree object of the directory, empty when it does not exist",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitTreeHash) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitTreeHash) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitTreeHashModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	data.Exists = types.BoolValue(true)
+	data.Hash = types.StringValue(commit.TreeHash.String())
+
+	directory := filepath.ToSlash(filepath.Clean(data.Directory.ValueString()))
+	if directory != "." && directory != "/" {
+		tree, err := commit.Tree()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read tree", err.Error())
+			return
+		}
+
+		entry, err := tree.FindEntry(directory)
+		switch {
+		case err == object.ErrDirectoryNotFound || err == object.ErrEntryNotFound:
+			data.Exists = types.BoolValue(false)
+			data.Hash = types.StringValue("")
+		case err != nil:
+			resp.Diagnostics.AddError("unable to read tree", err.Error())
+			return
+		case entry.Mode != filemode.Dir:
+			resp.Diagnostics.AddAttributeError(path.Root("directory"), "invalid directory", fmt.Sprintf("%s at %s is not a directory", directory, commit.Hash.String()))
+			return
+		default:
+			data.Hash = types.StringValue(entry.Hash.String())
+		}
+	} else {
+		directory = ""
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("directory: %q tree: %s", directory, data.Hash.ValueString()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", commit.Hash.String(), directory))
+	data.Commit = types.StringValue(commit.Hash.String())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitTreeHashDataSourceConfig(path string, directory string) string {
+	return fmt.Sprintf(`
+data "git_tree_hash" "current" {
+  path      = %[1]q
+  directory = %[2]q
+}
+
+data "git_tree_hash" "previous" {
+  path      = %[1]q
+  ref       = "HEAD~1"
+  directory = %[2]q
+}
+`, path, directory)
+}
+
+func TestAccGitTreeHashDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	_, err = testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "services", "api"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", "api", "main.tf"), []byte("# api"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", "web.tf"), []byte("# web"), 0644))
+	_, err = testCommitAll(tempDir, "services")
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "services", "web.tf"), []byte("# web v2"), 0644))
+	head, err := testCommitAll(tempDir, "web v2")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+	commit, err := repo.CommitObject(*head)
+	assert.NoError(t, err)
+	tree, err := commit.Tree()
+	assert.NoError(t, err)
+	services, err := tree.FindEntry("services")
+	assert.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitTreeHashDataSourceConfig(tempDir, "services/api"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree_hash.current", "commit", head.String()),
+					resource.TestCheckResourceAttr("data.git_tree_hash.current", "exists", "true"),
+					resource.TestCheckResourceAttrPair("data.git_tree_hash.current", "hash", "data.git_tree_hash.previous", "hash"),
+				),
+			},
+			{
+				Config: testAccGitTreeHashDataSourceConfig(tempDir, "services/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree_hash.current", "hash", services.Hash.String()),
+					resource.TestMatchResourceAttr("data.git_tree_hash.previous", "hash", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+			{
+				Config: testAccGitTreeHashDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree_hash.current", "hash", commit.TreeHash.String()),
+				),
+			},
+			{
+				Config: testAccGitTreeHashDataSourceConfig(tempDir, "services/db"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree_hash.current", "exists", "false"),
+					resource.TestCheckResourceAttr("data.git_tree_hash.current", "hash", ""),
+				),
+			},
+			{
+				Config:      testAccGitTreeHashDataSourceConfig(tempDir, "services/web.tf"),
+				ExpectError: regexp.MustCompile("invalid directory"),
+			},
+		},
+	})
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitUpstream{}
+
+func NewGitUpstream() datasource.DataSource {
+	return &GitUpstream{}
+}
+
+// GitUpstream defines the data source implementation.
+type GitUpstream struct {
+	provider *GitProviderData
+}
+
+// GitUpstreamModel describes the data source data model.
+type GitUpstreamModel struct {
+	Id        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Branch    types.String `tfsdk:"branch"`
+	Exists    types.Bool   `tfsdk:"exists"`
+	Upstream  types.String `tfsdk:"upstream"`
+	Remote    types.String `tfsdk:"remote"`
+	Merge     types.String `tfsdk:"merge"`
+	Reference types.String `tfsdk:"ref"`
+	Commit    types.String `tfsdk:"commit"`
+}
+
+func (d *GitUpstream) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upstream"
+}
+
+func (d *GitUpstream) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Upstream data source, returns the upstream tracking branch configured for a local branch " +
+			"(`branch.<name>.remote` and `branch.<name>.merge`) like `git rev-parse --abbrev-ref <branch>@{upstream}`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Name of the local branch, defaults to the branch currently checked out, an error when " +
+					"HEAD is detached",
+				Optional: true,
+				Computed: true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the branch has an upstream configured",
+				Computed:            true,
+			},
+			"upstream": schema.StringAttribute{
+				MarkdownDescription: "Upstream tracking branch (ie. `origin/main`), empty when none is configured",
+				Computed:            true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote of the upstream, `.` when tracking a local branch, empty when none is configured",
+				Computed:            true,
+			},
+			"merge": schema.StringAttribute{
+				MarkdownDescription: "Reference merged from the upstream remote (ie. `refs/heads/main`), empty when none is configured",
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Local reference tracking the upstream (ie. `refs/remotes/origin/main`), empty when none is configured",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit the tracking reference points at, empty when it has not been fetched yet",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitUpstream) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitUpstream) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitUpstreamModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	branch := strings.TrimPrefix(data.Branch.ValueString(), "refs/heads/")
+	if branch == "" || branch == "HEAD" {
+		branch, err = gitutils.CurrentBranch(repo)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("branch"), "unable to determine current branch", err.Error())
+			return
+		}
+	}
+
+	refName := plumbing.NewBranchReferenceName(branch)
+	if _, err := repo.Reference(refName, true); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("branch"), "unable to find branch", fmt.Sprintf("%s: %s", refName.String(), err.Error()))
+		return
+	}
+
+	upstream, err := gitutils.BranchUpstream(repo, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read branch configuration", err.Error())
+		return
+	}
+
+	data.Branch = types.StringValue(branch)
+	data.Exists = types.BoolValue(upstream != nil)
+	data.Upstream = types.StringValue("")
+	data.Remote = types.StringValue("")
+	data.Merge = types.StringValue("")
+	data.Reference = types.StringValue("")
+	data.Commit = types.StringValue("")
+
+	if upstream != nil {
+		data.Remote = types.StringValue(upstream.Remote)
+		data.Merge = types.StringValue(upstream.Merge.String())
+		data.Reference = types.StringValue(upstream.Reference().String())
+
+		if upstream.Remote == "." {
+			data.Upstream = types.StringValue(upstream.Merge.Short())
+		} else {
+			data.Upstream = types.StringValue(fmt.Sprintf("%s/%s", upstream.Remote, upstream.Merge.Short()))
+		}
+
+		ref, err := repo.Reference(upstream.Reference(), true)
+		switch {
+		case err == plumbing.ErrReferenceNotFound:
+			// the upstream has not been fetched yet
+		case err != nil:
+			resp.Diagnostics.AddError("unable to read upstream reference", err.Error())
+			return
+		default:
+			data.Commit = types.StringValue(ref.Hash().String())
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("branch: %s upstream: %s", branch, data.Upstream.ValueString()))
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.Path.ValueString(), refName.String()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
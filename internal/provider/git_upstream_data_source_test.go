@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccGitUpstreamDataSourceConfig(path string, options string) string {
+	return fmt.Sprintf(`
+data "git_upstream" "test" {
+  path = %[1]q
+  %[2]s
+}
+`, path, options)
+}
+
+func TestAccGitUpstreamDataSource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	head, err := testSetupGit(tempDir, "", 0)
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(tempDir)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "main"), *head)))
+	assert.NoError(t, repo.CreateBranch(&config.Branch{
+		Name:   "master",
+		Remote: "origin",
+		Merge:  plumbing.NewBranchReferenceName("main"),
+	}))
+
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("feature"), *head)))
+	assert.NoError(t, repo.CreateBranch(&config.Branch{
+		Name:   "feature",
+		Remote: "upstream",
+		Merge:  plumbing.NewBranchReferenceName("feature"),
+	}))
+
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("local"), *head)))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGitUpstreamDataSourceConfig(tempDir, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_upstream.test", "branch", "master"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "upstream", "origin/main"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "remote", "origin"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "merge", "refs/heads/main"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "ref", "refs/remotes/origin/main"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "commit", head.String()),
+				),
+			},
+			{
+				Config: testAccGitUpstreamDataSourceConfig(tempDir, `branch = "feature"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_upstream.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "upstream", "upstream/feature"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "ref", "refs/remotes/upstream/feature"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "commit", ""),
+				),
+			},
+			{
+				Config: testAccGitUpstreamDataSourceConfig(tempDir, `branch = "local"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_upstream.test", "branch", "local"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "exists", "false"),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "upstream", ""),
+					resource.TestCheckResourceAttr("data.git_upstream.test", "remote", ""),
+				),
+			},
+			{
+				Config:      testAccGitUpstreamDataSourceConfig(tempDir, `branch = "missing"`),
+				ExpectError: regexp.MustCompile("unable to find branch"),
+			},
+		},
+	})
+}
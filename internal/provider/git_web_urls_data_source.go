@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitWebURLs{}
+
+func NewGitWebURLs() datasource.DataSource {
+	return &GitWebURLs{}
+}
+
+// GitWebURLs defines the data source implementation.
+type GitWebURLs struct {
+	provider *GitProviderData
+}
+
+// GitWebURLsModel describes the data source data model.
+type GitWebURLsModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Path               types.String `tfsdk:"path"`
+	Remote             types.String `tfsdk:"remote"`
+	RemoteURL          types.String `tfsdk:"remote_url"`
+	Reference          types.String `tfsdk:"ref"`
+	FromReference      types.String `tfsdk:"from_ref"`
+	File               types.String `tfsdk:"file"`
+	Forge              types.String `tfsdk:"forge"`
+	CommitURLTemplate  types.String `tfsdk:"commit_url_template"`
+	FileURLTemplate    types.String `tfsdk:"file_url_template"`
+	CompareURLTemplate types.String `tfsdk:"compare_url_template"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	Commit             types.String `tfsdk:"commit"`
+	CommitURL          types.String `tfsdk:"commit_url"`
+	FileURL            types.String `tfsdk:"file_url"`
+	CompareURL         types.String `tfsdk:"compare_url"`
+}
+
+func (d *GitWebURLs) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_urls"
+}
+
+func (d *GitWebURLs) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Web URLs data source, builds the forge web URLs of a commit, a file at a commit and a comparison between two commits. GitHub, GitLab and Bitbucket are built-in, self-hosted instances can set `forge` or their own URL templates",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Remote whose URL the web URLs are built from (default: origin)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Remote URL to build the web URLs from instead of the URL of `remote`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to link to, a branch, tag or commit (default: HEAD)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"from_ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to compare `ref` against, `compare_url` is empty when not set",
+				Optional:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "File to link to relative to the root of the repository, `file_url` is empty when not set",
+				Optional:            true,
+			},
+			"forge": schema.StringAttribute{
+				MarkdownDescription: "Forge the templates are taken from, one of `github`, `gitlab` or `bitbucket` (default: detected from the host name)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"commit_url_template": schema.StringAttribute{
+				MarkdownDescription: "Template of `commit_url`, overrides the one of `forge`. Templates may use the `{base_url}`, `{commit}`, `{file}`, `{from}` and `{to}` placeholders",
+				Optional:            true,
+			},
+			"file_url_template": schema.StringAttribute{
+				MarkdownDescription: "Template of `file_url`, overrides the one of `forge`",
+				Optional:            true,
+			},
+			"compare_url_template": schema.StringAttribute{
+				MarkdownDescription: "Template of `compare_url`, overrides the one of `forge`",
+				Optional:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Web URL of the repository (ie. `https://github.com/owner/repo`)",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit `ref` resolved to",
+				Computed:            true,
+			},
+			"commit_url": schema.StringAttribute{
+				MarkdownDescription: "Web URL of `commit`",
+				Computed:            true,
+			},
+			"file_url": schema.StringAttribute{
+				MarkdownDescription: "Web URL of `file` at `commit`",
+				Computed:            true,
+			},
+			"compare_url": schema.StringAttribute{
+				MarkdownDescription: "Web URL comparing `from_ref` to `commit`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitWebURLs) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *GitWebURLs) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitWebURLsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reference.ValueString() == "" {
+		data.Reference = types.StringValue("HEAD")
+	}
+	if data.Remote.ValueString() == "" {
+		data.Remote = types.StringValue(git.DefaultRemoteName)
+	}
+
+	repoPath, err := d.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = types.StringValue(repoPath)
+
+	repo, err := d.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	if data.RemoteURL.ValueString() == "" {
+		remote, err := repo.Remote(data.Remote.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to find remote", err.Error())
+			return
+		}
+		if len(remote.Config().URLs) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("remote"), "unable to find remote", fmt.Sprintf("remote %s has no url", data.Remote.ValueString()))
+			return
+		}
+		data.RemoteURL = types.StringValue(remote.Config().URLs[0])
+	}
+
+	baseURL, err := gitutils.WebURL(data.RemoteURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("remote_url"), "unable to determine web url", err.Error())
+		return
+	}
+
+	if data.Forge.ValueString() == "" {
+		data.Forge = types.StringValue(gitutils.DetectForge(baseURL))
+	}
+
+	templates := gitutils.Forges[data.Forge.ValueString()]
+	if data.CommitURLTemplate.ValueString() != "" {
+		templates.Commit = data.CommitURLTemplate.ValueString()
+	}
+	if data.FileURLTemplate.ValueString() != "" {
+		templates.File = data.FileURLTemplate.ValueString()
+	}
+	if data.CompareURLTemplate.ValueString() != "" {
+		templates.Compare = data.CompareURLTemplate.ValueString()
+	}
+
+	if templates.Commit == "" || templates.File == "" || templates.Compare == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("forge"), "unable to determine url templates", fmt.Sprintf("unknown forge %q for %s, set forge or the url templates", data.Forge.ValueString(), baseURL))
+		return
+	}
+
+	commit, err := resolveCommit(repo, data.Reference.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return
+	}
+
+	from := ""
+	if data.FromReference.ValueString() != "" {
+		fromCommit, err := resolveCommit(repo, data.FromReference.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from_ref"), "unable to resolve reference", err.Error())
+			return
+		}
+		from = fromCommit.Hash.String()
+	}
+
+	file := strings.TrimPrefix(data.File.ValueString(), "/")
+	expand := func(template string) string {
+		return gitutils.ExpandForgeTemplate(template, baseURL, commit.Hash.String(), file, from, commit.Hash.String())
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s@%s", baseURL, commit.Hash.String()))
+	data.BaseURL = types.StringValue(baseURL)
+	data.Commit = types.StringValue(commit.Hash.String())
+	data.CommitURL = types.StringValue(expand(templates.Commit))
+	data.FileURL = types.StringValue("")
+	data.CompareURL = types.StringValue("")
+
+	if file != "" {
+		data.FileURL = types.StringValue(expand(templates.File))
+	}
+	if from != "" {
+		data.CompareURL = types.StringValue(expand(templates.Compare))
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("base url: %s forge: %s", baseURL, data.Forge.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,511 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitWorktree{}
+var _ resource.ResourceWithImportState = &GitWorktree{}
+
+func NewGitWorktree() resource.Resource {
+	return &GitWorktree{}
+}
+
+// GitWorktree defines the resource implementation.
+type GitWorktree struct {
+	provider *GitProviderData
+}
+
+// GitWorktreeModel describes the resource data model.
+type GitWorktreeModel struct {
+	Id           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Serialize    types.Bool   `tfsdk:"serialize"`
+	WorktreePath types.String `tfsdk:"worktree_path"`
+	Reference    types.String `tfsdk:"ref"`
+	Force        types.Bool   `tfsdk:"force"`
+	Branch       types.String `tfsdk:"branch"`
+	Commit       types.String `tfsdk:"commit"`
+}
+
+func (r *GitWorktree) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worktree"
+}
+
+func (r *GitWorktree) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git Worktree resource, adds a linked working tree to a repository like `git worktree add` " +
+			"and keeps it checked out at a branch, tag or commit like the `git_checkout` resource does. As with git, a " +
+			"branch can only be checked out by one working tree at a time. Destroying the resource removes the working " +
+			"tree and prunes it from the repository like `git worktree remove`. Existing working trees can be imported " +
+			"with their `worktree_path`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to Git Repository, defaults to the provider `default_path`, may be bare",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serialize": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to wait for the other resources changing the same repository, and to refuse " +
+					"to change it while a git process holds its `index.lock`, false lets them run in parallel (default: true)",
+				Optional: true,
+			},
+			"worktree_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the working tree, which must be empty or not exist",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Reference to check out, a branch, tag or commit. Branches are checked out as such, " +
+					"created from the `origin` branch of the same name when missing locally, anything else detaches HEAD",
+				Required: true,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to discard local changes when checking out or removing the working " +
+					"tree, otherwise both fail when it has any (default: false)",
+				Optional: true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch checked out, empty when HEAD is detached",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit checked out",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GitWorktree) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *GitWorktree) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitWorktreeModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath, err := r.provider.repositoryPath(data.Path)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "unable to determine repository path", err.Error())
+		return
+	}
+	data.Path = resourcePath(data.Path, repoPath)
+
+	worktreePath, err := filepath.Abs(data.WorktreePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("worktree_path"), "invalid worktree_path", err.Error())
+		return
+	}
+	data.Id = types.StringValue(worktreePath)
+
+	repo, err := r.provider.openRepository(repoPath)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	// The lock of the repository also covers the new working tree, which is
+	// checked out while it is held.
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	commonDir, err := gitutils.CommonDir(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to determine git directory", err.Error())
+		return
+	}
+
+	// A working tree removed by hand is pruned before being added again.
+	if worktree, err := linkedWorktree(commonDir, worktreePath); err != nil {
+		resp.Diagnostics.AddError("unable to list working trees", err.Error())
+		return
+	} else if worktree != nil {
+		if _, err := os.Stat(worktreePath); err == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("worktree_path"), "working tree already exists",
+				fmt.Sprintf("%s is already a working tree of %s, import it to manage it", worktreePath, repoPath))
+			return
+		}
+		tflog.Trace(ctx, fmt.Sprintf("pruning working tree %s", worktree.ID))
+		if err := gitutils.PruneWorktree(commonDir, worktree.ID); err != nil {
+			resp.Diagnostics.AddError("unable to prune working tree", err.Error())
+			return
+		}
+	}
+
+	// HEAD starts at the commit of the main working tree, ref is checked out
+	// right after.
+	head, err := repo.Head()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read HEAD", err.Error())
+		return
+	}
+
+	id, err := gitutils.AddWorktree(commonDir, worktreePath, head.Hash().String())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("worktree_path"), "unable to add working tree", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("added working tree %s", id))
+
+	resp.Diagnostics.Append(r.checkout(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		// Leave nothing behind, the resource is not created.
+		if err := os.RemoveAll(worktreePath); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to remove %s: %v", worktreePath, err))
+		}
+		if err := gitutils.PruneWorktree(commonDir, id); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to prune working tree %s: %v", id, err))
+		}
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitWorktree) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitWorktreeModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Id.ValueString())
+	if errors.Is(err, git.ErrRepositoryNotExists) || errors.Is(err, git.ErrRepositoryIncomplete) {
+		tflog.Warn(ctx, fmt.Sprintf("working tree %s no longer exists, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open working tree", err.Error())
+		return
+	}
+
+	// Imported working trees adopt the repository they are linked to.
+	if data.Path.IsNull() {
+		commonDir, err := gitutils.CommonDir(repo)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to determine git directory", err.Error())
+			return
+		}
+		if filepath.Base(commonDir) == git.GitDirName {
+			commonDir = filepath.Dir(commonDir)
+		}
+		data.Path = types.StringValue(commonDir)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read HEAD", err.Error())
+		return
+	}
+
+	branch := ""
+	if head.Name().IsBranch() {
+		branch = head.Name().Short()
+	}
+
+	// A HEAD moved outside of Terraform is planned to be checked out again.
+	if head.Hash().String() != data.Commit.ValueString() || branch != data.Branch.ValueString() {
+		data.Reference = types.StringValue(branch)
+		if branch == "" {
+			data.Reference = types.StringValue(head.Hash().String())
+		}
+		data.Branch = types.StringValue(branch)
+		data.Commit = types.StringValue(head.Hash().String())
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitWorktree) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GitWorktreeModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open working tree", err.Error())
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	resp.Diagnostics.Append(r.checkout(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitWorktree) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitWorktreeModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, err := r.provider.openRepository(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to open git repository", err.Error())
+		return
+	}
+
+	unlock, err := r.provider.lockRepository(repo, data.Serialize)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to lock repository", err.Error())
+		return
+	}
+	defer unlock()
+
+	commonDir, err := gitutils.CommonDir(repo)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to determine git directory", err.Error())
+		return
+	}
+
+	worktreePath := data.Id.ValueString()
+
+	if !data.Force.ValueBool() {
+		wtRepo, err := r.provider.openRepository(worktreePath)
+		if err == nil {
+			wt, err := wtRepo.Worktree()
+			if err != nil {
+				resp.Diagnostics.AddError("unable to open working tree", err.Error())
+				return
+			}
+			status, err := wt.Status()
+			if err != nil {
+				resp.Diagnostics.AddError("unable to read working tree status", err.Error())
+				return
+			}
+			if !status.IsClean() {
+				resp.Diagnostics.AddError("working tree has local changes",
+					fmt.Sprintf("%s has modified or untracked files, set force to remove it anyway", worktreePath))
+				return
+			}
+		} else if !errors.Is(err, git.ErrRepositoryNotExists) && !errors.Is(err, git.ErrRepositoryIncomplete) {
+			resp.Diagnostics.AddError("unable to open working tree", err.Error())
+			return
+		}
+	}
+
+	if err := os.RemoveAll(worktreePath); err != nil {
+		resp.Diagnostics.AddError("unable to remove working tree", err.Error())
+		return
+	}
+
+	worktree, err := linkedWorktree(commonDir, worktreePath)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to list working trees", err.Error())
+		return
+	}
+	if worktree != nil {
+		if err := gitutils.PruneWorktree(commonDir, worktree.ID); err != nil {
+			resp.Diagnostics.AddError("unable to prune working tree", err.Error())
+			return
+		}
+	}
+}
+
+func (r *GitWorktree) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	worktreePath, err := filepath.Abs(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid import id", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("worktree_path"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), worktreePath)...)
+}
+
+// checkout checks out the reference of data in its working tree, setting the
+// branch and commit checked out.
+func (r *GitWorktree) checkout(ctx context.Context, data *GitWorktreeModel) (diags diag.Diagnostics) {
+	repo, err := r.provider.openRepository(data.Id.ValueString())
+	if err != nil {
+		diags.AddError("unable to open working tree", err.Error())
+		return diags
+	}
+
+	opts, err := checkoutOptions(repo, data.Reference.ValueString(), "origin")
+	if err != nil {
+		diags.AddAttributeError(path.Root("ref"), "unable to resolve reference", err.Error())
+		return diags
+	}
+	opts.Force = data.Force.ValueBool()
+
+	if opts.Branch != "" {
+		worktreePath, err := checkedOutBranch(repo, opts.Branch)
+		if err != nil {
+			diags.AddError("unable to list working trees", err.Error())
+			return diags
+		}
+		if worktreePath != "" && worktreePath != data.Id.ValueString() {
+			diags.AddAttributeError(path.Root("ref"), "branch already checked out",
+				fmt.Sprintf("%s is already checked out at %s", opts.Branch.Short(), worktreePath))
+			return diags
+		}
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		diags.AddError("unable to open working tree", err.Error())
+		return diags
+	}
+
+	if err := wt.Checkout(opts); err != nil {
+		diags.AddError("unable to check out reference", err.Error())
+		return diags
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		diags.AddError("unable to read HEAD", err.Error())
+		return diags
+	}
+
+	data.Branch = types.StringValue("")
+	if head.Name().IsBranch() {
+		data.Branch = types.StringValue(head.Name().Short())
+	}
+	data.Commit = types.StringValue(head.Hash().String())
+
+	tflog.Trace(ctx, fmt.Sprintf("checked out %s at %s", data.Reference.ValueString(), head.Hash().String()))
+
+	return diags
+}
+
+// linkedWorktree returns the working tree at worktreePath linked to the common
+// git directory commonDir, nil when there is none.
+func linkedWorktree(commonDir string, worktreePath string) (*gitutils.LinkedWorktree, error) {
+	worktrees, err := gitutils.LinkedWorktrees(commonDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, worktree := range worktrees {
+		if worktree.Path == worktreePath {
+			return &worktree, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkedOutBranch returns the path of the working tree of repo that has
+// branch checked out, empty when none has.
+func checkedOutBranch(repo *git.Repository, branch plumbing.ReferenceName) (string, error) {
+	commonDir, err := gitutils.CommonDir(repo)
+	if err != nil {
+		return "", err
+	}
+
+	worktrees, err := gitutils.LinkedWorktrees(commonDir)
+	if err != nil {
+		return "", err
+	}
+	for _, worktree := range worktrees {
+		if worktree.Head == "ref: "+branch.String() {
+			return worktree.Path, nil
+		}
+	}
+
+	// The HEAD of bare repositories is not checked out.
+	head, err := os.ReadFile(filepath.Join(commonDir, "HEAD"))
+	if err != nil {
+		return "", err
+	}
+	if filepath.Base(commonDir) == git.GitDirName && string(head) == "ref: "+branch.String()+"\n" {
+		return filepath.Dir(commonDir), nil
+	}
+
+	return "", nil
+}
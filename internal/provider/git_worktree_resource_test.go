@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccGitWorktreeResourceConfig(path string, worktreePath string, ref string) string {
+	return fmt.Sprintf(`
+resource "git_worktree" "test" {
+  path          = %[1]q
+  worktree_path = %[2]q
+  ref           = %[3]q
+}
+`, path, worktreePath, ref)
+}
+
+func TestAccGitWorktreeResource1(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	repoDir := filepath.Join(tempDir, "repo")
+	worktreeDir := filepath.Join(tempDir, "stacks", "production")
+
+	tagged, err := testSetupGit(repoDir, "v1.0.0", 0)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(repoDir, "main.tf"), []byte("# production\n"), 0644))
+	head, err := testCommitAll(repoDir, "add main.tf")
+	assert.NoError(t, err)
+
+	repo, err := git.PlainOpen(repoDir)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("production"), *head)))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if _, err := os.Stat(worktreeDir); !os.IsNotExist(err) {
+				return fmt.Errorf("expected %s to be removed", worktreeDir)
+			}
+			if _, err := os.Stat(filepath.Join(repoDir, ".git", "worktrees")); !os.IsNotExist(err) {
+				return fmt.Errorf("expected the working tree to be pruned")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitWorktreeResourceConfig(repoDir, worktreeDir, "master"),
+				ExpectError: regexp.MustCompile("master is already checked out at"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccGitWorktreeResourceConfig(repoDir, worktreeDir, "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "id", worktreeDir),
+					resource.TestCheckResourceAttr("git_worktree.test", "branch", "production"),
+					resource.TestCheckResourceAttr("git_worktree.test", "commit", head.String()),
+					testCheckHead(worktreeDir, "refs/heads/production"),
+					testCheckWorktreeFile(worktreeDir, "main.tf", "# production\n"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitWorktreeResourceConfig(repoDir, worktreeDir, "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "branch", ""),
+					resource.TestCheckResourceAttr("git_worktree.test", "commit", tagged.String()),
+					testCheckWorktreeFile(worktreeDir, "main.tf", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "git_worktree.test",
+				ImportState:             true,
+				ImportStateId:           worktreeDir,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					repo, err := openRepository(worktreeDir)
+					assert.NoError(t, err)
+					wt, err := repo.Worktree()
+					assert.NoError(t, err)
+					assert.NoError(t, wt.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("production")}))
+				},
+				Config: testAccGitWorktreeResourceConfig(repoDir, worktreeDir, "v1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "branch", ""),
+					resource.TestCheckResourceAttr("git_worktree.test", "commit", tagged.String()),
+				),
+			},
+		},
+	})
+}
+
+// testCheckWorktreeFile checks the content of file in the working tree at
+// path, an empty content checking the file does not exist.
+func testCheckWorktreeFile(path string, file string, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		actual, err := os.ReadFile(filepath.Join(path, file))
+		if os.IsNotExist(err) && content == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if string(actual) != content {
+			return fmt.Errorf("expected %s to be %q, got %q", file, content, actual)
+		}
+
+		return nil
+	}
+}
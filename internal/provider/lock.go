@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
+)
+
+// repositoryLocks serializes the resources changing the same repository.
+// Terraform applies independent resources in parallel, in the same provider
+// process, and go-git does not lock the index or the references it writes.
+type repositoryLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newRepositoryLocks() *repositoryLocks {
+	return &repositoryLocks{locks: map[string]*sync.Mutex{}}
+}
+
+// lock locks the repository whose common git directory is dir, waiting for
+// the resource holding it, and returns the function unlocking it. A nil
+// value locks nothing.
+func (l *repositoryLocks) lock(dir string) func() {
+	if l == nil {
+		return func() {}
+	}
+
+	l.mu.Lock()
+	m, ok := l.locks[dir]
+	if !ok {
+		m = &sync.Mutex{}
+		l.locks[dir] = m
+	}
+	l.mu.Unlock()
+
+	m.Lock()
+	return m.Unlock
+}
+
+// lockRepository waits for the other resources of the provider changing repo,
+// linked working trees included, and returns the function letting them
+// proceed, unless serialize is false. go-git does not take the index.lock of
+// git, so it refuses to while a git process holds the one of its working tree.
+func (p *GitProviderData) lockRepository(repo *git.Repository, serialize types.Bool) (func(), error) {
+	if !serialize.IsNull() && !serialize.ValueBool() {
+		return func() {}, nil
+	}
+
+	commonDir, err := gitutils.CommonDir(repo)
+	if err != nil {
+		return nil, err
+	}
+	gitDir, err := gitutils.GitDir(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := p.locks().lock(commonDir)
+
+	if _, err := os.Stat(filepath.Join(gitDir, "index.lock")); err == nil {
+		unlock()
+		return nil, fmt.Errorf("index.lock exists, another git process seems to be running in this repository")
+	}
+
+	return unlock, nil
+}
+
+// locks returns the repository locks of the provider, nil when it has not
+// been configured.
+func (p *GitProviderData) locks() *repositoryLocks {
+	if p == nil {
+		return nil
+	}
+	return p.repositoryLocks
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLockRepository(t *testing.T) {
+	tempDir, err := os.MkdirTemp(os.TempDir(), "terraform-provider-git-")
+	assert.NoError(t, err)
+	//noinspection GoUnhandledErrorResult
+	defer os.RemoveAll(tempDir)
+
+	repo, err := git.PlainInit(tempDir, false)
+	assert.NoError(t, err)
+	indexLock := filepath.Join(tempDir, ".git", "index.lock")
+
+	p := &GitProviderData{repositoryLocks: newRepositoryLocks()}
+
+	unlock, err := p.lockRepository(repo, types.BoolNull())
+	assert.NoError(t, err)
+
+	// Other resources wait for the lock.
+	locked := make(chan func())
+	go func() {
+		unlock, err := p.lockRepository(repo, types.BoolValue(true))
+		assert.NoError(t, err)
+		locked <- unlock
+	}()
+	select {
+	case <-locked:
+		t.Fatal("expected the repository to stay locked")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// unless they do not serialize.
+	noop, err := p.lockRepository(repo, types.BoolValue(false))
+	assert.NoError(t, err)
+	noop()
+
+	unlock()
+	(<-locked)()
+
+	// Git processes updating the index are not waited for.
+	assert.NoError(t, os.WriteFile(indexLock, nil, 0644))
+	_, err = p.lockRepository(repo, types.BoolNull())
+	assert.EqualError(t, err, "index.lock exists, another git process seems to be running in this repository")
+
+	// The repository is unlocked after a failure.
+	assert.NoError(t, os.Remove(indexLock))
+	unlock, err = p.lockRepository(repo, types.BoolNull())
+	assert.NoError(t, err)
+	unlock()
+}
@@ -2,12 +2,26 @@ package provider
 
 import (
 	"context"
-	"net/http"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	gitutils "github.com/ekristen/terraform-provider-git/pkg/git"
 )
 
 // Ensure GitProvider satisfies various provider interfaces.
@@ -21,8 +35,207 @@ type GitProvider struct {
 	version string
 }
 
-// ScaffoldingProviderModel describes the provider data model.
-type ScaffoldingProviderModel struct{}
+// GitProviderModel describes the provider data model.
+type GitProviderModel struct {
+	DefaultPath          types.String   `tfsdk:"default_path"`
+	Base                 types.String   `tfsdk:"base"`
+	AllowUnsafeOwnership types.Bool     `tfsdk:"allow_unsafe_ownership"`
+	SigningKey           types.String   `tfsdk:"signing_key"`
+	SigningKeyPassphrase types.String   `tfsdk:"signing_key_passphrase"`
+	ProtectedRefs        []types.String `tfsdk:"protected_refs"`
+
+	Repositories map[string]GitProviderRepositoryModel `tfsdk:"repositories"`
+}
+
+// GitProviderRepositoryModel describes a named repository of the provider.
+type GitProviderRepositoryModel struct {
+	Path     types.String `tfsdk:"path"`
+	URL      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// GitProviderData is passed to data sources and resources once the provider
+// has been configured.
+type GitProviderData struct {
+	DefaultPath          string
+	Base                 string
+	AllowUnsafeOwnership bool
+	SigningKey           string
+	SigningKeyPassphrase string
+	ProtectedRefs        []string
+	Repositories         map[string]GitProviderRepositoryModel
+
+	readCache       *readCache
+	repositoryLocks *repositoryLocks
+}
+
+// repositoryPath returns the configured path, falling back to the provider
+// default path when it has been omitted. Either may name one of the provider
+// repositories, which is replaced with its path. Relative paths are resolved
+// according to the provider base.
+func (p *GitProviderData) repositoryPath(path types.String) (string, error) {
+	name := path.ValueString()
+	if name == "" && p != nil {
+		name = p.DefaultPath
+	}
+	if name == "" {
+		return "", fmt.Errorf("path must be set when the provider has no default_path")
+	}
+
+	if p != nil {
+		if repository, ok := p.Repositories[name]; ok {
+			if repository.Path.ValueString() == "" {
+				return "", fmt.Errorf("repository %q has no path", name)
+			}
+			name = repository.Path.ValueString()
+		}
+	}
+
+	base := "root"
+	if p != nil && p.Base != "" {
+		base = p.Base
+	}
+
+	return resolvePath(name, base)
+}
+
+// resolvePath resolves the relative path p according to base. Terraform
+// starts providers in the root module directory, after applying -chdir, so
+// root leaves p as it is, while PWD is inherited from the shell that invoked
+// Terraform.
+func resolvePath(p string, base string) (string, error) {
+	if base == "root" || filepath.IsAbs(p) {
+		return p, nil
+	}
+	if base != "cwd" {
+		return "", fmt.Errorf("unsupported base %q, expected one of: root, cwd", base)
+	}
+
+	dir := os.Getenv("PWD")
+	if dir == "" {
+		return filepath.Abs(p)
+	}
+
+	return filepath.Join(dir, p), nil
+}
+
+// resourcePath returns the path to save in the state of a resource created in
+// the repository at repoPath: path as configured, which may be relative or
+// name one of the provider repositories, so it matches the plan, or repoPath
+// when it has been omitted. Operations on the saved path open it with
+// openRepository, which resolves it again.
+func resourcePath(path types.String, repoPath string) types.String {
+	if path.IsNull() || path.IsUnknown() || path.ValueString() == "" {
+		return types.StringValue(repoPath)
+	}
+	return path
+}
+
+// namedRepository returns the provider repository path refers to, by name or
+// by path, falling back to the provider default path when path is empty.
+func (p *GitProviderData) namedRepository(path string) (GitProviderRepositoryModel, bool) {
+	if p == nil {
+		return GitProviderRepositoryModel{}, false
+	}
+	if path == "" {
+		path = p.DefaultPath
+	}
+	if repository, ok := p.Repositories[path]; ok {
+		return repository, true
+	}
+
+	// The state of resources holds resolved paths when path was omitted or the
+	// resource was imported.
+	names := make([]string, 0, len(p.Repositories))
+	for name := range p.Repositories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if p.Repositories[name].Path.ValueString() == "" {
+			continue
+		}
+		if resolved, err := p.repositoryPath(types.StringValue(name)); err == nil && resolved == path {
+			return p.Repositories[name], true
+		}
+	}
+
+	return GitProviderRepositoryModel{}, false
+}
+
+// repositoryAuth returns the authentication to use against the remotes of the
+// repository at path: username and password when either is set, otherwise the
+// credentials of the provider repository path refers to.
+func (p *GitProviderData) repositoryAuth(path types.String, username types.String, password types.String) transport.AuthMethod {
+	if username.ValueString() == "" && password.ValueString() == "" {
+		if repository, ok := p.namedRepository(path.ValueString()); ok {
+			username, password = repository.Username, repository.Password
+		}
+	}
+
+	return remoteAuth(username, password)
+}
+
+// repositoryLocation resolves location, a path, a URL or the name of one of
+// the provider repositories, to the location to read and the authentication to
+// use against remotes. A named repository is read from its url when it has one,
+// with its credentials unless username or password are set.
+func (p *GitProviderData) repositoryLocation(location types.String, username types.String, password types.String) (string, transport.AuthMethod) {
+	if p != nil {
+		if repository, ok := p.Repositories[location.ValueString()]; ok {
+			if username.ValueString() == "" && password.ValueString() == "" {
+				username, password = repository.Username, repository.Password
+			}
+
+			if repository.URL.ValueString() != "" {
+				return repository.URL.ValueString(), remoteAuth(username, password)
+			}
+			return repository.Path.ValueString(), remoteAuth(username, password)
+		}
+	}
+
+	return location.ValueString(), remoteAuth(username, password)
+}
+
+// openRepository opens the repository at path, resolved like repositoryPath
+// does, refusing repositories owned by another user like git does unless they
+// are listed in safe.directory or allow_unsafe_ownership is set.
+func (p *GitProviderData) openRepository(path string) (*git.Repository, error) {
+	path, err := p.repositoryPath(types.StringValue(path))
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil || !p.AllowUnsafeOwnership {
+		safeDirectories, err := p.cache().get("safe-directories", func() (interface{}, error) {
+			return gitutils.SafeDirectories()
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read safe.directory: %v", err)
+		}
+
+		if err := gitutils.CheckOwnership(path, safeDirectories.([]string)); err != nil {
+			return nil, err
+		}
+	}
+
+	return openRepository(path)
+}
+
+// checkProtectedRef returns an error when name matches the provider
+// protected_refs, action telling what a resource was about to do to it.
+func (p *GitProviderData) checkProtectedRef(name plumbing.ReferenceName, action string) error {
+	if p.isProtectedRef(name) {
+		return fmt.Errorf("refusing to %s %s, it matches the provider protected_refs", action, name.String())
+	}
+	return nil
+}
+
+// isProtectedRef reports whether name matches the provider protected_refs.
+func (p *GitProviderData) isProtectedRef(name plumbing.ReferenceName) bool {
+	return p != nil && gitutils.MatchRefName(p.ProtectedRefs, name.String())
+}
 
 func (p *GitProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "git"
@@ -30,11 +243,80 @@ func (p *GitProvider) Metadata(ctx context.Context, req provider.MetadataRequest
 }
 
 func (p *GitProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"default_path": schema.StringAttribute{
+				MarkdownDescription: "Repository path used by data sources that omit `path`, " +
+					"may also be set with the `GIT_PROVIDER_DEFAULT_PATH` environment variable",
+				Optional: true,
+			},
+			"base": schema.StringAttribute{
+				MarkdownDescription: "How relative repository paths are resolved, in `path`, `default_path` and `repositories`: " +
+					"`root` resolves them against the root module directory Terraform runs in (after `-chdir`), `cwd` " +
+					"against the directory Terraform was invoked from. Terraform does not tell providers which module a " +
+					"resource is declared in, use `path.module` in `path` for module relative paths (default: root)",
+				Optional:   true,
+				Validators: []validator.String{stringOneOf("root", "cwd")},
+			},
+			"allow_unsafe_ownership": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not to read repositories owned by another user, which git refuses unless they are " +
+					"listed in `safe.directory`, common when a repository is mounted into a container. " +
+					"May also be set with the `GIT_PROVIDER_ALLOW_UNSAFE_OWNERSHIP` environment variable (default: false)",
+				Optional: true,
+			},
+			"signing_key": schema.StringAttribute{
+				MarkdownDescription: "Armored OpenPGP private key signing the commits and tags created by resources, unless " +
+					"they set their own `signing_key` or set `sign` to false, by default they are not signed",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"signing_key_passphrase": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of `signing_key` when it is encrypted",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"protected_refs": schema.ListAttribute{
+				MarkdownDescription: "References resources refuse to delete, force push or rewrite, whatever they are configured " +
+					"to do, as full reference names where `*` matches any characters (ie. `refs/heads/main`, `refs/tags/v*`). " +
+					"This covers the references deleted by `git_release` and pruned by `git_fetch` and `git_mirror`, the " +
+					"ones force updated by `git_fetch` and `git_mirror`, which must fast forward instead, and the " +
+					"branches rebased by `git_pull`",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"repositories": schema.MapNestedAttribute{
+				MarkdownDescription: "Named repositories, the names can be used in place of a path in `path` and `default_path` " +
+					"of data sources and resources, and in place of a URL in remote data sources (ie. `url`, `urls`, `source` and `target`) to avoid " +
+					"repeating the location and credentials of the same repositories",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path to the Git Repository, used by local data sources and resources",
+							Optional:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the remote repository, used by remote data sources (default: `path`)",
+							Optional:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Username for HTTP(S) basic authentication, unless the data source or resource sets credentials",
+							Optional:            true,
+						},
+						"password": schema.StringAttribute{
+							MarkdownDescription: "Password or token for HTTP(S) basic authentication, unless the data source or resource sets credentials",
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
 func (p *GitProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	var data ScaffoldingProviderModel
+	var data GitProviderModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -42,22 +324,142 @@ func (p *GitProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
+	providerData := &GitProviderData{
+		DefaultPath:     os.Getenv("GIT_PROVIDER_DEFAULT_PATH"),
+		readCache:       newReadCache(),
+		repositoryLocks: newRepositoryLocks(),
+	}
+	if data.DefaultPath.ValueString() != "" {
+		providerData.DefaultPath = data.DefaultPath.ValueString()
+	}
+	providerData.Base = data.Base.ValueString()
+	if v := os.Getenv("GIT_PROVIDER_ALLOW_UNSAFE_OWNERSHIP"); v != "" {
+		allow, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid GIT_PROVIDER_ALLOW_UNSAFE_OWNERSHIP", err.Error())
+			return
+		}
+		providerData.AllowUnsafeOwnership = allow
+	}
+	if !data.AllowUnsafeOwnership.IsNull() {
+		providerData.AllowUnsafeOwnership = data.AllowUnsafeOwnership.ValueBool()
+	}
+	providerData.SigningKey = data.SigningKey.ValueString()
+	providerData.SigningKeyPassphrase = data.SigningKeyPassphrase.ValueString()
+	if providerData.SigningKey != "" {
+		if _, err := readSigningKey(providerData.SigningKey, providerData.SigningKeyPassphrase); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("signing_key"), "unable to read signing key", err.Error())
+			return
+		}
+	}
+	for i, pattern := range data.ProtectedRefs {
+		if !strings.HasPrefix(pattern.ValueString(), "refs/") {
+			resp.Diagnostics.AddAttributeError(path.Root("protected_refs").AtListIndex(i), "invalid protected reference",
+				fmt.Sprintf("%q must be a full reference name starting with refs/", pattern.ValueString()))
+			return
+		}
+		providerData.ProtectedRefs = append(providerData.ProtectedRefs, pattern.ValueString())
+	}
+	for name, repository := range data.Repositories {
+		if repository.Path.ValueString() == "" && repository.URL.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("repositories").AtMapKey(name), "invalid repository", "path or url must be set")
+			continue
+		}
+
+		// Paths of repositories are resolved once, like any other path, so
+		// remote data sources reading them get the same location.
+		if repository.Path.ValueString() != "" {
+			repoPath, err := providerData.repositoryPath(repository.Path)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("repositories").AtMapKey(name).AtName("path"), "unable to resolve path", err.Error())
+				continue
+			}
+			repository.Path = types.StringValue(repoPath)
+			data.Repositories[name] = repository
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	providerData.Repositories = data.Repositories
 
-	// Example client configuration for data sources and resources
-	client := http.DefaultClient
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *GitProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewGitCheckout,
+		NewGitFileResource,
+		NewGitFilesResource,
+		NewGitRemoteResource,
+		NewGitInit,
+		NewGitSubmoduleResource,
+		NewGitHook,
+		NewGitWorktree,
+		NewGitMirror,
+		NewGitRelease,
+		NewGitSparseCheckout,
+		NewGitMaintenance,
+		NewGitFetch,
+		NewGitPull,
+	}
 }
 
 func (p *GitProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewGitRepository,
+		NewGitBranch,
+		NewGitSubmodules,
+		NewGitRemotes,
+		NewGitRemoteRefs,
+		NewGitFile,
+		NewGitTree,
+		NewGitBlame,
+		NewGitObject,
+		NewGitDiff,
+		NewGitMergeCheck,
+		NewGitContributors,
+		NewGitDescribe,
+		NewGitMergeBase,
+		NewGitChangedPaths,
+		NewGitArchive,
+		NewGitWebURLs,
+		NewGitRefsCompare,
+		NewGitCommitSignature,
+		NewGitIsAncestor,
+		NewGitCheckIgnore,
+		NewGitFiles,
+		NewGitDefaultBranch,
+		NewGitSemver,
+		NewGitCommitCount,
+		NewGitBranchSlug,
+		NewGitRemoteTag,
+		NewGitRemoteFile,
+		NewGitDiffStat,
+		NewGitRemoteFiles,
+		NewGitMailmapAuthors,
+		NewGitAttributes,
+		NewGitShortlog,
+		NewGitTagContains,
+		NewGitCherry,
+		NewGitCI,
+		NewGitFileHistory,
+		NewGitLastModified,
+		NewGitGrep,
+		NewGitRevParse,
+		NewGitCommitTrailers,
+		NewGitReleaseNotes,
+		NewGitTreeHash,
+		NewGitBlobHash,
+		NewGitUpstream,
+		NewGitCommitFiles,
+		NewGitPatch,
+		NewGitPathChanged,
+		NewGitLatestSemverTag,
+		NewGitMonorepoVersion,
+		NewGitConventionalCommits,
+		NewGitGitmodules,
 	}
 }
 
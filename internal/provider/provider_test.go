@@ -3,7 +3,13 @@ package provider
 import (
 	"testing"
 
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/assert"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -20,3 +26,61 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestRepositoryAuth(t *testing.T) {
+	p := &GitProviderData{
+		DefaultPath: "infra",
+		Repositories: map[string]GitProviderRepositoryModel{
+			"infra": {
+				Path:     types.StringValue("/repositories/infra"),
+				Username: types.StringValue("user"),
+				Password: types.StringValue("token"),
+			},
+		},
+	}
+	named := &githttp.BasicAuth{Username: "user", Password: "token"}
+
+	cases := []struct {
+		name     string
+		path     types.String
+		username types.String
+		expected transport.AuthMethod
+	}{
+		{"name", types.StringValue("infra"), types.StringNull(), named},
+		{"resolved path", types.StringValue("/repositories/infra"), types.StringNull(), named},
+		{"default path", types.StringNull(), types.StringNull(), named},
+		{"other path", types.StringValue("/repositories/other"), types.StringNull(), nil},
+		{"credentials", types.StringValue("infra"), types.StringValue("other"), &githttp.BasicAuth{Username: "other"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, p.repositoryAuth(c.path, c.username, types.StringNull()))
+		})
+	}
+}
+
+func TestIsProtectedRef(t *testing.T) {
+	p := &GitProviderData{ProtectedRefs: []string{"refs/heads/main", "refs/tags/v*"}}
+
+	cases := []struct {
+		name     plumbing.ReferenceName
+		expected bool
+	}{
+		{"refs/heads/main", true},
+		{"refs/heads/main2", false},
+		{"refs/heads/feature/main", false},
+		{"refs/tags/v1.0.0", true},
+		{"refs/tags/v1/rc", true},
+		{"refs/tags/1.0.0", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name.String(), func(t *testing.T) {
+			assert.Equal(t, c.expected, p.isProtectedRef(c.name))
+		})
+	}
+
+	var unconfigured *GitProviderData
+	assert.False(t, unconfigured.isProtectedRef("refs/heads/main"))
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// refUpdate is a reference a resource changed, on remote when it is set.
+// Old is the zero hash for a created reference, New for a deleted one.
+type refUpdate struct {
+	Remote string
+	Name   plumbing.ReferenceName
+	Old    plumbing.Hash
+	New    plumbing.Hash
+}
+
+// refUpdateAttrTypes are the attributes of the elements of ref_updates.
+var refUpdateAttrTypes = map[string]attr.Type{
+	"remote": types.StringType,
+	"ref":    types.StringType,
+	"old":    types.StringType,
+	"new":    types.StringType,
+}
+
+// refUpdatesAttribute is the schema of ref_updates, the references changed by
+// the last create or update of a resource.
+func refUpdatesAttribute(modifiers ...planmodifier.List) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "References changed by the last create or update, local ones first, with the commit or tag " +
+			"they pointed at before and after, ie. to notify or roll back",
+		Computed:      true,
+		PlanModifiers: modifiers,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"remote": schema.StringAttribute{
+					MarkdownDescription: "Remote the reference was pushed to, empty for local references",
+					Computed:            true,
+				},
+				"ref": schema.StringAttribute{
+					MarkdownDescription: "Full name of the reference (ie. `refs/heads/main`)",
+					Computed:            true,
+				},
+				"old": schema.StringAttribute{
+					MarkdownDescription: "Hash the reference pointed at before, empty when it was created",
+					Computed:            true,
+				},
+				"new": schema.StringAttribute{
+					MarkdownDescription: "Hash the reference points at now, empty when it was deleted",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// refUpdatesValue returns the value of ref_updates for updates.
+func refUpdatesValue(updates []refUpdate) types.List {
+	elementType := types.ObjectType{AttrTypes: refUpdateAttrTypes}
+
+	elements := make([]attr.Value, 0, len(updates))
+	for _, update := range updates {
+		elements = append(elements, types.ObjectValueMust(refUpdateAttrTypes, map[string]attr.Value{
+			"remote": types.StringValue(update.Remote),
+			"ref":    types.StringValue(update.Name.String()),
+			"old":    types.StringValue(hashValue(update.Old)),
+			"new":    types.StringValue(hashValue(update.New)),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements)
+}
+
+// refUpdatesUnknown returns the value of ref_updates to plan when a resource
+// is going to move references outside of a configuration change.
+func refUpdatesUnknown() types.List {
+	return types.ListUnknown(types.ObjectType{AttrTypes: refUpdateAttrTypes})
+}
+
+// hashValue returns hash as a string, empty for the zero hash.
+func hashValue(hash plumbing.Hash) string {
+	if hash.IsZero() {
+		return ""
+	}
+	return hash.String()
+}
+
+// refSnapshot holds the hashes the references of a repository point at, to
+// tell which ones an operation changed.
+type refSnapshot map[plumbing.ReferenceName]plumbing.Hash
+
+// snapshotRefs returns the hashes the references of repo point at, symbolic
+// references such as HEAD excepted.
+func snapshotRefs(repo *git.Repository) (refSnapshot, error) {
+	iter, err := repo.Storer.IterReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := refSnapshot{}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference {
+			snapshot[ref.Name()] = ref.Hash()
+		}
+		return nil
+	})
+
+	return snapshot, err
+}
+
+// updates returns the references of repo changed since the snapshot, by name.
+func (s refSnapshot) updates(repo *git.Repository) ([]refUpdate, error) {
+	current, err := snapshotRefs(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []refUpdate
+	for name, hash := range current {
+		if s[name] != hash {
+			updates = append(updates, refUpdate{Name: name, Old: s[name], New: hash})
+		}
+	}
+	for name, hash := range s {
+		if _, ok := current[name]; !ok {
+			updates = append(updates, refUpdate{Name: name, Old: hash})
+		}
+	}
+
+	sort.Slice(updates, func(i, j int) bool { return updates[i].Name < updates[j].Name })
+
+	return updates, nil
+}
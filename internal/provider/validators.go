@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// oneOfValidator validates that a string attribute is set to one of values.
+type oneOfValidator struct {
+	values []string
+}
+
+var _ validator.String = oneOfValidator{}
+
+// stringOneOf returns a validator rejecting string values other than values,
+// null and unknown values are left for the attribute to handle.
+func stringOneOf(values ...string) validator.String {
+	return oneOfValidator{values: values}
+}
+
+func (v oneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("invalid %s", req.Path.String()),
+		fmt.Sprintf("unsupported %s %q, expected one of: %s", req.Path.String(), value, strings.Join(v.values, ", ")))
+}
@@ -0,0 +1,61 @@
+package git
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// hashPrefixer is implemented by the storages able to list object hashes by
+// prefix without reading the objects.
+type hashPrefixer interface {
+	HashesWithPrefix(prefix []byte) ([]plumbing.Hash, error)
+}
+
+// UniqueAbbrev returns the shortest abbreviation of hash, at least minLength
+// hexadecimal digits long, that no other object of repo starts with, like the
+// abbreviations `git rev-parse --short` prints.
+func UniqueAbbrev(repo *git.Repository, hash plumbing.Hash, minLength int) (string, error) {
+	full := hash.String()
+	if minLength >= len(full) {
+		return full, nil
+	}
+
+	s, ok := repo.Storer.(hashPrefixer)
+	if !ok {
+		return "", fmt.Errorf("storage %T can not list objects by prefix", repo.Storer)
+	}
+
+	// every colliding object shares at least the first byte
+	hashes, err := s.HashesWithPrefix(hash[:1])
+	if err != nil {
+		return "", err
+	}
+
+	length := minLength
+	for _, other := range hashes {
+		if other == hash {
+			continue
+		}
+
+		common := commonPrefixLength(full, other.String())
+		if common+1 > length {
+			length = common + 1
+		}
+	}
+
+	if length > len(full) {
+		length = len(full)
+	}
+
+	return full[:length], nil
+}
+
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,179 @@
+package git
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// maxAlternatesDepth limits how many alternates are followed from one another,
+// the same limit as git.
+const maxAlternatesDepth = 5
+
+// UseAlternates makes repo look up the objects it is missing in the object
+// databases listed in objects/info/alternates, as used by `git clone
+// --shared` and forks on deduplicated hosting.
+//
+// go-git follows alternates on its own but re-reads the file and reopens the
+// alternate packs for every object it misses, which makes walking the history
+// of a fork crawl, ignores them for size lookups and only resolves relative
+// entries starting with `../`. The alternate databases are opened once here
+// instead and hidden from go-git.
+func UseAlternates(repo *git.Repository) error {
+	s, ok := repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return nil
+	}
+
+	alternates, err := readAlternates(s.Filesystem(), 0)
+	if err != nil || len(alternates) == 0 {
+		return err
+	}
+
+	repo.Storer = &alternatesStorage{
+		Storer:     filesystem.NewStorage(&noAlternatesFS{Filesystem: s.Filesystem()}, cache.NewObjectLRUDefault()),
+		alternates: alternates,
+	}
+	return nil
+}
+
+// readAlternates opens the object databases listed in the alternates file of
+// the git directory fs, themselves following their own alternates.
+func readAlternates(fs billy.Filesystem, depth int) ([]storage.Storer, error) {
+	if depth >= maxAlternatesDepth {
+		return nil, nil
+	}
+
+	objectsDir := filepath.Join(fs.Root(), "objects")
+
+	f, err := os.Open(filepath.Join(objectsDir, "info", "alternates"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var alternates []storage.Storer
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// relative entries are relative to the objects directory
+		dir := filepath.FromSlash(line)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(objectsDir, dir)
+		}
+
+		alternateFS := osfs.New(filepath.Dir(filepath.Clean(dir)))
+
+		nested, err := readAlternates(alternateFS, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		alternates = append(alternates, &alternatesStorage{
+			Storer:     filesystem.NewStorage(&noAlternatesFS{Filesystem: alternateFS}, cache.NewObjectLRUDefault()),
+			alternates: nested,
+		})
+	}
+
+	return alternates, scanner.Err()
+}
+
+// noAlternatesFS hides the alternates file of a git directory from go-git.
+type noAlternatesFS struct {
+	billy.Filesystem
+}
+
+func (fs *noAlternatesFS) Open(filename string) (billy.File, error) {
+	if filepath.ToSlash(filepath.Clean(filename)) == "objects/info/alternates" {
+		return nil, os.ErrNotExist
+	}
+	return fs.Filesystem.Open(filename)
+}
+
+// alternatesStorage falls back to the alternate object databases for objects
+// missing from the repository.
+type alternatesStorage struct {
+	storage.Storer
+	alternates []storage.Storer
+}
+
+func (s *alternatesStorage) EncodedObject(t plumbing.ObjectType, h plumbing.Hash) (plumbing.EncodedObject, error) {
+	obj, err := s.Storer.EncodedObject(t, h)
+	if err != plumbing.ErrObjectNotFound {
+		return obj, err
+	}
+
+	for _, alternate := range s.alternates {
+		if obj, err := alternate.EncodedObject(t, h); err == nil {
+			return obj, nil
+		}
+	}
+
+	return nil, plumbing.ErrObjectNotFound
+}
+
+func (s *alternatesStorage) HasEncodedObject(h plumbing.Hash) error {
+	err := s.Storer.HasEncodedObject(h)
+	if err != plumbing.ErrObjectNotFound {
+		return err
+	}
+
+	for _, alternate := range s.alternates {
+		if alternate.HasEncodedObject(h) == nil {
+			return nil
+		}
+	}
+
+	return plumbing.ErrObjectNotFound
+}
+
+func (s *alternatesStorage) EncodedObjectSize(h plumbing.Hash) (int64, error) {
+	size, err := s.Storer.EncodedObjectSize(h)
+	if err != plumbing.ErrObjectNotFound {
+		return size, err
+	}
+
+	for _, alternate := range s.alternates {
+		if size, err := alternate.EncodedObjectSize(h); err == nil {
+			return size, nil
+		}
+	}
+
+	return 0, plumbing.ErrObjectNotFound
+}
+
+func (s *alternatesStorage) HashesWithPrefix(prefix []byte) ([]plumbing.Hash, error) {
+	var hashes []plumbing.Hash
+
+	for _, st := range append([]storage.Storer{s.Storer}, s.alternates...) {
+		prefixer, ok := st.(hashPrefixer)
+		if !ok {
+			continue
+		}
+
+		found, err := prefixer.HashesWithPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, found...)
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,224 @@
+package git
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ArchiveFormats lists the formats supported by WriteArchive.
+var ArchiveFormats = []string{"tar", "tar.gz", "zip"}
+
+// ArchiveOptions ...
+//
+// Archives are reproducible, the same tree and options always produce the
+// same bytes: entries are written in tree order, every entry carries ModTime
+// and is owned by root, and the gzip header has no name or timestamp.
+type ArchiveOptions struct {
+	// Format is one of ArchiveFormats.
+	Format string
+	// Prefix is prepended to every path in the archive, like
+	// `git archive --prefix`.
+	Prefix string
+	// ModTime is recorded for every entry, `git archive` uses the commit
+	// time.
+	ModTime time.Time
+	// Exclude leaves out the entries it returns true for, names are relative
+	// to the archived tree.
+	Exclude func(name string) bool
+}
+
+// ArchiveFormat infers the archive format from the extension of name the way
+// `git archive --output` does, defaulting to tar.
+func ArchiveFormat(name string) string {
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return "zip"
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return "tar.gz"
+	}
+	return "tar"
+}
+
+// WriteArchive writes the content of tree to w.
+func WriteArchive(w io.Writer, tree *object.Tree, opts ArchiveOptions) error {
+	// sub-second precision and time zones would otherwise leak into the tar
+	// pax records and the zip timestamps
+	opts.ModTime = opts.ModTime.UTC().Truncate(time.Second)
+
+	switch opts.Format {
+	case "tar":
+		return writeTar(w, tree, opts)
+	case "tar.gz":
+		// the zero header omits the name and timestamp
+		gw := gzip.NewWriter(w)
+		if err := writeTar(gw, tree, opts); err != nil {
+			return err
+		}
+		return gw.Close()
+	case "zip":
+		return writeZip(w, tree, opts)
+	}
+
+	return fmt.Errorf("unsupported archive format %q, expected one of: %s", opts.Format, strings.Join(ArchiveFormats, ", "))
+}
+
+// walkArchive calls fn for every entry of tree in order, directories before
+// their content. Git keeps tree entries sorted so the order is stable.
+// Submodules are skipped as their content is not part of the repository, as
+// are the entries exclude returns true for.
+func walkArchive(tree *object.Tree, exclude func(name string) bool, fn func(name string, entry object.TreeEntry) error) error {
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+
+	for {
+		name, entry, err := walker.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if entry.Mode == filemode.Submodule {
+			continue
+		}
+		if exclude != nil && exclude(name) {
+			continue
+		}
+
+		if err := fn(name, entry); err != nil {
+			return err
+		}
+	}
+}
+
+func readArchiveBlob(tree *object.Tree, name string) (*object.File, io.ReadCloser, error) {
+	file, err := tree.File(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, err := file.Reader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return file, r, nil
+}
+
+func writeTar(w io.Writer, tree *object.Tree, opts ArchiveOptions) error {
+	tw := tar.NewWriter(w)
+
+	if err := walkArchive(tree, opts.Exclude, func(name string, entry object.TreeEntry) error {
+		header := &tar.Header{
+			Name:    path.Join(opts.Prefix, name),
+			ModTime: opts.ModTime,
+			Uname:   "root",
+			Gname:   "root",
+			Format:  tar.FormatPAX,
+		}
+
+		switch entry.Mode {
+		case filemode.Dir:
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+			header.Mode = 0755
+			return tw.WriteHeader(header)
+		case filemode.Symlink:
+			file, r, err := readArchiveBlob(tree, name)
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+
+			target, err := io.ReadAll(io.LimitReader(r, file.Size))
+			if err != nil {
+				return err
+			}
+
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = string(target)
+			header.Mode = 0777
+			return tw.WriteHeader(header)
+		}
+
+		file, r, err := readArchiveBlob(tree, name)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		header.Typeflag = tar.TypeReg
+		header.Size = file.Size
+		header.Mode = 0644
+		if entry.Mode == filemode.Executable {
+			header.Mode = 0755
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		_, err = io.Copy(tw, r)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func writeZip(w io.Writer, tree *object.Tree, opts ArchiveOptions) error {
+	zw := zip.NewWriter(w)
+
+	if err := walkArchive(tree, opts.Exclude, func(name string, entry object.TreeEntry) error {
+		header := &zip.FileHeader{
+			Name:     path.Join(opts.Prefix, name),
+			Method:   zip.Deflate,
+			Modified: opts.ModTime,
+		}
+
+		switch entry.Mode {
+		case filemode.Dir:
+			header.Name += "/"
+			header.Method = zip.Store
+			header.SetMode(os.ModeDir | 0755)
+			_, err := zw.CreateHeader(header)
+			return err
+		case filemode.Symlink:
+			header.SetMode(os.ModeSymlink | 0777)
+		case filemode.Executable:
+			header.SetMode(0755)
+		default:
+			header.SetMode(0644)
+		}
+
+		_, r, err := readArchiveBlob(tree, name)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(fw, r)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
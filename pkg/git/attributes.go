@@ -0,0 +1,171 @@
+package git
+
+import (
+	"io"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitattributes"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const gitattributesFile = ".gitattributes"
+
+// ExportIgnore matches the paths `git archive` leaves out because of the
+// export-ignore attribute.
+type ExportIgnore struct {
+	matcher gitattributes.Matcher
+}
+
+// NewExportIgnore reads the .gitattributes files of root, the root tree of a
+// commit, like `git archive` does.
+func NewExportIgnore(root *object.Tree) (*ExportIgnore, error) {
+	attributes, err := readTreeAttributesStack(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportIgnore{matcher: gitattributes.NewMatcher(attributes)}, nil
+}
+
+// readTreeAttributesStack reads every .gitattributes file of root in
+// ascending order of priority.
+func readTreeAttributesStack(root *object.Tree) ([]gitattributes.MatchAttribute, error) {
+	var attributes []gitattributes.MatchAttribute
+
+	walker := object.NewTreeWalker(root, true, nil)
+	defer walker.Close()
+
+	// Trees are walked parents first so deeper files, which take precedence,
+	// end up later in the stack.
+	for {
+		name, entry, err := walker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if entry.Name != gitattributesFile || !entry.Mode.IsFile() {
+			continue
+		}
+
+		attrs, err := readTreeAttributes(root, name)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, attrs...)
+	}
+
+	return attributes, nil
+}
+
+func readTreeAttributes(root *object.Tree, name string) ([]gitattributes.MatchAttribute, error) {
+	file, err := root.File(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := file.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var domain []string
+	if dir := path.Dir(name); dir != "." {
+		domain = strings.Split(dir, "/")
+	}
+
+	// only the top level file may define macros
+	return gitattributes.ReadAttributes(r, domain, domain == nil)
+}
+
+// Match reports whether name, relative to the root tree, or one of its parent
+// directories has the export-ignore attribute set.
+func (e *ExportIgnore) Match(name string) bool {
+	parts := strings.Split(name, "/")
+	for i := range parts {
+		results, _ := e.matcher.Match(parts[:i+1], []string{"export-ignore"})
+		if attr, ok := results["export-ignore"]; ok && attr.IsSet() {
+			return true
+		}
+	}
+	return false
+}
+
+// Attributes evaluates the .gitattributes files of a tree like
+// `git check-attr --source`.
+type Attributes struct {
+	stack  []gitattributes.MatchAttribute
+	macros map[string]gitattributes.MatchAttribute
+}
+
+// binaryMacro is the macro git defines for every repository.
+const binaryMacro = "[attr]binary -diff -merge -text"
+
+// NewAttributes reads the .gitattributes files of root, the root tree of a
+// commit.
+func NewAttributes(root *object.Tree) (*Attributes, error) {
+	stack, err := readTreeAttributesStack(root)
+	if err != nil {
+		return nil, err
+	}
+
+	binary, err := gitattributes.ParseAttributesLine(binaryMacro, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Attributes{
+		stack:  stack,
+		macros: map[string]gitattributes.MatchAttribute{binary.Name: binary},
+	}
+	for _, attr := range stack {
+		if attr.Pattern == nil {
+			a.macros[attr.Name] = attr
+		}
+	}
+
+	return a, nil
+}
+
+// Match returns the attributes of name, a path relative to the root tree,
+// keyed by attribute name. Values are `set`, `unset` or the value assigned to
+// the attribute, unspecified attributes are left out. Later lines and deeper
+// files take precedence, macros expand to their attributes.
+func (a *Attributes) Match(name string) map[string]string {
+	parts := strings.Split(strings.Trim(name, "/"), "/")
+
+	results := map[string]string{}
+	for _, line := range a.stack {
+		if line.Pattern == nil || !line.Pattern.Match(parts) {
+			continue
+		}
+
+		for _, attr := range line.Attributes {
+			if macro, ok := a.macros[attr.Name()]; ok && attr.IsSet() {
+				for _, expanded := range macro.Attributes {
+					setAttribute(results, expanded)
+				}
+			}
+			setAttribute(results, attr)
+		}
+	}
+
+	return results
+}
+
+func setAttribute(results map[string]string, attr gitattributes.Attribute) {
+	switch {
+	case attr.IsSet():
+		results[attr.Name()] = "set"
+	case attr.IsUnset():
+		results[attr.Name()] = "unset"
+	case attr.IsValueSet():
+		results[attr.Name()] = attr.Value()
+	default:
+		delete(results, attr.Name())
+	}
+}
@@ -0,0 +1,148 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// BlameLine ...
+type BlameLine struct {
+	Hash plumbing.Hash
+	Text string
+}
+
+// Blame attributes every line of the file at path to the commit that last
+// changed it. Lines are passed on to the first parent that contains them
+// unchanged, lines no parent contains are attributed to the commit itself.
+// Renames are not followed.
+func Blame(c *object.Commit, path string) ([]BlameLine, error) {
+	type pendingCommit struct {
+		commit  *object.Commit
+		content string
+		// lines maps a line of content to the result lines it ends up as
+		lines map[int][]int
+	}
+
+	file, err := c.File(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []BlameLine{}
+	lines := map[int][]int{}
+	for i, text := range splitLines(content) {
+		result = append(result, BlameLine{Text: text})
+		lines[i] = []int{i}
+	}
+
+	queue := map[plumbing.Hash]*pendingCommit{
+		c.Hash: {commit: c, content: content, lines: lines},
+	}
+	for len(queue) > 0 {
+		// Handle the most recent commit first so lines from several children
+		// are usually gathered before a commit is visited.
+		var current *pendingCommit
+		for _, p := range queue {
+			if current == nil || p.commit.Committer.When.After(current.commit.Committer.When) {
+				current = p
+			}
+		}
+		delete(queue, current.commit.Hash)
+
+		remaining := current.lines
+		err := current.commit.Parents().ForEach(func(parent *object.Commit) error {
+			if len(remaining) == 0 {
+				return nil
+			}
+
+			parentFile, err := parent.File(path)
+			if err == object.ErrFileNotFound {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			parentContent, err := parentFile.Contents()
+			if err != nil {
+				return err
+			}
+
+			mapping := lineMapping(parentContent, current.content)
+			passed := map[int][]int{}
+			for line, targets := range remaining {
+				if parentLine, ok := mapping[line]; ok {
+					passed[parentLine] = append(passed[parentLine], targets...)
+					delete(remaining, line)
+				}
+			}
+			if len(passed) == 0 {
+				return nil
+			}
+
+			if p, ok := queue[parent.Hash]; ok {
+				for line, targets := range passed {
+					p.lines[line] = append(p.lines[line], targets...)
+				}
+			} else {
+				queue[parent.Hash] = &pendingCommit{commit: parent, content: parentContent, lines: passed}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, targets := range remaining {
+			for _, target := range targets {
+				result[target].Hash = current.commit.Hash
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// lineMapping maps the lines of dst to the lines of src they are unchanged from.
+func lineMapping(src string, dst string) map[int]int {
+	mapping := map[int]int{}
+	srcLine, dstLine := 0, 0
+	for _, d := range diff.Do(src, dst) {
+		n := countLines(d.Text)
+		switch d.Type {
+		case diffmatchpatch.DiffEqual:
+			for i := 0; i < n; i++ {
+				mapping[dstLine+i] = srcLine + i
+			}
+			srcLine += n
+			dstLine += n
+		case diffmatchpatch.DiffDelete:
+			srcLine += n
+		case diffmatchpatch.DiffInsert:
+			dstLine += n
+		}
+	}
+	return mapping
+}
+
+func countLines(text string) int {
+	n := strings.Count(text, "\n")
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		n++
+	}
+	return n
+}
+
+func splitLines(content string) []string {
+	if content == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
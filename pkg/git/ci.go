@@ -0,0 +1,149 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CIEnvironment is the build metadata a CI system exposes through environment
+// variables, normalized across systems. Values a system does not expose are
+// empty.
+type CIEnvironment struct {
+	// System identifies the CI system (ie. `github_actions`), `generic`
+	// when only CI=true is set and empty outside of CI.
+	System     string
+	RunID      string
+	Actor      string
+	Repository string
+	Commit     string
+	// Ref is the full name of the reference being built (ie.
+	// `refs/heads/main`).
+	Ref         string
+	PullRequest int64
+	BaseRef     string
+	HeadRef     string
+}
+
+// DetectCI reads the environment of the CI system running the process through
+// getenv, usually os.Getenv.
+func DetectCI(getenv func(string) string) CIEnvironment {
+	switch {
+	case getenv("GITHUB_ACTIONS") == "true":
+		env := CIEnvironment{
+			System:     "github_actions",
+			RunID:      getenv("GITHUB_RUN_ID"),
+			Actor:      getenv("GITHUB_ACTOR"),
+			Repository: getenv("GITHUB_REPOSITORY"),
+			Commit:     getenv("GITHUB_SHA"),
+			Ref:        getenv("GITHUB_REF"),
+			BaseRef:    getenv("GITHUB_BASE_REF"),
+			HeadRef:    getenv("GITHUB_HEAD_REF"),
+		}
+		// Pull requests are built from refs/pull/<number>/merge.
+		if parts := strings.Split(env.Ref, "/"); len(parts) == 4 && parts[1] == "pull" {
+			env.PullRequest = parsePullRequest(parts[2])
+		}
+		return env
+	case getenv("GITLAB_CI") == "true":
+		env := CIEnvironment{
+			System:      "gitlab_ci",
+			RunID:       getenv("CI_PIPELINE_ID"),
+			Actor:       getenv("GITLAB_USER_LOGIN"),
+			Repository:  getenv("CI_PROJECT_PATH"),
+			Commit:      getenv("CI_COMMIT_SHA"),
+			Ref:         ciRef(getenv("CI_COMMIT_BRANCH"), getenv("CI_COMMIT_TAG")),
+			PullRequest: parsePullRequest(getenv("CI_MERGE_REQUEST_IID")),
+			BaseRef:     getenv("CI_MERGE_REQUEST_TARGET_BRANCH_NAME"),
+			HeadRef:     getenv("CI_MERGE_REQUEST_SOURCE_BRANCH_NAME"),
+		}
+		if env.Ref == "" && env.HeadRef != "" {
+			env.Ref = "refs/heads/" + env.HeadRef
+		}
+		return env
+	case getenv("CIRCLECI") == "true":
+		env := CIEnvironment{
+			System:      "circleci",
+			RunID:       getenv("CIRCLE_WORKFLOW_ID"),
+			Actor:       getenv("CIRCLE_USERNAME"),
+			Commit:      getenv("CIRCLE_SHA1"),
+			Ref:         ciRef(getenv("CIRCLE_BRANCH"), getenv("CIRCLE_TAG")),
+			PullRequest: parsePullRequest(getenv("CIRCLE_PR_NUMBER")),
+		}
+		if user, repo := getenv("CIRCLE_PROJECT_USERNAME"), getenv("CIRCLE_PROJECT_REPONAME"); user != "" && repo != "" {
+			env.Repository = user + "/" + repo
+		}
+		// CIRCLE_PR_NUMBER is only set for forks, the pull request URL is
+		// set for every pull request.
+		if url := getenv("CIRCLE_PULL_REQUEST"); env.PullRequest == 0 && url != "" {
+			env.PullRequest = parsePullRequest(url[strings.LastIndex(url, "/")+1:])
+		}
+		if env.PullRequest != 0 {
+			env.HeadRef = getenv("CIRCLE_BRANCH")
+		}
+		return env
+	case getenv("BUILDKITE") == "true":
+		env := CIEnvironment{
+			System:      "buildkite",
+			RunID:       getenv("BUILDKITE_BUILD_ID"),
+			Actor:       getenv("BUILDKITE_BUILD_CREATOR"),
+			Repository:  getenv("BUILDKITE_REPO"),
+			Commit:      getenv("BUILDKITE_COMMIT"),
+			Ref:         ciRef(getenv("BUILDKITE_BRANCH"), getenv("BUILDKITE_TAG")),
+			PullRequest: parsePullRequest(getenv("BUILDKITE_PULL_REQUEST")),
+		}
+		if env.PullRequest != 0 {
+			env.BaseRef = getenv("BUILDKITE_PULL_REQUEST_BASE_BRANCH")
+			env.HeadRef = getenv("BUILDKITE_BRANCH")
+		}
+		return env
+	case getenv("TF_BUILD") == "True":
+		return CIEnvironment{
+			System:      "azure_pipelines",
+			RunID:       getenv("BUILD_BUILDID"),
+			Actor:       getenv("BUILD_REQUESTEDFOR"),
+			Repository:  getenv("BUILD_REPOSITORY_NAME"),
+			Commit:      getenv("BUILD_SOURCEVERSION"),
+			Ref:         getenv("BUILD_SOURCEBRANCH"),
+			PullRequest: parsePullRequest(getenv("SYSTEM_PULLREQUEST_PULLREQUESTNUMBER")),
+			BaseRef:     strings.TrimPrefix(getenv("SYSTEM_PULLREQUEST_TARGETBRANCH"), "refs/heads/"),
+			HeadRef:     strings.TrimPrefix(getenv("SYSTEM_PULLREQUEST_SOURCEBRANCH"), "refs/heads/"),
+		}
+	case getenv("JENKINS_URL") != "":
+		return CIEnvironment{
+			System:      "jenkins",
+			RunID:       getenv("BUILD_TAG"),
+			Repository:  getenv("GIT_URL"),
+			Commit:      getenv("GIT_COMMIT"),
+			Ref:         ciRef(strings.TrimPrefix(getenv("GIT_BRANCH"), "origin/"), getenv("TAG_NAME")),
+			PullRequest: parsePullRequest(getenv("CHANGE_ID")),
+			BaseRef:     getenv("CHANGE_TARGET"),
+			HeadRef:     getenv("CHANGE_BRANCH"),
+		}
+	case getenv("CI") == "true":
+		return CIEnvironment{System: "generic"}
+	}
+
+	return CIEnvironment{}
+}
+
+// ciRef returns the full reference name of a build of branch or tag, tags
+// take precedence as some systems also set the branch for tag builds.
+func ciRef(branch string, tag string) string {
+	switch {
+	case tag != "":
+		return "refs/tags/" + tag
+	case branch != "":
+		return "refs/heads/" + branch
+	}
+	return ""
+}
+
+// parsePullRequest parses a pull request number, values that are not a
+// positive number (ie. `false` on Buildkite) are no pull request.
+func parsePullRequest(s string) int64 {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
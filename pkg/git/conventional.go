@@ -0,0 +1,236 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Bump is the part of a version a set of changes increments.
+type Bump int
+
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+// String returns the name of the bump, `none`, `patch`, `minor` or `major`.
+func (b Bump) String() string {
+	switch b {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	}
+	return "none"
+}
+
+// ConventionalCommit is the header of a commit message following
+// https://www.conventionalcommits.org, ie. `feat(api)!: drop v1 endpoints`.
+type ConventionalCommit struct {
+	Type    string
+	Scope   string
+	Subject string
+	// Breaking is set by a `!` after the type or scope, or a `BREAKING CHANGE`
+	// footer.
+	Breaking bool
+	// Body is the free form text between the header and the footers.
+	Body string
+	// Footers are the `Token: value` or `Token #value` lines ending the
+	// message, ie. `BREAKING CHANGE: v1 endpoints are gone` or `Refs #12`.
+	Footers []Trailer
+}
+
+var (
+	conventionalHeaderRegexp   = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^()]*)\))?(!)?: (.+)$`)
+	conventionalBreakingRegexp = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+	conventionalFooterRegexp   = regexp.MustCompile(`^(BREAKING[ -]CHANGE|[A-Za-z0-9][A-Za-z0-9-]*)(?:: | #)(.*)$`)
+)
+
+// ParseConventionalCommit parses the commit message, returning nil when it
+// does not follow Conventional Commits.
+func ParseConventionalCommit(message string) *ConventionalCommit {
+	header := strings.TrimSpace(strings.SplitN(message, "\n", 2)[0])
+
+	match := conventionalHeaderRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return nil
+	}
+
+	body, footers := parseConventionalBody(message)
+
+	return &ConventionalCommit{
+		Type:     strings.ToLower(match[1]),
+		Scope:    match[2],
+		Subject:  match[4],
+		Breaking: match[3] == "!" || conventionalBreakingRegexp.MatchString(message),
+		Body:     body,
+		Footers:  footers,
+	}
+}
+
+// parseConventionalBody splits the message after its header into the body and
+// the footers. The footers start with the first paragraph opening with a
+// footer token, a value runs until the next token so it may span several
+// lines.
+func parseConventionalBody(message string) (string, []Trailer) {
+	parts := strings.SplitN(strings.ReplaceAll(message, "\r\n", "\n"), "\n", 2)
+	if len(parts) == 1 {
+		return "", nil
+	}
+
+	paragraphs := strings.Split(strings.Trim(parts[1], "\n"), "\n\n")
+
+	var body []string
+	var footers []Trailer
+	for i, paragraph := range paragraphs {
+		lines := strings.Split(paragraph, "\n")
+		if footers == nil && !conventionalFooterRegexp.MatchString(lines[0]) {
+			body = append(body, paragraph)
+			continue
+		}
+
+		if i > len(body) {
+			// blank lines within a footer value are kept
+			last := &footers[len(footers)-1]
+			last.Value += "\n"
+		}
+		for _, line := range lines {
+			if match := conventionalFooterRegexp.FindStringSubmatch(line); match != nil {
+				footers = append(footers, Trailer{Key: match[1], Value: match[2]})
+				continue
+			}
+			last := &footers[len(footers)-1]
+			last.Value += "\n" + line
+		}
+	}
+
+	for i := range footers {
+		footers[i].Value = strings.TrimSpace(footers[i].Value)
+	}
+
+	return strings.TrimSpace(strings.Join(body, "\n\n")), footers
+}
+
+// ConventionalBumpOptions lists the commit types triggering a minor and a
+// patch release, breaking changes always trigger a major release.
+type ConventionalBumpOptions struct {
+	MinorTypes []string
+	PatchTypes []string
+}
+
+// Bump returns the bump the commit calls for.
+func (c *ConventionalCommit) Bump(opts ConventionalBumpOptions) Bump {
+	if c.Breaking {
+		return BumpMajor
+	}
+	for _, t := range opts.MinorTypes {
+		if strings.EqualFold(c.Type, t) {
+			return BumpMinor
+		}
+	}
+	for _, t := range opts.PatchTypes {
+		if strings.EqualFold(c.Type, t) {
+			return BumpPatch
+		}
+	}
+	return BumpNone
+}
+
+// Less reports whether v precedes v2, ignoring the prefix, prerelease and
+// build metadata.
+func (v SemVer) Less(v2 SemVer) bool {
+	if v.Major != v2.Major {
+		return v.Major < v2.Major
+	}
+	if v.Minor != v2.Minor {
+		return v.Minor < v2.Minor
+	}
+	return v.Patch < v2.Patch
+}
+
+// Increment returns the version following v for the bump, without prerelease
+// or build metadata.
+func (v SemVer) Increment(b Bump) SemVer {
+	next := SemVer{Prefix: v.Prefix, Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+
+	switch b {
+	case BumpMajor:
+		next.Major, next.Minor, next.Patch = v.Major+1, 0, 0
+	case BumpMinor:
+		next.Minor, next.Patch = v.Minor+1, 0
+	case BumpPatch:
+		next.Patch = v.Patch + 1
+	default:
+		next.Prerelease = append([]string{}, v.Prerelease...)
+		next.BuildMetadata = append([]string{}, v.BuildMetadata...)
+	}
+
+	return next
+}
+
+// ReleaseTag is a tag naming a release version.
+type ReleaseTag struct {
+	Name    string
+	Version *SemVer
+	Commit  *object.Commit
+}
+
+// LatestReleaseTag returns the highest release version tagged on c or one of
+// its ancestors, nil when there is none. Only tags parsing as versions without
+// prerelease and matching one of the match glob patterns, when given, are
+// considered.
+func LatestReleaseTag(repo *git.Repository, c *object.Commit, match []string) (*ReleaseTag, error) {
+	iter, err := repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	releases := map[plumbing.Hash][]ReleaseTag{}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if !describeMatch(match, nil, name) {
+			return nil
+		}
+
+		version := SemVerParse(name)
+		if version == nil || len(version.Prerelease) > 0 {
+			return nil
+		}
+
+		target := ref.Hash()
+		if obj, err := repo.TagObject(ref.Hash()); err == nil {
+			target = obj.Target
+		} else if err != plumbing.ErrObjectNotFound {
+			return err
+		}
+
+		releases[target] = append(releases[target], ReleaseTag{Name: name, Version: version})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *ReleaseTag
+	err = object.NewCommitPreorderIter(c, nil, nil).ForEach(func(commit *object.Commit) error {
+		for _, release := range releases[commit.Hash] {
+			if latest == nil || latest.Version.Less(*release.Version) {
+				latest = &ReleaseTag{Name: release.Name, Version: release.Version, Commit: commit}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return latest, nil
+}
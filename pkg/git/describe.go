@@ -0,0 +1,342 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// ErrNoDescribeNames is returned by DescribeCommit when no tag can describe
+// the commit.
+var ErrNoDescribeNames = fmt.Errorf("no names found, cannot describe anything")
+
+// describeCandidates is the number of tags considered before picking the one
+// with the smallest distance, the same default as `git describe`.
+const describeCandidates = 10
+
+// DescribeCommitOptions ...
+type DescribeCommitOptions struct {
+	// Match and Exclude are glob patterns tag names must, and must not,
+	// match.
+	Match   []string
+	Exclude []string
+	// Tags considers lightweight tags as well as annotated ones.
+	Tags bool
+	// FirstParent only follows the first parent of merge commits.
+	FirstParent bool
+}
+
+// DescribeResult ...
+type DescribeResult struct {
+	// Tag is empty when no tag describes the commit.
+	Tag      string
+	Distance int
+	Hash     plumbing.Hash
+}
+
+// Format renders the result like `git describe`, abbrev 0 only renders the
+// tag, or the full hash without one, and long renders the distance and hash
+// even for an exact match.
+func (r *DescribeResult) Format(abbrev int, long bool) string {
+	hash := r.Hash.String()
+	if abbrev > 0 && abbrev < len(hash) {
+		hash = hash[:abbrev]
+	}
+
+	switch {
+	case r.Tag == "":
+		return hash
+	case abbrev == 0:
+		return r.Tag
+	case r.Distance == 0 && !long:
+		return r.Tag
+	}
+
+	return fmt.Sprintf("%s-%d-g%s", r.Tag, r.Distance, hash)
+}
+
+type describeTag struct {
+	name      string
+	annotated bool
+	date      int64
+}
+
+// DescribeCommit finds the tag closest to c, the tag whose commit has the
+// fewest commits between it and c. Returns ErrNoDescribeNames when there is
+// no such tag.
+func DescribeCommit(repo *git.Repository, c *object.Commit, opts DescribeCommitOptions) (*DescribeResult, error) {
+	tags, err := describeTags(repo, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return describeWithTags(c, tags, opts.FirstParent)
+}
+
+// DescribePath describes c for a component of a monorepo living under paths:
+// only tags starting with prefix followed by a version (ie. `api/v1.2.3` for
+// the prefix `api/`) are considered, and only the commits touching one of
+// paths count towards the distance. Returns ErrNoDescribeNames when there is
+// no such tag.
+func DescribePath(repo *git.Repository, c *object.Commit, prefix string, paths []string) (*DescribeResult, error) {
+	tags, err := describeTags(repo, DescribeCommitOptions{Match: []string{prefix + "*"}, Tags: true})
+	if err != nil {
+		return nil, err
+	}
+	for hash, tag := range tags {
+		if !strings.HasPrefix(tag.name, prefix) || SemVerParse(strings.TrimPrefix(tag.name, prefix)) == nil {
+			delete(tags, hash)
+		}
+	}
+
+	result, err := describeWithTags(c, tags, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// the closest tag is picked on the full history, only the distance is
+	// restricted to the commits touching paths
+	var tagged *object.Commit
+	for hash, tag := range tags {
+		if tag.name == result.Tag {
+			tagged, err = repo.CommitObject(hash)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	distance, err := PathDistance(tagged, c, paths)
+	if err != nil {
+		return nil, err
+	}
+	result.Distance = distance
+
+	return result, nil
+}
+
+// PathDistance counts the commits reachable from c but not from tagged that
+// touch one of paths, compared with their first parent. A nil tagged counts
+// the whole history of c.
+func PathDistance(tagged *object.Commit, c *object.Commit, paths []string) (int, error) {
+	excluded := map[plumbing.Hash]bool{}
+	if tagged != nil {
+		err := object.NewCommitPreorderIter(tagged, nil, nil).ForEach(func(commit *object.Commit) error {
+			excluded[commit.Hash] = true
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	distance := 0
+	err := object.NewCommitPreorderIter(c, excluded, nil).ForEach(func(commit *object.Commit) error {
+		touched, err := CommitTouchesPaths(commit, paths, nil)
+		if err != nil {
+			return err
+		}
+		if touched {
+			distance++
+		}
+		return nil
+	})
+	return distance, err
+}
+
+// describeWithTags finds the tag of tags closest to c.
+func describeWithTags(c *object.Commit, tags map[plumbing.Hash]describeTag, firstParent bool) (*DescribeResult, error) {
+	if tag, ok := tags[c.Hash]; ok {
+		return &DescribeResult{Tag: tag.name, Hash: c.Hash}, nil
+	}
+
+	var candidates []*object.Commit
+	err := describeWalk(c, firstParent, func(commit *object.Commit) error {
+		if _, ok := tags[commit.Hash]; ok {
+			candidates = append(candidates, commit)
+			if len(candidates) == describeCandidates {
+				return storer.ErrStop
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoDescribeNames
+	}
+
+	var best *DescribeResult
+	for _, candidate := range candidates {
+		distance, err := describeDistance(candidate, c, firstParent)
+		if err != nil {
+			return nil, err
+		}
+
+		if best == nil || distance < best.Distance {
+			best = &DescribeResult{
+				Tag:      tags[candidate.Hash].name,
+				Distance: distance,
+				Hash:     c.Hash,
+			}
+		}
+	}
+
+	return best, nil
+}
+
+// describeTags maps commits to the tag describing them. When several tags
+// point at the same commit annotated tags win over lightweight ones, then the
+// most recent tag, then the first name in order.
+func describeTags(repo *git.Repository, opts DescribeCommitOptions) (map[plumbing.Hash]describeTag, error) {
+	iter, err := repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[plumbing.Hash]describeTag{}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if !describeMatch(opts.Match, opts.Exclude, name) {
+			return nil
+		}
+
+		tag := describeTag{name: name}
+		target := ref.Hash()
+
+		obj, err := repo.TagObject(ref.Hash())
+		switch err {
+		case nil:
+			commit, err := obj.Commit()
+			if err != nil {
+				// tags of trees and blobs cannot describe a commit
+				return nil
+			}
+			tag.annotated = true
+			tag.date = obj.Tagger.When.Unix()
+			target = commit.Hash
+		case plumbing.ErrObjectNotFound:
+			if !opts.Tags {
+				return nil
+			}
+			commit, err := repo.CommitObject(ref.Hash())
+			if err != nil {
+				return nil
+			}
+			tag.date = commit.Committer.When.Unix()
+		default:
+			return err
+		}
+
+		if current, ok := tags[target]; ok && !describePrefer(tag, current) {
+			return nil
+		}
+		tags[target] = tag
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func describePrefer(tag describeTag, current describeTag) bool {
+	if tag.annotated != current.annotated {
+		return tag.annotated
+	}
+	if tag.date != current.date {
+		return tag.date > current.date
+	}
+	return tag.name < current.name
+}
+
+// MatchTag reports whether the tag name matches one of the match glob
+// patterns, when given, and none of the exclude patterns.
+func MatchTag(match []string, exclude []string, name string) bool {
+	return describeMatch(match, exclude, name)
+}
+
+func describeMatch(match []string, exclude []string, name string) bool {
+	for _, pattern := range exclude {
+		if matchGlob(pattern, name) {
+			return false
+		}
+	}
+	if len(match) == 0 {
+		return true
+	}
+	for _, pattern := range match {
+		if matchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeWalk calls fn for the ancestors of c, newest first.
+func describeWalk(c *object.Commit, firstParent bool, fn func(*object.Commit) error) error {
+	if !firstParent {
+		err := object.NewCommitIterCTime(c, nil, nil).ForEach(fn)
+		if err == storer.ErrStop {
+			return nil
+		}
+		return err
+	}
+
+	for {
+		if err := fn(c); err != nil {
+			if err == storer.ErrStop {
+				return nil
+			}
+			return err
+		}
+		if c.NumParents() == 0 {
+			return nil
+		}
+
+		parent, err := c.Parent(0)
+		if err != nil {
+			return err
+		}
+		c = parent
+	}
+}
+
+// describeDistance counts the commits reachable from c but not from tagged.
+func describeDistance(tagged *object.Commit, c *object.Commit, firstParent bool) (int, error) {
+	if firstParent {
+		distance := 0
+		err := describeWalk(c, true, func(commit *object.Commit) error {
+			if commit.Hash == tagged.Hash {
+				return storer.ErrStop
+			}
+			distance++
+			return nil
+		})
+		return distance, err
+	}
+
+	excluded := map[plumbing.Hash]bool{}
+	err := object.NewCommitPreorderIter(tagged, nil, nil).ForEach(func(commit *object.Commit) error {
+		excluded[commit.Hash] = true
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	distance := 0
+	err = object.NewCommitPreorderIter(c, excluded, nil).ForEach(func(*object.Commit) error {
+		distance++
+		return nil
+	})
+	return distance, err
+}
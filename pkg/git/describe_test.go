@@ -0,0 +1,67 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeResultFormat(t *testing.T) {
+	hash := plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")
+
+	cases := []struct {
+		name     string
+		result   DescribeResult
+		abbrev   int
+		long     bool
+		expected string
+	}{
+		{"exact", DescribeResult{Tag: "v1.0.0", Hash: hash}, 7, false, "v1.0.0"},
+		{"exact long", DescribeResult{Tag: "v1.0.0", Hash: hash}, 7, true, "v1.0.0-0-g0123456"},
+		{"distance", DescribeResult{Tag: "v1.0.0", Distance: 2, Hash: hash}, 7, false, "v1.0.0-2-g0123456"},
+		{"distance abbrev", DescribeResult{Tag: "v1.0.0", Distance: 2, Hash: hash}, 10, false, "v1.0.0-2-g0123456789"},
+		{"distance abbrev 0", DescribeResult{Tag: "v1.0.0", Distance: 2, Hash: hash}, 0, false, "v1.0.0"},
+		{"distance abbrev 0 long", DescribeResult{Tag: "v1.0.0", Distance: 2, Hash: hash}, 0, true, "v1.0.0"},
+		{"untagged", DescribeResult{Hash: hash}, 7, false, "0123456"},
+		{"untagged abbrev 40", DescribeResult{Hash: hash}, 40, false, hash.String()},
+		{"untagged abbrev 0", DescribeResult{Hash: hash}, 0, false, hash.String()},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.result.Format(c.abbrev, c.long))
+		})
+	}
+}
+
+func TestMatchTag(t *testing.T) {
+	cases := []struct {
+		name     string
+		match    []string
+		exclude  []string
+		tag      string
+		expected bool
+	}{
+		{"no patterns", nil, nil, "v1.0.0", true},
+		{"match", []string{"v*"}, nil, "v1.0.0", true},
+		{"no match", []string{"v*"}, nil, "release-1", false},
+		{"any match", []string{"release-*", "v*"}, nil, "v1.0.0", true},
+		{"star crosses slashes", []string{"app/*"}, nil, "app/nested/v1.0.0", true},
+		{"question mark", []string{"v?.0.0"}, nil, "v1.0.0", true},
+		{"question mark length", []string{"v?.0.0"}, nil, "v10.0.0", false},
+		{"character class", []string{"v[12].*"}, nil, "v2.0.0", true},
+		{"character class no match", []string{"v[12].*"}, nil, "v3.0.0", false},
+		{"literal dot", []string{"v1.0"}, nil, "v1x0", false},
+		{"anchored", []string{"v1"}, nil, "v1.0.0", false},
+		{"excluded", nil, []string{"*-rc*"}, "v1.0.0-rc1", false},
+		{"exclude wins", []string{"v*"}, []string{"*-rc*"}, "v1.0.0-rc1", false},
+		{"not excluded", []string{"v*"}, []string{"*-rc*"}, "v1.0.0", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, MatchTag(c.match, c.exclude, c.tag))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// ForgeTemplates holds the URL templates of a forge. Templates may use the
+// {base_url}, {commit}, {file}, {from} and {to} placeholders.
+type ForgeTemplates struct {
+	Commit  string
+	File    string
+	Compare string
+}
+
+// Forges maps the forges with built-in templates to their templates.
+var Forges = map[string]ForgeTemplates{
+	"github": {
+		Commit:  "{base_url}/commit/{commit}",
+		File:    "{base_url}/blob/{commit}/{file}",
+		Compare: "{base_url}/compare/{from}...{to}",
+	},
+	"gitlab": {
+		Commit:  "{base_url}/-/commit/{commit}",
+		File:    "{base_url}/-/blob/{commit}/{file}",
+		Compare: "{base_url}/-/compare/{from}...{to}",
+	},
+	"bitbucket": {
+		Commit:  "{base_url}/commits/{commit}",
+		File:    "{base_url}/src/{commit}/{file}",
+		Compare: "{base_url}/branches/compare/{to}%0D{from}",
+	},
+}
+
+// WebURL converts a remote URL (https, ssh or scp-like) to the https URL of
+// the repository on its forge, without credentials or the .git suffix.
+func WebURL(remoteURL string) (string, error) {
+	endpoint, err := transport.NewEndpoint(remoteURL)
+	if err != nil {
+		return "", err
+	}
+
+	if endpoint.Protocol == "file" || endpoint.Host == "" {
+		return "", fmt.Errorf("%s is not hosted on a forge", remoteURL)
+	}
+
+	host := endpoint.Host
+	if (endpoint.Protocol == "http" || endpoint.Protocol == "https") && endpoint.Port != 0 {
+		host = fmt.Sprintf("%s:%d", host, endpoint.Port)
+	}
+
+	scheme := "https"
+	if endpoint.Protocol == "http" {
+		scheme = "http"
+	}
+
+	repoPath := strings.TrimSuffix(strings.Trim(endpoint.Path, "/"), ".git")
+
+	return fmt.Sprintf("%s://%s/%s", scheme, host, repoPath), nil
+}
+
+// DetectForge guesses the forge of a web URL from its host name, returns an
+// empty string for unknown hosts.
+func DetectForge(webURL string) string {
+	endpoint, err := transport.NewEndpoint(webURL)
+	if err != nil {
+		return ""
+	}
+
+	for _, forge := range []string{"github", "gitlab", "bitbucket"} {
+		if strings.Contains(endpoint.Host, forge) {
+			return forge
+		}
+	}
+
+	return ""
+}
+
+// ExpandForgeTemplate replaces the placeholders of template.
+func ExpandForgeTemplate(template string, baseURL string, commit string, file string, from string, to string) string {
+	return strings.NewReplacer(
+		"{base_url}", baseURL,
+		"{commit}", commit,
+		"{file}", file,
+		"{from}", from,
+		"{to}", to,
+	).Replace(template)
+}
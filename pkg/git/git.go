@@ -38,8 +38,21 @@ func TagMap(repo git.Repository) (*map[string]string, error) {
 	return &tagMap, nil
 }
 
+// DescribeOptions ...
+type DescribeOptions struct {
+	// IncludePaths and ExcludePaths restrict which commits add to the
+	// distance, commits that only touch filtered out paths are not counted.
+	IncludePaths []string
+	ExcludePaths []string
+}
+
 // Describe ...
 func Describe(repo git.Repository) (*string, *int, *string, error) {
+	return DescribeWithOptions(repo, DescribeOptions{})
+}
+
+// DescribeWithOptions ...
+func DescribeWithOptions(repo git.Repository, opts DescribeOptions) (*string, *int, *string, error) {
 	type gitDescribeNode struct {
 		Commit   object.Commit
 		Distance int
@@ -61,10 +74,12 @@ func Describe(repo git.Repository) (*string, *int, *string, error) {
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("unable to get log: %v", err)
 	}
+	filtered := len(opts.IncludePaths) > 0 || len(opts.ExcludePaths) > 0
 	state := map[string]gitDescribeNode{}
+	weights := map[string]int{}
 	counter := 0
 	tagHash := ""
-	commits.ForEach(func(c *object.Commit) error {
+	err = commits.ForEach(func(c *object.Commit) error {
 		node, found := state[c.Hash.String()]
 		if !found {
 			node = gitDescribeNode{
@@ -73,12 +88,23 @@ func Describe(repo git.Repository) (*string, *int, *string, error) {
 			}
 			state[c.Hash.String()] = node
 		}
+		weight := 1
+		if filtered {
+			touched, err := CommitTouchesPaths(c, opts.IncludePaths, opts.ExcludePaths)
+			if err != nil {
+				return err
+			}
+			if !touched {
+				weight = 0
+			}
+		}
+		weights[c.Hash.String()] = weight
 		c.Parents().ForEach(func(p *object.Commit) error {
 			_, found := state[p.Hash.String()]
 			if !found {
 				state[p.Hash.String()] = gitDescribeNode{
 					Commit:   *p,
-					Distance: node.Distance + 1,
+					Distance: node.Distance + weight,
 				}
 			}
 			return nil
@@ -91,10 +117,17 @@ func Describe(repo git.Repository) (*string, *int, *string, error) {
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to walk log: %v", err)
+	}
 	if tagHash == "" {
-		for _, node := range state {
-			if node.Distance+1 > counter {
-				counter = node.Distance + 1
+		for hash, node := range state {
+			weight, found := weights[hash]
+			if !found {
+				weight = 1
+			}
+			if node.Distance+weight > counter {
+				counter = node.Distance + weight
 			}
 		}
 		tagName := ""
@@ -103,3 +136,16 @@ func Describe(repo git.Repository) (*string, *int, *string, error) {
 	tagName := (*tags)[tagHash]
 	return &tagName, &counter, &headHash, nil
 }
+
+// RootCommit follows the first parent of c until it reaches a commit without
+// parents, the initial commit of the mainline history.
+func RootCommit(c *object.Commit) (*object.Commit, error) {
+	for c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read parent of %s: %v", c.Hash.String(), err)
+		}
+		c = parent
+	}
+	return c, nil
+}
@@ -0,0 +1,61 @@
+package git
+
+import (
+	"bytes"
+
+	format "github.com/go-git/go-git/v5/plumbing/format/config"
+)
+
+// SetGitmodule returns the content of a `.gitmodules` file with the
+// submodule name set to path, url and branch, an empty branch removing it.
+// Other submodules, options and the order of the file are kept as they are.
+func SetGitmodule(content []byte, name string, path string, url string, branch string) ([]byte, error) {
+	cfg, err := decodeGitmodules(content)
+	if err != nil {
+		return nil, err
+	}
+
+	subsection := cfg.Section("submodule").Subsection(name)
+	subsection.SetOption("path", path)
+	subsection.SetOption("url", url)
+	if branch == "" {
+		subsection.RemoveOption("branch")
+	} else {
+		subsection.SetOption("branch", branch)
+	}
+
+	return encodeGitmodules(cfg)
+}
+
+// RemoveGitmodule returns the content of a `.gitmodules` file without the
+// submodule name, nil when no submodule is left.
+func RemoveGitmodule(content []byte, name string) ([]byte, error) {
+	cfg, err := decodeGitmodules(content)
+	if err != nil {
+		return nil, err
+	}
+
+	section := cfg.Section("submodule")
+	section.RemoveSubsection(name)
+	if len(section.Subsections) == 0 && len(section.Options) == 0 {
+		return nil, nil
+	}
+
+	return encodeGitmodules(cfg)
+}
+
+func decodeGitmodules(content []byte) (*format.Config, error) {
+	cfg := format.New()
+	if err := format.NewDecoder(bytes.NewReader(content)).Decode(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func encodeGitmodules(cfg *format.Config) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := format.NewEncoder(&buf).Encode(cfg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,103 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// GitDir returns the path of the git directory of repo, `.git` of its working
+// tree unless it is bare.
+func GitDir(repo *git.Repository) (string, error) {
+	s := repo.Storer
+	for {
+		switch st := s.(type) {
+		case *filesystem.Storage:
+			return st.Filesystem().Root(), nil
+		case *alternatesStorage:
+			s = st.Storer
+		default:
+			return "", fmt.Errorf("repository is not stored on disk")
+		}
+	}
+}
+
+// HooksDir returns the directory git runs the hooks of repo from, `hooks` of
+// the common git directory unless `core.hooksPath` is configured. Like git, a
+// relative `core.hooksPath` is relative to the root of the working tree, or to
+// the git directory of bare repositories.
+func HooksDir(repo *git.Repository) (string, error) {
+	gitDir, err := CommonDir(repo)
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := repo.ConfigScoped(config.SystemScope)
+	if err != nil {
+		return "", err
+	}
+
+	hooksPath := cfg.Raw.Section("core").Option("hooksPath")
+	if hooksPath == "" {
+		return filepath.Join(gitDir, "hooks"), nil
+	}
+
+	if hooksPath == "~" || strings.HasPrefix(hooksPath, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		hooksPath = filepath.Join(home, strings.TrimPrefix(hooksPath, "~"))
+	}
+	if filepath.IsAbs(hooksPath) {
+		return filepath.Clean(hooksPath), nil
+	}
+
+	base := gitDir
+	wt, err := repo.Worktree()
+	if err == nil {
+		base = wt.Filesystem.Root()
+	} else if !errors.Is(err, git.ErrIsBareRepository) {
+		return "", err
+	}
+
+	return filepath.Join(base, hooksPath), nil
+}
+
+// HookNames are the hooks git runs, as documented by githooks(5).
+var HookNames = []string{
+	"applypatch-msg",
+	"pre-applypatch",
+	"post-applypatch",
+	"pre-commit",
+	"pre-merge-commit",
+	"prepare-commit-msg",
+	"commit-msg",
+	"post-commit",
+	"pre-rebase",
+	"post-checkout",
+	"post-merge",
+	"pre-push",
+	"pre-receive",
+	"update",
+	"proc-receive",
+	"post-receive",
+	"post-update",
+	"reference-transaction",
+	"push-to-checkout",
+	"pre-auto-gc",
+	"post-rewrite",
+	"sendemail-validate",
+	"fsmonitor-watchman",
+	"p4-changelist",
+	"p4-prepare-changelist",
+	"p4-post-changelist",
+	"p4-pre-submit",
+	"post-index-change",
+}
@@ -0,0 +1,155 @@
+package git
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// IgnoreRule is a single pattern of an ignore file.
+type IgnoreRule struct {
+	// Source is the file the rule is read from, relative to the worktree, ie.
+	// `.gitignore`, `docs/.gitignore` or `.git/info/exclude`.
+	Source string
+	// Line is the line number of the rule in Source, starting at 1.
+	Line int
+	// Pattern is the rule as written in Source.
+	Pattern string
+
+	pattern gitignore.Pattern
+}
+
+// ReadIgnoreRules reads the rules of the `.git/info/exclude` file and of every
+// `.gitignore` file of the worktree of repo, in ascending order of priority
+// like `git check-ignore`: info/exclude first, then the .gitignore files,
+// parent directories before their subdirectories.
+func ReadIgnoreRules(repo *git.Repository) ([]IgnoreRule, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []IgnoreRule
+
+	if dir := gitDirectory(repo); dir != nil {
+		exclude, err := readIgnoreFile(dir, "info/exclude", ".git/info/exclude", nil)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, exclude...)
+	}
+
+	ignored, err := readIgnoreFiles(wt.Filesystem, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(rules, ignored...), nil
+}
+
+// CheckIgnore reports whether name, a slash separated path relative to the
+// worktree, is ignored by rules and the rule deciding it, nil when no rule
+// matches. Like git, a path inside an ignored directory can not be included
+// again.
+func CheckIgnore(rules []IgnoreRule, name string, isDir bool) (bool, *IgnoreRule) {
+	parts := strings.Split(strings.Trim(path.Clean(name), "/"), "/")
+
+	for i := 1; i <= len(parts); i++ {
+		dir := isDir || i < len(parts)
+
+		for j := len(rules) - 1; j >= 0; j-- {
+			switch rules[j].pattern.Match(parts[:i], dir) {
+			case gitignore.Exclude:
+				return true, &rules[j]
+			case gitignore.Include:
+				if i == len(parts) {
+					return false, &rules[j]
+				}
+			default:
+				continue
+			}
+			break
+		}
+	}
+
+	return false, nil
+}
+
+// readIgnoreFiles reads the .gitignore file of dir and of its subdirectories.
+func readIgnoreFiles(fs billy.Filesystem, dir []string) ([]IgnoreRule, error) {
+	rules, err := readIgnoreFile(fs, fs.Join(append(dir, ".gitignore")...), path.Join(append(dir, ".gitignore")...), dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.ReadDir(fs.Join(dir...))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == ".git" {
+			continue
+		}
+
+		nested, err := readIgnoreFiles(fs, append(append([]string{}, dir...), entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, nested...)
+	}
+
+	return rules, nil
+}
+
+// readIgnoreFile reads the rules of the ignore file name of fs, domain is the
+// directory the rules apply to.
+func readIgnoreFile(fs billy.Filesystem, name string, source string, domain []string) ([]IgnoreRule, error) {
+	f, err := fs.Open(name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rules []IgnoreRule
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if strings.HasPrefix(text, "#") || strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		rules = append(rules, IgnoreRule{
+			Source:  source,
+			Line:    line,
+			Pattern: text,
+			pattern: gitignore.ParsePattern(text, domain),
+		})
+	}
+
+	return rules, scanner.Err()
+}
+
+// gitDirectory returns the git directory of repo, nil when it is not stored
+// on a filesystem.
+func gitDirectory(repo *git.Repository) billy.Filesystem {
+	s := repo.Storer
+	if alternates, ok := s.(*alternatesStorage); ok {
+		s = alternates.Storer
+	}
+
+	if fs, ok := s.(*filesystem.Storage); ok {
+		return fs.Filesystem()
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package git
+
+import (
+	"bufio"
+	"strings"
+)
+
+// Mailmap maps the names and emails commits are recorded with to canonical
+// ones, following the `.mailmap` format of git.
+type Mailmap struct {
+	entries map[string]*mailmapEntry
+}
+
+type mailmapEntry struct {
+	name  string
+	email string
+	// names holds the entries only applying to a commit name, keyed by the
+	// lowercase commit name.
+	names map[string]*mailmapEntry
+}
+
+// ParseMailmap parses the content of a `.mailmap` file. Lines that can not be
+// parsed are ignored like git does. Each line takes one of these forms:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+func ParseMailmap(content string) *Mailmap {
+	m := &Mailmap{entries: map[string]*mailmapEntry{}}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		properName, properEmail, rest, ok := parseMailmapIdentity(line)
+		if !ok {
+			continue
+		}
+
+		commitName, commitEmail, _, ok := parseMailmapIdentity(rest)
+		if !ok {
+			// A single identity maps the name of the email it holds.
+			commitName, commitEmail, properEmail = "", properEmail, ""
+		}
+
+		m.add(properName, properEmail, commitName, commitEmail)
+	}
+
+	return m
+}
+
+// parseMailmapIdentity parses the `Name <email>` at the start of s, the name
+// may be empty.
+func parseMailmapIdentity(s string) (string, string, string, bool) {
+	start := strings.Index(s, "<")
+	if start < 0 {
+		return "", "", "", false
+	}
+	end := strings.Index(s[start:], ">")
+	if end < 0 {
+		return "", "", "", false
+	}
+	end += start
+
+	return strings.TrimSpace(s[:start]), strings.TrimSpace(s[start+1 : end]), s[end+1:], true
+}
+
+func (m *Mailmap) add(properName, properEmail, commitName, commitEmail string) {
+	key := strings.ToLower(commitEmail)
+
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &mailmapEntry{names: map[string]*mailmapEntry{}}
+		m.entries[key] = entry
+	}
+
+	if commitName != "" {
+		nameKey := strings.ToLower(commitName)
+		if _, ok := entry.names[nameKey]; !ok {
+			entry.names[nameKey] = &mailmapEntry{}
+		}
+		entry = entry.names[nameKey]
+	}
+
+	// Later lines override earlier ones.
+	if properName != "" {
+		entry.name = properName
+	}
+	if properEmail != "" {
+		entry.email = properEmail
+	}
+}
+
+// Len returns the number of commit emails with a mapping.
+func (m *Mailmap) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.entries)
+}
+
+// Resolve returns the canonical name and email of the identity a commit is
+// recorded with. Emails and names are matched without regard to case, an
+// identity without mapping is returned as is.
+func (m *Mailmap) Resolve(name string, email string) (string, string) {
+	if m == nil {
+		return name, email
+	}
+
+	entry, ok := m.entries[strings.ToLower(email)]
+	if !ok {
+		return name, email
+	}
+	if named, ok := entry.names[strings.ToLower(name)]; ok {
+		entry = named
+	}
+
+	if entry.name != "" {
+		name = entry.name
+	}
+	if entry.email != "" {
+		email = entry.email
+	}
+
+	return name, email
+}
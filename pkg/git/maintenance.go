@@ -0,0 +1,500 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/revlist"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// packWindow is the number of objects considered as delta bases when
+// repacking, the same default as `git repack`.
+const packWindow = 10
+
+// ObjectCounts counts the objects of a repository like `git count-objects`.
+type ObjectCounts struct {
+	LooseObjects int
+	Packs        int
+}
+
+// CountObjects counts the loose objects and packs of repo.
+func CountObjects(repo *git.Repository) (*ObjectCounts, error) {
+	s, err := localStorage(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := &ObjectCounts{}
+	if err := s.ForEachObjectHash(func(plumbing.Hash) error {
+		counts.LooseObjects++
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	packs, err := s.ObjectPacks()
+	if err != nil {
+		return nil, err
+	}
+	counts.Packs = len(packs)
+
+	return counts, nil
+}
+
+// maintenanceLocks are the lock files of the git directory whose presence
+// means another git process is updating the repository.
+var maintenanceLocks = []string{"gc.pid", "index.lock", "HEAD.lock", "config.lock", "packed-refs.lock", "shallow.lock"}
+
+// LockMaintenance takes the gc.pid lock of the common git directory dir, like
+// `git gc` does, and returns the function releasing it. It refuses to while
+// gc.pid or the lock files of another git process exist. gitDir is the git
+// directory of the working tree maintenance runs from, the lock files of the
+// other linked working trees and of submodules are not considered.
+func LockMaintenance(dir string, gitDir string) (func(), error) {
+	for _, name := range maintenanceLocks {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return nil, fmt.Errorf("%s exists, another git process seems to be running in this repository", name)
+		}
+	}
+
+	dir, gitDir = filepath.Clean(dir), filepath.Clean(gitDir)
+	err := filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			// Objects have no lock files, references and the working tree
+			// do. Submodules are repositories of their own.
+			switch filepath.Dir(name) {
+			case dir:
+				if entry.Name() == "objects" || entry.Name() == "modules" {
+					return filepath.SkipDir
+				}
+			case filepath.Join(dir, "worktrees"):
+				if name != gitDir {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+		if strings.HasSuffix(name, ".lock") {
+			rel, _ := filepath.Rel(dir, name)
+			return fmt.Errorf("%s exists, another git process seems to be running in this repository", filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pid := filepath.Join(dir, "gc.pid")
+	f, err := os.OpenFile(pid, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("gc.pid exists, another git process seems to be running in this repository")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, _ := os.Hostname()
+	_, err = fmt.Fprintf(f, "%d %s", os.Getpid(), hostname)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(pid)
+		return nil, err
+	}
+
+	return func() { os.Remove(pid) }, nil
+}
+
+// ExpireReflogs removes the entries of the reflogs of the git directory dir
+// older than before, like `git reflog expire --all` does, and returns the
+// number of entries removed.
+func ExpireReflogs(dir string, before time.Time) (int, error) {
+	expired := 0
+
+	err := filepath.WalkDir(filepath.Join(dir, "logs"), func(name string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		var kept bytes.Buffer
+		removed := 0
+		for _, line := range strings.SplitAfter(string(content), "\n") {
+			if line == "" {
+				continue
+			}
+			if when, ok := reflogTime(line); ok && when.Before(before) {
+				removed++
+				continue
+			}
+			kept.WriteString(line)
+		}
+		if removed == 0 {
+			return nil
+		}
+
+		expired += removed
+		return writeFileAtomic(name, kept.Bytes())
+	})
+
+	return expired, err
+}
+
+// Repack packs the objects of repo reachable from its references, reflogs,
+// indexes and working trees into a single pack, like `git repack -a -d` does,
+// and returns the number of objects packed. Unreachable objects of the packs
+// replaced are written loose, to be pruned once expired, and loose objects
+// packed are removed. Objects of alternates are left out.
+func Repack(repo *git.Repository) (int, error) {
+	s, err := localStorage(repo)
+	if err != nil {
+		return 0, err
+	}
+
+	reachable, err := reachableObjects(repo)
+	if err != nil {
+		return 0, err
+	}
+
+	var objects []plumbing.Hash
+	for hash := range reachable {
+		if s.HasEncodedObject(hash) == nil {
+			objects = append(objects, hash)
+		}
+	}
+
+	packs, err := s.ObjectPacks()
+	if err != nil {
+		return 0, err
+	}
+
+	// Like `git repack -A`, unreachable objects are not lost with their pack
+	// but left loose, starting their expiration now.
+	iter, err := s.IterEncodedObjects(plumbing.AnyObject)
+	if err != nil {
+		return 0, err
+	}
+	err = iter.ForEach(func(obj plumbing.EncodedObject) error {
+		if reachable[obj.Hash()] {
+			return nil
+		}
+		if _, err := s.LooseObjectTime(obj.Hash()); err == nil {
+			return nil
+		}
+		_, err := s.SetEncodedObject(obj)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to unpack unreachable objects: %v", err)
+	}
+
+	pack := plumbing.ZeroHash
+	if len(objects) > 0 {
+		if pack, err = writePack(repo, s, objects); err != nil {
+			return 0, fmt.Errorf("unable to write pack: %v", err)
+		}
+	}
+
+	for _, hash := range packs {
+		if hash == pack {
+			continue
+		}
+		if err := s.DeleteOldObjectPackAndIndex(hash, time.Time{}); err != nil {
+			return 0, err
+		}
+	}
+	s.Reindex()
+
+	var packed []plumbing.Hash
+	if err := s.ForEachObjectHash(func(hash plumbing.Hash) error {
+		if reachable[hash] {
+			packed = append(packed, hash)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	for _, hash := range packed {
+		if err := s.DeleteLooseObject(hash); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(objects), nil
+}
+
+// PruneObjects deletes the loose objects of repo unreachable from its
+// references, reflogs, indexes and working trees last modified before
+// before, like `git prune --expire` does, and returns the number deleted.
+func PruneObjects(repo *git.Repository, before time.Time) (int, error) {
+	s, err := localStorage(repo)
+	if err != nil {
+		return 0, err
+	}
+
+	reachable, err := reachableObjects(repo)
+	if err != nil {
+		return 0, err
+	}
+
+	var expired []plumbing.Hash
+	if err := s.ForEachObjectHash(func(hash plumbing.Hash) error {
+		if reachable[hash] {
+			return nil
+		}
+		if when, err := s.LooseObjectTime(hash); err == nil && when.Before(before) {
+			expired = append(expired, hash)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	for _, hash := range expired {
+		if err := s.DeleteLooseObject(hash); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(expired), nil
+}
+
+// localStorage returns the storage of repo without its alternates.
+func localStorage(repo *git.Repository) (*filesystem.Storage, error) {
+	s := repo.Storer
+	for {
+		switch st := s.(type) {
+		case *filesystem.Storage:
+			return st, nil
+		case *alternatesStorage:
+			s = st.Storer
+		default:
+			return nil, fmt.Errorf("repository is not stored on disk")
+		}
+	}
+}
+
+// reachableObjects returns the objects reachable from the references,
+// reflogs, indexes and working trees of repo, the ones git keeps.
+func reachableObjects(repo *git.Repository) (map[plumbing.Hash]bool, error) {
+	if shallow, err := repo.Storer.Shallow(); err != nil {
+		return nil, err
+	} else if len(shallow) > 0 {
+		return nil, fmt.Errorf("shallow repositories are not supported")
+	}
+
+	commonDir, err := CommonDir(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := map[plumbing.Hash]bool{}
+
+	refs, err := repo.Storer.IterReferences()
+	if err != nil {
+		return nil, err
+	}
+	if err := refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference {
+			roots[ref.Hash()] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if head, err := repo.Head(); err == nil {
+		roots[head.Hash()] = true
+	}
+
+	dirs := []string{commonDir}
+	worktrees, err := LinkedWorktrees(commonDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, worktree := range worktrees {
+		dirs = append(dirs, filepath.Join(commonDir, "worktrees", worktree.ID))
+		if plumbing.IsHash(worktree.Head) {
+			roots[plumbing.NewHash(worktree.Head)] = true
+		}
+	}
+
+	for _, dir := range dirs {
+		if err := reflogObjects(dir, roots); err != nil {
+			return nil, err
+		}
+		if err := indexObjects(filepath.Join(dir, "index"), roots); err != nil {
+			return nil, err
+		}
+	}
+
+	// Objects already gone, ie. the old side of an old reflog entry, can not
+	// be walked.
+	var hashes []plumbing.Hash
+	for hash := range roots {
+		if !hash.IsZero() && repo.Storer.HasEncodedObject(hash) == nil {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	objects, err := revlist.Objects(repo.Storer, hashes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reachable := make(map[plumbing.Hash]bool, len(objects))
+	for _, hash := range objects {
+		reachable[hash] = true
+	}
+
+	return reachable, nil
+}
+
+// reflogObjects adds the objects of the entries of the reflogs of the git
+// directory dir to roots.
+func reflogObjects(dir string, roots map[plumbing.Hash]bool) error {
+	return filepath.WalkDir(filepath.Join(dir, "logs"), func(name string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			for i := 0; i < 2 && i < len(fields); i++ {
+				if plumbing.IsHash(fields[i]) {
+					roots[plumbing.NewHash(fields[i])] = true
+				}
+			}
+		}
+
+		return scanner.Err()
+	})
+}
+
+// indexObjects adds the blobs of the index file name to roots.
+func indexObjects(name string, roots map[plumbing.Hash]bool) error {
+	f, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	idx := &index.Index{}
+	if err := index.NewDecoder(bufio.NewReader(f)).Decode(idx); err != nil {
+		return fmt.Errorf("unable to read %s: %v", name, err)
+	}
+
+	for _, entry := range idx.Entries {
+		if entry.Mode != filemode.Submodule {
+			roots[entry.Hash] = true
+		}
+	}
+
+	return nil
+}
+
+// writePack writes objects to a new pack of s and returns its hash.
+func writePack(repo *git.Repository, s *filesystem.Storage, objects []plumbing.Hash) (hash plumbing.Hash, err error) {
+	w, err := s.PackfileWriter()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	defer func() {
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	return packfile.NewEncoder(w, repo.Storer, false).Encode(objects, packWindow)
+}
+
+// reflogTime returns the time of the reflog entry line,
+// `<old> <new> <name> <<email>> <timestamp> <tz>\t<message>`.
+func reflogTime(line string) (time.Time, bool) {
+	line, _, _ = strings.Cut(line, "\t")
+
+	i := strings.LastIndex(line, "> ")
+	if i < 0 {
+		return time.Time{}, false
+	}
+
+	fields := strings.Fields(line[i+2:])
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+
+	seconds, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(seconds, 0), true
+}
+
+// writeFileAtomic replaces the content of the file name, writing it to its
+// lock file first so that git never reads a partial file. It fails when the
+// lock file exists, held by another git process.
+func writeFileAtomic(name string, content []byte) error {
+	lock := name + ".lock"
+	f, err := os.OpenFile(lock, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("unable to lock %s: %s exists, another git process seems to be running", name, lock)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(lock, name)
+	}
+	if err != nil {
+		os.Remove(lock)
+	}
+
+	return err
+}
@@ -0,0 +1,153 @@
+package git
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockMaintenance(t *testing.T) {
+	cases := []struct {
+		name     string
+		gitDir   string
+		lock     string
+		expected string
+	}{
+		{"unlocked", "", "", ""},
+		{"gc.pid", "", "gc.pid", "gc.pid exists"},
+		{"index", "", "index.lock", "index.lock exists"},
+		{"reference", "", "refs/heads/main.lock", "refs/heads/main.lock exists"},
+		{"objects", "", "objects/pack/pack.lock", ""},
+		{"submodule", "", "modules/lib/index.lock", ""},
+		{"worktree", "worktrees/wt", "worktrees/wt/index.lock", "worktrees/wt/index.lock exists"},
+		{"other worktree", "", "worktrees/wt/index.lock", ""},
+		{"other worktree from worktree", "worktrees/wt", "worktrees/other/HEAD.lock", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, sub := range []string{"objects/pack", "refs/heads", "modules/lib", "worktrees/wt", "worktrees/other"} {
+				assert.NoError(t, os.MkdirAll(filepath.Join(dir, sub), 0755))
+			}
+			if c.lock != "" {
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, c.lock), nil, 0644))
+			}
+
+			unlock, err := LockMaintenance(dir, filepath.Join(dir, c.gitDir))
+			if c.expected != "" {
+				assert.ErrorContains(t, err, c.expected)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.FileExists(t, filepath.Join(dir, "gc.pid"))
+			_, err = LockMaintenance(dir, dir)
+			assert.ErrorContains(t, err, "gc.pid exists")
+
+			unlock()
+			assert.NoFileExists(t, filepath.Join(dir, "gc.pid"))
+		})
+	}
+}
+
+func TestPruneObjects(t *testing.T) {
+	content := []byte("content\n")
+	blob := plumbing.ComputeHash(plumbing.BlobObject, content)
+	old := time.Now().AddDate(0, 0, -30)
+
+	cases := []struct {
+		name   string
+		recent bool
+		// reach makes commit, holding blob, reachable from the repository at
+		// dir.
+		reach  func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash)
+		pruned int
+		blob   bool
+	}{
+		{"unreachable", false, nil, 3, false},
+		{"recent", true, nil, 0, true},
+		{"branch", false, func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash) {
+			assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/heads/main", commit)))
+		}, 0, true},
+		{"detached head", false, func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash) {
+			assert.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.HEAD, commit)))
+		}, 0, true},
+		{"reflog", false, func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash) {
+			assert.NoError(t, os.MkdirAll(filepath.Join(dir, "logs", "refs", "heads"), 0755))
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, "logs", "refs", "heads", "gone"),
+				[]byte(fmt.Sprintf("%s %s Author <author@example.com> 1600000000 +0000\tcommit\n", plumbing.ZeroHash, commit)), 0644))
+		}, 0, true},
+		{"index", false, func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash) {
+			assert.NoError(t, writeIndex(filepath.Join(dir, "index"), &index.Index{
+				Entries: []*index.Entry{{Name: "file", Hash: blob, Mode: filemode.Regular}},
+			}))
+		}, 2, true},
+		{"linked worktree head", false, func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash) {
+			_, err := AddWorktree(dir, filepath.Join(t.TempDir(), "wt"), commit.String())
+			assert.NoError(t, err)
+		}, 0, true},
+		{"linked worktree index", false, func(t *testing.T, repo *git.Repository, dir string, commit plumbing.Hash) {
+			id, err := AddWorktree(dir, filepath.Join(t.TempDir(), "wt"), "ref: refs/heads/main")
+			assert.NoError(t, err)
+			assert.NoError(t, writeIndex(filepath.Join(dir, "worktrees", id, "index"), &index.Index{
+				Entries: []*index.Entry{{Name: "file", Hash: blob, Mode: filemode.Regular}},
+			}))
+		}, 2, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := t.TempDir()
+			repo, err := git.PlainInit(dir, true)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			tree, err := WriteTree(repo.Storer, nil, map[string]*TreeChange{"file": {Content: content}})
+			if !assert.NoError(t, err) {
+				return
+			}
+			sig := object.Signature{Name: "Author", Email: "author@example.com", When: time.Unix(1600000000, 0)}
+			obj := repo.Storer.NewEncodedObject()
+			assert.NoError(t, (&object.Commit{Author: sig, Committer: sig, Message: "commit\n", TreeHash: tree}).Encode(obj))
+			commit, err := repo.Storer.SetEncodedObject(obj)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			if c.reach != nil {
+				c.reach(t, repo, dir, commit)
+			}
+
+			if !c.recent {
+				assert.NoError(t, filepath.WalkDir(filepath.Join(dir, "objects"), func(name string, entry fs.DirEntry, err error) error {
+					if err != nil || entry.IsDir() {
+						return err
+					}
+					return os.Chtimes(name, old, old)
+				}))
+			}
+
+			pruned, err := PruneObjects(repo, time.Now().AddDate(0, 0, -14))
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, c.pruned, pruned)
+			assert.Equal(t, c.blob, repo.Storer.HasEncodedObject(blob) == nil)
+			assert.Equal(t, c.pruned == 0, repo.Storer.HasEncodedObject(commit) == nil)
+		})
+	}
+}
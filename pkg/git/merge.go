@@ -0,0 +1,415 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// TreeMerge is the result of merging the changes of a tree into another.
+type TreeMerge struct {
+	// Changes are the changes to apply to ours, by path, for WriteTree.
+	Changes map[string]*TreeChange
+	// Conflicts are the paths that could not be merged, sorted.
+	Conflicts []string
+}
+
+// mergeEntry is the content a path ends up with on one side of a merge, the
+// zero value represents a missing path.
+type mergeEntry struct {
+	hash plumbing.Hash
+	mode filemode.FileMode
+}
+
+// MergeTrees merges the changes between base and theirs into ours, like the
+// recursive strategy of `git merge` without rename detection. Files changed
+// on both sides are merged line by line and conflict when the changes
+// overlap, a file deleted on one side and modified on the other, or replaced
+// by a directory, conflicts as well.
+func MergeTrees(ctx context.Context, base *object.Tree, ours *object.Tree, theirs *object.Tree) (*TreeMerge, error) {
+	ourChanges, err := mergeEntries(ctx, base, ours)
+	if err != nil {
+		return nil, err
+	}
+	theirChanges, err := mergeEntries(ctx, base, theirs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TreeMerge{Changes: map[string]*TreeChange{}, Conflicts: []string{}}
+	conflicts := map[string]bool{}
+
+	for name, their := range theirChanges {
+		our, changed := ourChanges[name]
+		if changed && our == their {
+			continue
+		}
+
+		if !changed {
+			change, err := treeChange(theirs, name, their)
+			if err != nil {
+				return nil, err
+			}
+			result.Changes[name] = change
+			continue
+		}
+
+		// Deleted on one side, modified on the other.
+		if our.mode == filemode.Empty || their.mode == filemode.Empty {
+			conflicts[name] = true
+			continue
+		}
+
+		change, ok, err := mergeFile(base, ours, theirs, name, our, their)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			conflicts[name] = true
+			continue
+		}
+		result.Changes[name] = change
+	}
+
+	// A file of one side where the other has a directory can not be merged.
+	for name, change := range result.Changes {
+		if change.Content == nil && change.Submodule.IsZero() {
+			continue
+		}
+		isDir, err := mergedIsDir(ours, result.Changes, name)
+		if err != nil {
+			return nil, err
+		}
+		if isDir {
+			conflicts[name] = true
+		}
+		for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+			isFile, err := mergedIsFile(ours, result.Changes, dir)
+			if err != nil {
+				return nil, err
+			}
+			if isFile {
+				conflicts[name] = true
+				break
+			}
+		}
+	}
+
+	for name := range conflicts {
+		delete(result.Changes, name)
+		result.Conflicts = append(result.Conflicts, name)
+	}
+	sort.Strings(result.Conflicts)
+
+	return result, nil
+}
+
+// MergeFile merges the changes between base and theirs into ours line by line,
+// like `git merge-file`, and returns the merged content, false when changes
+// overlap or touch adjacent lines.
+func MergeFile(base string, ours string, theirs string) (string, bool) {
+	if ours == theirs || theirs == base {
+		return ours, true
+	}
+	if ours == base {
+		return theirs, true
+	}
+
+	baseLines := strings.SplitAfter(base, "\n")
+	ourHunks := mergeHunks(base, ours, false)
+	theirHunks := mergeHunks(base, theirs, true)
+
+	hunks := make([]mergeHunk, 0, len(ourHunks)+len(theirHunks))
+	hunks = append(hunks, ourHunks...)
+	hunks = append(hunks, theirHunks...)
+	sort.SliceStable(hunks, func(i, j int) bool { return hunks[i].start < hunks[j].start })
+
+	var merged strings.Builder
+	line := 0
+	for i := 0; i < len(hunks); {
+		// Hunks overlapping or adjacent to each other are resolved together.
+		start, end := hunks[i].start, hunks[i].end
+		group := []mergeHunk{hunks[i]}
+		for i++; i < len(hunks) && hunks[i].start <= end; i++ {
+			if hunks[i].end > end {
+				end = hunks[i].end
+			}
+			group = append(group, hunks[i])
+		}
+
+		merged.WriteString(strings.Join(baseLines[line:start], ""))
+		line = end
+
+		var ourGroup, theirGroup []mergeHunk
+		for _, hunk := range group {
+			if hunk.theirs {
+				theirGroup = append(theirGroup, hunk)
+			} else {
+				ourGroup = append(ourGroup, hunk)
+			}
+		}
+
+		ourText := applyHunks(baseLines, start, end, ourGroup)
+		theirText := applyHunks(baseLines, start, end, theirGroup)
+		switch {
+		case len(theirGroup) == 0:
+			merged.WriteString(ourText)
+		case len(ourGroup) == 0, ourText == theirText:
+			merged.WriteString(theirText)
+		default:
+			return "", false
+		}
+	}
+	merged.WriteString(strings.Join(baseLines[line:], ""))
+
+	return merged.String(), true
+}
+
+// mergeHunk replaces the lines start to end, excluded, of the base of a merge
+// with text.
+type mergeHunk struct {
+	start  int
+	end    int
+	text   string
+	theirs bool
+}
+
+// mergeHunks returns the hunks turning base into content, in order, theirs
+// telling which side of the merge content is.
+func mergeHunks(base string, content string, theirs bool) []mergeHunk {
+	var hunks []mergeHunk
+	var hunk *mergeHunk
+	line := 0
+	for _, d := range diff.Do(base, content) {
+		n := countLines(d.Text)
+		if d.Type == diffmatchpatch.DiffEqual {
+			if hunk != nil {
+				hunks = append(hunks, *hunk)
+				hunk = nil
+			}
+			line += n
+			continue
+		}
+
+		if hunk == nil {
+			hunk = &mergeHunk{start: line, end: line, theirs: theirs}
+		}
+		if d.Type == diffmatchpatch.DiffDelete {
+			line += n
+			hunk.end = line
+		} else {
+			hunk.text += d.Text
+		}
+	}
+	if hunk != nil {
+		hunks = append(hunks, *hunk)
+	}
+
+	return hunks
+}
+
+// applyHunks returns the lines start to end of baseLines with hunks, within
+// that range and in order, applied.
+func applyHunks(baseLines []string, start int, end int, hunks []mergeHunk) string {
+	var text strings.Builder
+	line := start
+	for _, hunk := range hunks {
+		text.WriteString(strings.Join(baseLines[line:hunk.start], ""))
+		text.WriteString(hunk.text)
+		line = hunk.end
+	}
+	text.WriteString(strings.Join(baseLines[line:end], ""))
+	return text.String()
+}
+
+// mergeFile merges the file name, changed to our and their on each side, and
+// returns the change to apply to ours, false when it conflicts.
+func mergeFile(base *object.Tree, ours *object.Tree, theirs *object.Tree, name string, our mergeEntry, their mergeEntry) (*TreeChange, bool, error) {
+	if !our.mode.IsFile() || !their.mode.IsFile() || our.mode == filemode.Symlink || their.mode == filemode.Symlink {
+		return nil, false, nil
+	}
+
+	baseEntry, err := treeEntry(base, name)
+	if err != nil {
+		return nil, false, err
+	}
+	// Files added on both sides are merged from an empty file.
+	if !baseEntry.mode.IsFile() {
+		baseEntry = mergeEntry{}
+	}
+
+	// Like content, a mode changed on one side only is merged.
+	mode := our.mode
+	if our.mode == baseEntry.mode {
+		mode = their.mode
+	} else if their.mode != baseEntry.mode && their.mode != our.mode {
+		return nil, false, nil
+	}
+
+	var contents [3][]byte
+	for i, side := range []struct {
+		tree  *object.Tree
+		entry mergeEntry
+	}{{base, baseEntry}, {ours, our}, {theirs, their}} {
+		if side.entry.mode == filemode.Empty {
+			continue
+		}
+		if contents[i], err = fileContent(side.tree, name); err != nil {
+			return nil, false, err
+		}
+		// Like git, binary files are not merged.
+		if bytes.IndexByte(contents[i], 0) >= 0 {
+			return nil, false, nil
+		}
+	}
+
+	merged, ok := MergeFile(string(contents[0]), string(contents[1]), string(contents[2]))
+	if !ok {
+		return nil, false, nil
+	}
+
+	return &TreeChange{Content: []byte(merged), Executable: mode == filemode.Executable}, true, nil
+}
+
+// mergeEntries returns the entry of every path changed between base and tree.
+func mergeEntries(ctx context.Context, base *object.Tree, tree *object.Tree) (map[string]mergeEntry, error) {
+	changes, err := object.DiffTreeContext(ctx, base, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]mergeEntry{}
+	for _, change := range changes {
+		if change.To.Name == "" {
+			entries[change.From.Name] = mergeEntry{}
+			continue
+		}
+		// A file replaced by a directory, or the opposite, is reported as a
+		// single modification of the path.
+		if change.From.Name != "" && change.From.Name != change.To.Name {
+			entries[change.From.Name] = mergeEntry{}
+		}
+		entries[change.To.Name] = mergeEntry{hash: change.To.TreeEntry.Hash, mode: change.To.TreeEntry.Mode}
+	}
+
+	return entries, nil
+}
+
+// treeChange returns the change writing entry, the file name of tree.
+func treeChange(tree *object.Tree, name string, entry mergeEntry) (*TreeChange, error) {
+	switch entry.mode {
+	case filemode.Empty:
+		return &TreeChange{}, nil
+	case filemode.Submodule:
+		return &TreeChange{Submodule: entry.hash}, nil
+	}
+
+	content, err := fileContent(tree, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TreeChange{
+		Content:    content,
+		Executable: entry.mode == filemode.Executable,
+		Symlink:    entry.mode == filemode.Symlink,
+	}, nil
+}
+
+// treeEntry returns the entry of the file name in tree, the zero value when it
+// does not exist.
+func treeEntry(tree *object.Tree, name string) (mergeEntry, error) {
+	if tree == nil {
+		return mergeEntry{}, nil
+	}
+
+	// go-git looks files up through parents that may not be directories.
+	if dir := path.Dir(name); dir != "." {
+		parent, err := treeEntry(tree, dir)
+		if err != nil || parent.mode != filemode.Dir {
+			return mergeEntry{}, err
+		}
+	}
+
+	entry, err := tree.FindEntry(name)
+	if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) {
+		return mergeEntry{}, nil
+	}
+	if err != nil {
+		return mergeEntry{}, err
+	}
+
+	return mergeEntry{hash: entry.Hash, mode: entry.Mode}, nil
+}
+
+// mergedIsFile returns whether name is a file once changes are applied to
+// tree.
+func mergedIsFile(tree *object.Tree, changes map[string]*TreeChange, name string) (bool, error) {
+	if change, ok := changes[name]; ok {
+		return change.Content != nil || !change.Submodule.IsZero(), nil
+	}
+
+	entry, err := treeEntry(tree, name)
+	return entry.mode != filemode.Empty && entry.mode != filemode.Dir, err
+}
+
+// mergedIsDir returns whether name is a directory holding files once changes
+// are applied to tree.
+func mergedIsDir(tree *object.Tree, changes map[string]*TreeChange, name string) (bool, error) {
+	for other, change := range changes {
+		if strings.HasPrefix(other, name+"/") && (change.Content != nil || !change.Submodule.IsZero()) {
+			return true, nil
+		}
+	}
+
+	entry, err := treeEntry(tree, name)
+	if err != nil || entry.mode != filemode.Dir {
+		return false, err
+	}
+
+	dir, err := tree.Tree(name)
+	if err != nil {
+		return false, err
+	}
+
+	// The directory remains unless changes delete every file in it.
+	remains := false
+	err = dir.Files().ForEach(func(f *object.File) error {
+		if change, ok := changes[name+"/"+f.Name]; !ok || change.Content != nil {
+			remains = true
+			return io.EOF
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	return remains, nil
+}
+
+// fileContent reads the content of the file, or symlink, name of tree.
+func fileContent(tree *object.Tree, name string) ([]byte, error) {
+	f, err := tree.File(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := f.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
@@ -0,0 +1,166 @@
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeFile(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+
+	cases := []struct {
+		name     string
+		ours     string
+		theirs   string
+		expected string
+		ok       bool
+	}{
+		{"unchanged", base, base, base, true},
+		{"ours only", "a\nB\nc\nd\ne\n", base, "a\nB\nc\nd\ne\n", true},
+		{"theirs only", base, "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", true},
+		{"same change", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", true},
+		{"distinct lines", "A\nb\nc\nd\ne\n", "a\nb\nc\nd\nE\n", "A\nb\nc\nd\nE\n", true},
+		{"deletion and change", "b\nc\nd\ne\n", "a\nb\nc\nD\ne\n", "b\nc\nD\ne\n", true},
+		{"same insertion", "a\nb\nx\nc\nd\ne\n", "a\nb\nx\nc\nd\ne\n", "a\nb\nx\nc\nd\ne\n", true},
+		{"same line", "a\nB\nc\nd\ne\n", "a\nb2\nc\nd\ne\n", "", false},
+		{"adjacent lines", "a\nB\nc\nd\ne\n", "a\nb\nC\nd\ne\n", "", false},
+		{"distinct insertions", "a\nb\nx\nc\nd\ne\n", "a\nb\ny\nc\nd\ne\n", "", false},
+		{"deleted and changed", "a\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			merged, ok := MergeFile(base, c.ours, c.theirs)
+			assert.Equal(t, c.ok, ok)
+			assert.Equal(t, c.expected, merged)
+		})
+	}
+}
+
+func TestMergeTrees(t *testing.T) {
+	base := map[string]*TreeChange{
+		"README.md":  {Content: []byte("a\nb\nc\nd\ne\n")},
+		"main.go":    {Content: []byte("package main\n")},
+		"run.sh":     {Content: []byte("#!/bin/sh\n")},
+		"binary.dat": {Content: []byte("a\x00b")},
+	}
+
+	cases := []struct {
+		name      string
+		ours      map[string]*TreeChange
+		theirs    map[string]*TreeChange
+		changes   map[string]*TreeChange
+		conflicts []string
+	}{
+		{
+			name:      "unchanged",
+			changes:   map[string]*TreeChange{},
+			conflicts: []string{},
+		},
+		{
+			name:      "theirs added",
+			ours:      map[string]*TreeChange{"main.go": {Content: []byte("package app\n")}},
+			theirs:    map[string]*TreeChange{"new.txt": {Content: []byte("new\n")}},
+			changes:   map[string]*TreeChange{"new.txt": {Content: []byte("new\n")}},
+			conflicts: []string{},
+		},
+		{
+			name:      "theirs deleted",
+			theirs:    map[string]*TreeChange{"main.go": {}},
+			changes:   map[string]*TreeChange{"main.go": {}},
+			conflicts: []string{},
+		},
+		{
+			name:      "both deleted",
+			ours:      map[string]*TreeChange{"main.go": {}},
+			theirs:    map[string]*TreeChange{"main.go": {}},
+			changes:   map[string]*TreeChange{},
+			conflicts: []string{},
+		},
+		{
+			name:      "merged lines",
+			ours:      map[string]*TreeChange{"README.md": {Content: []byte("A\nb\nc\nd\ne\n")}},
+			theirs:    map[string]*TreeChange{"README.md": {Content: []byte("a\nb\nc\nd\nE\n")}},
+			changes:   map[string]*TreeChange{"README.md": {Content: []byte("A\nb\nc\nd\nE\n")}},
+			conflicts: []string{},
+		},
+		{
+			name:      "mode and content",
+			ours:      map[string]*TreeChange{"run.sh": {Content: []byte("#!/bin/sh\nexit 0\n")}},
+			theirs:    map[string]*TreeChange{"run.sh": {Content: []byte("#!/bin/sh\n"), Executable: true}},
+			changes:   map[string]*TreeChange{"run.sh": {Content: []byte("#!/bin/sh\nexit 0\n"), Executable: true}},
+			conflicts: []string{},
+		},
+		{
+			name:      "conflicting lines",
+			ours:      map[string]*TreeChange{"README.md": {Content: []byte("a\nB\nc\nd\ne\n")}},
+			theirs:    map[string]*TreeChange{"README.md": {Content: []byte("a\nb2\nc\nd\ne\n")}},
+			changes:   map[string]*TreeChange{},
+			conflicts: []string{"README.md"},
+		},
+		{
+			name:      "deleted and modified",
+			ours:      map[string]*TreeChange{"main.go": {}},
+			theirs:    map[string]*TreeChange{"main.go": {Content: []byte("package app\n")}},
+			changes:   map[string]*TreeChange{},
+			conflicts: []string{"main.go"},
+		},
+		{
+			name:      "added on both sides",
+			ours:      map[string]*TreeChange{"new.txt": {Content: []byte("ours\n")}},
+			theirs:    map[string]*TreeChange{"new.txt": {Content: []byte("theirs\n")}},
+			changes:   map[string]*TreeChange{},
+			conflicts: []string{"new.txt"},
+		},
+		{
+			name:      "binary",
+			ours:      map[string]*TreeChange{"binary.dat": {Content: []byte("a\x00c")}},
+			theirs:    map[string]*TreeChange{"binary.dat": {Content: []byte("b\x00b")}},
+			changes:   map[string]*TreeChange{},
+			conflicts: []string{"binary.dat"},
+		},
+		{
+			name: "file and directory",
+			ours: map[string]*TreeChange{"lib": {Content: []byte("lib\n")}},
+			theirs: map[string]*TreeChange{
+				"lib/a.go":  {Content: []byte("package lib\n")},
+				"other.txt": {Content: []byte("other\n")},
+			},
+			changes:   map[string]*TreeChange{"other.txt": {Content: []byte("other\n")}},
+			conflicts: []string{"lib/a.go"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := memory.NewStorage()
+			baseTree := testWriteTree(t, s, nil, base)
+
+			result, err := MergeTrees(context.Background(), baseTree, testWriteTree(t, s, baseTree, c.ours), testWriteTree(t, s, baseTree, c.theirs))
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, c.changes, result.Changes)
+			assert.Equal(t, c.conflicts, result.Conflicts)
+		})
+	}
+}
+
+// testWriteTree writes the tree resulting from applying changes to base in s.
+func testWriteTree(t *testing.T, s *memory.Storage, base *object.Tree, changes map[string]*TreeChange) *object.Tree {
+	hash, err := WriteTree(s, base, changes)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	tree, err := object.GetTree(s, hash)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return tree
+}
@@ -0,0 +1,108 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/config"
+)
+
+// UnsafeOwnershipError is returned by CheckOwnership for repositories owned by
+// another user, the equivalent of git's `detected dubious ownership` error.
+type UnsafeOwnershipError struct {
+	Path  string
+	Owner int
+	User  int
+}
+
+func (e *UnsafeOwnershipError) Error() string {
+	return fmt.Sprintf("%s is owned by uid %d but the provider runs as uid %d, which git refuses as dubious ownership. "+
+		"Add it to safe.directory in the git configuration (`git config --global --add safe.directory %s`) "+
+		"or set allow_unsafe_ownership in the provider configuration to trust it", e.Path, e.Owner, e.User, e.Path)
+}
+
+// CheckOwnership returns an *UnsafeOwnershipError when the repository at path,
+// or its .git directory, is not owned by the current user and path is not
+// trusted by safeDirectories, the safe.directory values of the git
+// configuration. Ownership is not checked on Windows.
+func CheckOwnership(path string, safeDirectories []string) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	if isSafeDirectory(abs, safeDirectories) {
+		return nil
+	}
+
+	user, ok := currentUID()
+	if !ok {
+		return nil
+	}
+
+	for _, p := range []string{abs, filepath.Join(abs, ".git")} {
+		owner, ok, err := ownerUID(p)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if ok && owner != user {
+			return &UnsafeOwnershipError{Path: abs, Owner: owner, User: user}
+		}
+	}
+
+	return nil
+}
+
+// SafeDirectories reads the safe.directory values of the system and global git
+// configuration, git ignores it in the repository configuration. An empty
+// value resets the list.
+func SafeDirectories() ([]string, error) {
+	var directories []string
+
+	for _, scope := range []config.Scope{config.SystemScope, config.GlobalScope} {
+		cfg, err := config.LoadConfig(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, directory := range cfg.Raw.Section("safe").Options.GetAll("directory") {
+			if directory == "" {
+				directories = nil
+				continue
+			}
+			directories = append(directories, directory)
+		}
+	}
+
+	return directories, nil
+}
+
+// isSafeDirectory reports whether path is trusted by safeDirectories: `*`
+// trusts every repository and a trailing `/*` every repository below a
+// directory.
+func isSafeDirectory(path string, safeDirectories []string) bool {
+	for _, directory := range safeDirectories {
+		if directory == "*" {
+			return true
+		}
+
+		if strings.HasSuffix(directory, "/*") {
+			if strings.HasPrefix(filepath.ToSlash(path)+"/", strings.TrimSuffix(directory, "*")) {
+				return true
+			}
+			continue
+		}
+
+		if filepath.Clean(filepath.FromSlash(directory)) == path {
+			return true
+		}
+	}
+
+	return false
+}
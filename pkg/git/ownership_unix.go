@@ -0,0 +1,34 @@
+//go:build !windows
+
+package git
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// currentUID returns the user ownership is checked against, the user who ran
+// sudo when running as root through it, like git.
+func currentUID() (int, bool) {
+	uid := os.Geteuid()
+	if uid == 0 {
+		if sudo, err := strconv.Atoi(os.Getenv("SUDO_UID")); err == nil {
+			return sudo, true
+		}
+	}
+	return uid, true
+}
+
+func ownerUID(path string) (int, bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, false, err
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false, nil
+	}
+	return int(stat.Uid), true, nil
+}
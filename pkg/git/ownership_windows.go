@@ -0,0 +1,12 @@
+//go:build windows
+
+package git
+
+// currentUID is not available on Windows, ownership is not checked.
+func currentUID() (int, bool) {
+	return 0, false
+}
+
+func ownerUID(path string) (int, bool, error) {
+	return 0, false, nil
+}
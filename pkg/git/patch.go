@@ -0,0 +1,135 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// patchNameMaxLength is the maximum length of the subject part of patch file
+// names, as used by `git format-patch`.
+const patchNameMaxLength = 52
+
+var patchNameInvalidRegexp = regexp.MustCompile(`[^A-Za-z0-9._]+`)
+
+// FormatPatch renders the changes commit introduces over its first parent, or
+// over an empty tree for a root commit, as an mbox email like
+// `git format-patch --no-signature` that `git am` applies. number and total
+// produce the `[PATCH n/m]` subject prefix, a total of 1 produces `[PATCH]`.
+func FormatPatch(ctx context.Context, commit *object.Commit, number int, total int) (string, error) {
+	patch, err := firstParentPatch(ctx, commit)
+	if err != nil {
+		return "", err
+	}
+
+	subject, body := splitMessage(commit.Message)
+
+	prefix := "[PATCH]"
+	if total > 1 {
+		prefix = fmt.Sprintf("[PATCH %d/%d]", number, total)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "From %s Mon Sep 17 00:00:00 2001\n", commit.Hash.String())
+	fmt.Fprintf(&sb, "From: %s <%s>\n", commit.Author.Name, commit.Author.Email)
+	fmt.Fprintf(&sb, "Date: %s\n", commit.Author.When.Format("Mon, 2 Jan 2006 15:04:05 -0700"))
+	fmt.Fprintf(&sb, "Subject: %s %s\n\n", prefix, subject)
+	if body != "" {
+		sb.WriteString(body + "\n")
+	}
+	sb.WriteString("---\n")
+
+	stats := patch.Stats()
+	if len(stats) > 0 {
+		sb.WriteString(stats.String())
+		sb.WriteString(diffStatSummary(stats) + "\n\n")
+		sb.WriteString(patch.String())
+	}
+
+	return sb.String(), nil
+}
+
+// PatchFileName returns the name `git format-patch` writes the patch of the
+// commit with message to, ie. `0001-fix-handle-empty-body.patch`.
+func PatchFileName(number int, message string) string {
+	subject, _ := splitMessage(message)
+
+	name := strings.Trim(patchNameInvalidRegexp.ReplaceAllString(subject, "-"), "-.")
+	if len(name) > patchNameMaxLength {
+		name = strings.TrimRight(name[:patchNameMaxLength], "-.")
+	}
+
+	return fmt.Sprintf("%04d-%s.patch", number, name)
+}
+
+// firstParentPatch returns the patch of the changes commit introduces over
+// its first parent, or over an empty tree for a root commit.
+func firstParentPatch(ctx context.Context, commit *object.Commit) (*object.Patch, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	parentTree := &object.Tree{}
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := object.DiffTreeWithOptions(ctx, parentTree, tree, object.DefaultDiffTreeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes.PatchContext(ctx)
+}
+
+// splitMessage splits a commit message into its subject, the first
+// paragraph joined into a single line, and its body.
+func splitMessage(message string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(message), "\n\n", 2)
+
+	subject := strings.Join(strings.Fields(parts[0]), " ")
+	if len(parts) == 1 {
+		return subject, ""
+	}
+
+	return subject, strings.TrimSpace(parts[1])
+}
+
+// diffStatSummary returns the summary line ending `git diff --stat`, ie.
+// ` 2 files changed, 3 insertions(+), 1 deletion(-)`.
+func diffStatSummary(stats object.FileStats) string {
+	var additions, deletions int
+	for _, stat := range stats {
+		additions += stat.Addition
+		deletions += stat.Deletion
+	}
+
+	summary := fmt.Sprintf(" %d %s changed", len(stats), plural(len(stats), "file", "files"))
+	if additions > 0 || deletions == 0 {
+		summary += fmt.Sprintf(", %d %s(+)", additions, plural(additions, "insertion", "insertions"))
+	}
+	if deletions > 0 || additions == 0 {
+		summary += fmt.Sprintf(", %d %s(-)", deletions, plural(deletions, "deletion", "deletions"))
+	}
+
+	return summary
+}
+
+func plural(n int, singular string, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,93 @@
+package git
+
+import (
+	"context"
+	"crypto/sha1"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// PatchID returns an identifier of the changes commit introduces over its
+// first parent that is stable across cherry-picks, in the spirit of
+// `git patch-id`: file names and added or removed lines are hashed with
+// whitespace removed, so line numbers, context and the commit metadata do
+// not matter. Binary files are identified by the blobs they change between.
+func PatchID(ctx context.Context, commit *object.Commit) (plumbing.Hash, error) {
+	patch, err := firstParentPatch(ctx, commit)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	filePatches := patch.FilePatches()
+	sort.SliceStable(filePatches, func(i, j int) bool {
+		return patchFileName(filePatches[i]) < patchFileName(filePatches[j])
+	})
+
+	h := sha1.New()
+	for _, fp := range filePatches {
+		from, to := fp.Files()
+		for _, f := range []diff.File{from, to} {
+			if f == nil {
+				h.Write([]byte("/dev/null\n"))
+				continue
+			}
+			h.Write([]byte(f.Path() + "\n"))
+		}
+
+		if fp.IsBinary() {
+			for _, f := range []diff.File{from, to} {
+				if f != nil {
+					h.Write([]byte(f.Hash().String() + "\n"))
+				}
+			}
+			continue
+		}
+
+		for _, chunk := range fp.Chunks() {
+			var prefix string
+			switch chunk.Type() {
+			case diff.Add:
+				prefix = "+"
+			case diff.Delete:
+				prefix = "-"
+			default:
+				continue
+			}
+
+			for _, line := range strings.SplitAfter(chunk.Content(), "\n") {
+				if line == "" {
+					continue
+				}
+				h.Write([]byte(prefix + stripWhitespace(line) + "\n"))
+			}
+		}
+	}
+
+	var id plumbing.Hash
+	copy(id[:], h.Sum(nil))
+	return id, nil
+}
+
+// patchFileName returns the path a file patch applies to, its destination
+// unless the file is deleted.
+func patchFileName(fp diff.FilePatch) string {
+	from, to := fp.Files()
+	if to != nil {
+		return to.Path()
+	}
+	return from.Path()
+}
+
+func stripWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
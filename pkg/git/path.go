@@ -0,0 +1,142 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// MatchPath reports whether name matches pattern. A pattern without wildcards
+// matches the path itself and everything below it, `*` and `?` do not cross
+// directory boundaries and `**` matches any number of directories.
+func MatchPath(pattern string, name string) bool {
+	pattern = strings.Trim(pattern, "/")
+	if pattern == "" {
+		return true
+	}
+
+	if !strings.ContainsAny(pattern, "*?[") {
+		return name == pattern || strings.HasPrefix(name, pattern+"/")
+	}
+
+	re, err := regexp.Compile(globToRegexp(pattern, true))
+	if err != nil {
+		return false
+	}
+	return re.MatchString(name)
+}
+
+// matchGlob reports whether name matches pattern like git's wildmatch without
+// FNM_PATHNAME, as used for reference names: `*` and `?` match `/` too.
+func matchGlob(pattern string, name string) bool {
+	re, err := regexp.Compile(globToRegexp(pattern, false))
+	if err != nil {
+		return false
+	}
+	return re.MatchString(name)
+}
+
+// MatchPaths reports whether name matches any include pattern (or there are
+// none) and no exclude pattern.
+func MatchPaths(include []string, exclude []string, name string) bool {
+	matched := len(include) == 0
+	for _, pattern := range include {
+		if MatchPath(pattern, name) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+
+	for _, pattern := range exclude {
+		if MatchPath(pattern, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// CommitTouchesPaths reports whether the commit changed, compared with its
+// first parent, at least one path accepted by MatchPaths.
+func CommitTouchesPaths(c *object.Commit, include []string, exclude []string) (bool, error) {
+	tree, err := c.Tree()
+	if err != nil {
+		return false, err
+	}
+
+	var parentTree *object.Tree
+	if c.NumParents() > 0 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return false, err
+		}
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return false, err
+	}
+
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			if name != "" && MatchPaths(include, exclude, name) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// globToRegexp converts the glob pattern to a regular expression. With
+// pathname, `*` and `?` do not match `/` and a matching directory also matches
+// everything below it.
+func globToRegexp(pattern string, pathname bool) string {
+	star, question := ".*", "."
+	if pathname {
+		star, question = "[^/]*", "[^/]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '*' && i+1 < len(pattern) && pattern[i+1] == '*':
+			i++
+			if i+1 < len(pattern) && pattern[i+1] == '/' {
+				// `**/` matches zero or more leading directories
+				i++
+				sb.WriteString("(?:.*/)?")
+			} else {
+				sb.WriteString(".*")
+			}
+		case c == '*':
+			sb.WriteString(star)
+		case c == '?':
+			sb.WriteString(question)
+		case c == '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			sb.WriteString(pattern[i : i+end+1])
+			i += end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if pathname {
+		// a matching directory also matches everything below it
+		sb.WriteString("(?:/.*)?")
+	}
+	sb.WriteString("$")
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidRefName returns an error unless name, ie. a branch or tag name without
+// its refs/heads/ or refs/tags/ prefix, follows the rules of
+// `git check-ref-format --allow-onelevel`.
+func ValidRefName(name string) error {
+	switch {
+	case name == "" || name == "@":
+		return fmt.Errorf("%q is not a valid reference name", name)
+	case strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") || strings.Contains(name, "//"):
+		return fmt.Errorf("%q must not start or end with a slash or hold consecutive slashes", name)
+	case strings.HasSuffix(name, "."):
+		return fmt.Errorf("%q must not end with a dot", name)
+	case strings.Contains(name, "..") || strings.Contains(name, "@{"):
+		return fmt.Errorf("%q must not contain .. or @{", name)
+	}
+
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("%q must not contain %q", name, r)
+		}
+	}
+
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return fmt.Errorf("%q must not have components starting with a dot or ending with .lock", name)
+		}
+	}
+
+	return nil
+}
+
+// MatchRefName reports whether the full reference name, ie. refs/heads/main,
+// matches one of the glob patterns, in which `*` also matches `/`.
+func MatchRefName(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
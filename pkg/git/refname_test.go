@@ -0,0 +1,66 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidRefName(t *testing.T) {
+	cases := []struct {
+		name  string
+		ref   string
+		valid bool
+	}{
+		{"branch", "main", true},
+		{"nested", "feature/login", true},
+		{"empty", "", false},
+		{"at", "@", false},
+		{"leading slash", "/main", false},
+		{"trailing slash", "main/", false},
+		{"double slash", "feature//login", false},
+		{"trailing dot", "main.", false},
+		{"double dot", "main..next", false},
+		{"reflog", "main@{1}", false},
+		{"space", "my branch", false},
+		{"wildcard", "feature/*", false},
+		{"control character", "main\x01", false},
+		{"hidden component", "feature/.login", false},
+		{"lock component", "feature.lock/login", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidRefName(c.ref)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMatchRefName(t *testing.T) {
+	cases := []struct {
+		name     string
+		patterns []string
+		ref      string
+		expected bool
+	}{
+		{"no patterns", nil, "refs/heads/main", false},
+		{"exact", []string{"refs/heads/main"}, "refs/heads/main", true},
+		{"exact other", []string{"refs/heads/main"}, "refs/heads/main2", false},
+		{"star", []string{"refs/heads/release-*"}, "refs/heads/release-1", true},
+		{"star crosses slashes", []string{"refs/tags/*"}, "refs/tags/app/v1.0.0", true},
+		{"any pattern", []string{"refs/heads/main", "refs/tags/*"}, "refs/tags/v1.0.0", true},
+		{"other namespace", []string{"refs/heads/*"}, "refs/remotes/origin/main", false},
+		{"anchored", []string{"heads/*"}, "refs/heads/main", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, MatchRefName(c.patterns, c.ref))
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package git
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+var upstreamSuffix = regexp.MustCompile(`(?i)@\{(u|upstream)\}`)
+
+// RevParseResult is the object a revision expression names.
+type RevParseResult struct {
+	Hash plumbing.Hash
+	Type plumbing.ObjectType
+	// Reference is the full name of the reference the expression names (ie.
+	// refs/heads/main for main, HEAD or main@{u}), empty for other expressions.
+	Reference plumbing.ReferenceName
+}
+
+// RevParse resolves a revision expression like `git rev-parse` does, without
+// peeling the object it names, so an annotated tag resolves to the tag object
+// rather than to its commit. On top of what repo.ResolveRevision understands
+// (ie. HEAD~3, main^2, abbreviated hashes) it supports `<branch>@{upstream}`,
+// peeling with `<rev>^{type}` and `<rev>:<path>`. Reflog entries and the index
+// are not supported.
+func RevParse(repo *git.Repository, rev string) (*RevParseResult, error) {
+	if rev == "" {
+		return nil, fmt.Errorf("empty revision")
+	}
+	if strings.HasPrefix(rev, ":") {
+		return nil, fmt.Errorf("%q: the index and commit message searches are not supported", rev)
+	}
+
+	if i := revPathSeparator(rev); i > 0 {
+		return revParsePath(repo, rev[:i], rev[i+1:])
+	}
+
+	if i := strings.LastIndex(rev, "^{"); i > 0 && strings.HasSuffix(rev, "}") && !strings.HasPrefix(rev[i+2:], "/") {
+		return revParsePeel(repo, rev[:i], rev[i+2:len(rev)-1])
+	}
+
+	if rev == "@" || strings.HasPrefix(rev, "@~") || strings.HasPrefix(rev, "@^") {
+		rev = "HEAD" + rev[1:]
+	}
+
+	if loc := upstreamSuffix.FindStringIndex(rev); loc != nil {
+		branch := rev[:loc[0]]
+
+		upstream, err := BranchUpstream(repo, branch)
+		if err != nil {
+			return nil, err
+		}
+		if upstream == nil {
+			return nil, fmt.Errorf("no upstream configured for branch %q", branch)
+		}
+
+		rev = upstream.Reference().String() + rev[loc[1]:]
+	}
+
+	if strings.Contains(rev, "@{") {
+		return nil, fmt.Errorf("%q: reflog entries are not supported", rev)
+	}
+
+	if !strings.ContainsAny(rev, "~^") {
+		result, err := revParseName(repo, rev)
+		if err != nil || result != nil {
+			return result, err
+		}
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve revision %q: %v", rev, err)
+	}
+
+	return &RevParseResult{Hash: *hash, Type: plumbing.CommitObject}, nil
+}
+
+// revPathSeparator returns the index of the colon separating the revision
+// from the path in `<rev>:<path>`, skipping those in `^{/regex}`, or -1.
+func revPathSeparator(rev string) int {
+	depth := 0
+	for i, r := range rev {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ':':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// revParseName resolves a full hash, a reference name or an abbreviated hash,
+// in this order like git does, returning nil when name is none of them.
+func revParseName(repo *git.Repository, name string) (*RevParseResult, error) {
+	if len(name) == len(plumbing.ZeroHash)*2 && isHex(name) {
+		return revParseObject(repo, plumbing.NewHash(name), "")
+	}
+
+	for _, rule := range append([]string{"%s"}, plumbing.RefRevParseRules...) {
+		ref, err := storer.ResolveReference(repo.Storer, plumbing.ReferenceName(fmt.Sprintf(rule, name)))
+		if err == plumbing.ErrReferenceNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return revParseObject(repo, ref.Hash(), ref.Name())
+	}
+
+	if len(name) >= 4 && isHex(name) {
+		hash, err := resolveAbbrev(repo, strings.ToLower(name))
+		if err != nil || hash.IsZero() {
+			return nil, err
+		}
+		return revParseObject(repo, hash, "")
+	}
+
+	return nil, nil
+}
+
+// resolveAbbrev returns the object whose hash starts with prefix, the zero
+// hash when there is none.
+func resolveAbbrev(repo *git.Repository, prefix string) (plumbing.Hash, error) {
+	s, ok := repo.Storer.(hashPrefixer)
+	if !ok {
+		return plumbing.ZeroHash, fmt.Errorf("storage %T can not list objects by prefix", repo.Storer)
+	}
+
+	b, err := hex.DecodeString(prefix[:len(prefix)&^1])
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	hashes, err := s.HashesWithPrefix(b)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	var found []plumbing.Hash
+	for _, h := range hashes {
+		if strings.HasPrefix(h.String(), prefix) {
+			found = append(found, h)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return plumbing.ZeroHash, nil
+	case 1:
+		return found[0], nil
+	}
+
+	return plumbing.ZeroHash, fmt.Errorf("short object id %s is ambiguous, %d objects match", prefix, len(found))
+}
+
+func revParseObject(repo *git.Repository, hash plumbing.Hash, name plumbing.ReferenceName) (*RevParseResult, error) {
+	obj, err := repo.Storer.EncodedObject(plumbing.AnyObject, hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read object %s: %v", hash.String(), err)
+	}
+
+	return &RevParseResult{Hash: hash, Type: obj.Type(), Reference: name}, nil
+}
+
+// revParsePeel resolves rev then peels it to an object of type typ like
+// `<rev>^{type}` does.
+func revParsePeel(repo *git.Repository, rev string, typ string) (*RevParseResult, error) {
+	r, err := RevParse(repo, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	peeled, err := r.Peel(repo, typ)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %v", rev, err)
+	}
+
+	return peeled, nil
+}
+
+// Peel dereferences the object until one of type typ (`commit`, `tree`,
+// `blob` or `tag`) is found: tags are followed to their target and commits to
+// their tree. An empty typ peels tags until something else is found, `object`
+// returns the object as is.
+func (r *RevParseResult) Peel(repo *git.Repository, typ string) (*RevParseResult, error) {
+	switch typ {
+	case "", "object", "commit", "tree", "blob", "tag":
+	default:
+		return nil, fmt.Errorf("unknown object type %q", typ)
+	}
+
+	hash, t := r.Hash, r.Type
+	for typ != "object" && t.String() != typ && (typ != "" || t == plumbing.TagObject) {
+		switch {
+		case t == plumbing.TagObject:
+			tag, err := repo.TagObject(hash)
+			if err != nil {
+				return nil, err
+			}
+			hash, t = tag.Target, tag.TargetType
+		case t == plumbing.CommitObject && typ == "tree":
+			c, err := repo.CommitObject(hash)
+			if err != nil {
+				return nil, err
+			}
+			hash, t = c.TreeHash, plumbing.TreeObject
+		default:
+			return nil, fmt.Errorf("%s %s can not be peeled to a %s", t.String(), hash.String(), typ)
+		}
+	}
+
+	return &RevParseResult{Hash: hash, Type: t}, nil
+}
+
+// revParsePath resolves the entry at name in the tree rev peels to, like
+// `<rev>:<path>` does.
+func revParsePath(repo *git.Repository, rev string, name string) (*RevParseResult, error) {
+	r, err := revParsePeel(repo, rev, "tree")
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.Trim(name, "/")
+	if name == "" {
+		return r, nil
+	}
+
+	tree, err := repo.TreeObject(r.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := tree.FindEntry(name)
+	if err != nil {
+		return nil, fmt.Errorf("path %q does not exist in %q: %v", name, rev, err)
+	}
+
+	t := plumbing.BlobObject
+	switch entry.Mode {
+	case filemode.Dir:
+		t = plumbing.TreeObject
+	case filemode.Submodule:
+		t = plumbing.CommitObject
+	}
+
+	return &RevParseResult{Hash: entry.Hash, Type: t}, nil
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,310 @@
+package git
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/crypto/ssh"
+)
+
+// Signature describes a commit or tag signature without verifying it.
+type Signature struct {
+	// Type is one of gpg, ssh, x509 or unknown.
+	Type string
+	// KeyID is the long key ID for gpg signatures and the SHA256 fingerprint
+	// of the public key for ssh signatures.
+	KeyID       string
+	Fingerprint string
+	// SignerEmail is only known when the signature embeds the signer's user
+	// ID, which gpg does when signing with --sender.
+	SignerEmail string
+}
+
+const (
+	pgpSignatureHeader  = "-----BEGIN PGP SIGNATURE-----"
+	sshSignatureHeader  = "-----BEGIN SSH SIGNATURE-----"
+	x509SignatureHeader = "-----BEGIN SIGNED MESSAGE-----"
+)
+
+// SignCommit signs commit with key like `git commit -S` does, replacing any
+// signature it has.
+func SignCommit(commit *object.Commit, key *openpgp.Entity) error {
+	commit.PGPSignature = ""
+
+	encoded := &plumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(encoded); err != nil {
+		return err
+	}
+	r, err := encoded.Reader()
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&b, key, r, nil); err != nil {
+		return fmt.Errorf("unable to sign commit: %v", err)
+	}
+	commit.PGPSignature = b.String()
+
+	return nil
+}
+
+// ParseSignature ...
+func ParseSignature(armored string) (*Signature, error) {
+	armored = strings.TrimSpace(armored)
+
+	switch {
+	case strings.HasPrefix(armored, pgpSignatureHeader):
+		return parsePGPSignature(armored)
+	case strings.HasPrefix(armored, sshSignatureHeader):
+		return parseSSHSignature(armored)
+	case strings.HasPrefix(armored, x509SignatureHeader):
+		return &Signature{Type: "x509"}, nil
+	}
+
+	return &Signature{Type: "unknown"}, nil
+}
+
+func parsePGPSignature(armored string) (*Signature, error) {
+	block, err := armor.Decode(strings.NewReader(armored))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pgp signature: %v", err)
+	}
+
+	body, err := io.ReadAll(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pgp signature: %v", err)
+	}
+
+	tag, content, err := readPGPPacket(body)
+	if err != nil {
+		return nil, err
+	}
+	if tag != 2 {
+		return nil, fmt.Errorf("unexpected pgp packet type %d, expected a signature", tag)
+	}
+	if len(content) == 0 {
+		return nil, fmt.Errorf("empty pgp signature packet")
+	}
+
+	sig := &Signature{Type: "gpg"}
+
+	switch content[0] {
+	case 3:
+		// version, hashed length, type, creation time, key ID
+		if len(content) < 15 {
+			return nil, fmt.Errorf("truncated pgp signature packet")
+		}
+		sig.KeyID = fmt.Sprintf("%X", content[7:15])
+	case 4, 5:
+		// version, type, public key algorithm, hash algorithm, then the
+		// hashed and unhashed subpacket areas
+		rest := content[4:]
+		for i := 0; i < 2; i++ {
+			if len(rest) < 2 {
+				return nil, fmt.Errorf("truncated pgp signature packet")
+			}
+			n := int(binary.BigEndian.Uint16(rest))
+			if len(rest) < 2+n {
+				return nil, fmt.Errorf("truncated pgp signature packet")
+			}
+			if err := readPGPSubpackets(rest[2:2+n], sig); err != nil {
+				return nil, err
+			}
+			rest = rest[2+n:]
+		}
+	}
+
+	if sig.KeyID == "" && len(sig.Fingerprint) == 40 {
+		sig.KeyID = sig.Fingerprint[24:]
+	}
+
+	return sig, nil
+}
+
+// readPGPPacket returns the tag and content of the first packet in b.
+func readPGPPacket(b []byte) (byte, []byte, error) {
+	if len(b) < 2 || b[0]&0x80 == 0 {
+		return 0, nil, fmt.Errorf("invalid pgp packet")
+	}
+
+	var tag byte
+	var length, offset int
+
+	if b[0]&0x40 == 0 {
+		// old format, the length type is in the low bits of the header
+		tag = (b[0] & 0x3f) >> 2
+		switch b[0] & 0x03 {
+		case 0:
+			length, offset = int(b[1]), 2
+		case 1:
+			if len(b) < 3 {
+				return 0, nil, fmt.Errorf("truncated pgp packet")
+			}
+			length, offset = int(binary.BigEndian.Uint16(b[1:])), 3
+		case 2:
+			if len(b) < 5 {
+				return 0, nil, fmt.Errorf("truncated pgp packet")
+			}
+			length, offset = int(binary.BigEndian.Uint32(b[1:])), 5
+		default:
+			length, offset = len(b)-1, 1
+		}
+	} else {
+		tag = b[0] & 0x3f
+		n, size, err := readPGPLength(b[1:])
+		if err != nil {
+			return 0, nil, err
+		}
+		length, offset = n, 1+size
+	}
+
+	if length < 0 || len(b) < offset+length {
+		return 0, nil, fmt.Errorf("truncated pgp packet")
+	}
+
+	return tag, b[offset : offset+length], nil
+}
+
+// readPGPLength decodes a new format packet or subpacket length, returning
+// the length and the number of bytes it was encoded in.
+func readPGPLength(b []byte) (int, int, error) {
+	switch {
+	case len(b) < 1:
+	case b[0] < 192:
+		return int(b[0]), 1, nil
+	case b[0] < 255:
+		if len(b) < 2 {
+			break
+		}
+		return (int(b[0])-192)<<8 + int(b[1]) + 192, 2, nil
+	default:
+		if len(b) < 5 {
+			break
+		}
+		return int(binary.BigEndian.Uint32(b[1:])), 5, nil
+	}
+
+	return 0, 0, fmt.Errorf("truncated pgp length")
+}
+
+// readPGPSubpackets collects the issuer and signer details from a subpacket
+// area into sig.
+func readPGPSubpackets(b []byte, sig *Signature) error {
+	for len(b) > 0 {
+		length, size, err := readPGPLength(b)
+		if err != nil {
+			return err
+		}
+		if length < 1 || len(b) < size+length {
+			return fmt.Errorf("truncated pgp subpacket")
+		}
+
+		kind, data := b[size]&0x7f, b[size+1:size+length]
+		b = b[size+length:]
+
+		switch kind {
+		case 16: // issuer
+			if len(data) == 8 {
+				sig.KeyID = fmt.Sprintf("%X", data)
+			}
+		case 28: // signer's user ID
+			sig.SignerEmail = userIDEmail(string(data))
+		case 33: // issuer fingerprint, prefixed with the key version
+			if len(data) > 1 {
+				sig.Fingerprint = fmt.Sprintf("%X", data[1:])
+			}
+		}
+	}
+
+	return nil
+}
+
+// userIDEmail extracts the email of a `Name <email>` user ID.
+func userIDEmail(uid string) string {
+	if addr, err := mail.ParseAddress(uid); err == nil {
+		return addr.Address
+	}
+	if strings.Contains(uid, "@") {
+		return strings.Trim(uid, "<> ")
+	}
+	return ""
+}
+
+func parseSSHSignature(armored string) (*Signature, error) {
+	sig, err := decodeSSHSignature(armored)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := ssh.FingerprintSHA256(sig.publicKey)
+
+	return &Signature{
+		Type:        "ssh",
+		KeyID:       fingerprint,
+		Fingerprint: fingerprint,
+	}, nil
+}
+
+// sshSignature is a decoded SSHSIG blob.
+type sshSignature struct {
+	publicKey     ssh.PublicKey
+	namespace     string
+	hashAlgorithm string
+	signature     []byte
+}
+
+func decodeSSHSignature(armored string) (*sshSignature, error) {
+	block, _ := pem.Decode([]byte(armored))
+	if block == nil {
+		return nil, fmt.Errorf("unable to decode ssh signature")
+	}
+
+	// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+	r := bytes.NewReader(block.Bytes)
+
+	magic := make([]byte, 6)
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != "SSHSIG" {
+		return nil, fmt.Errorf("invalid ssh signature preamble")
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return nil, fmt.Errorf("truncated ssh signature")
+	}
+
+	// public key, namespace, reserved, hash algorithm and signature
+	fields := make([][]byte, 5)
+	for i := range fields {
+		var length uint32
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil || int(length) > r.Len() {
+			return nil, fmt.Errorf("truncated ssh signature")
+		}
+
+		fields[i] = make([]byte, length)
+		if _, err := io.ReadFull(r, fields[i]); err != nil {
+			return nil, fmt.Errorf("truncated ssh signature")
+		}
+	}
+
+	publicKey, err := ssh.ParsePublicKey(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse ssh signature public key: %v", err)
+	}
+
+	return &sshSignature{
+		publicKey:     publicKey,
+		namespace:     string(fields[1]),
+		hashAlgorithm: string(fields[3]),
+		signature:     fields[4],
+	}, nil
+}
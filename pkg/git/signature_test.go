@@ -0,0 +1,107 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignCommit(t *testing.T) {
+	key, err := openpgp.NewEntity("Signer", "", "signer@example.com", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	other, err := openpgp.NewEntity("Other", "", "other@example.com", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	cases := []struct {
+		name   string
+		signer *openpgp.Entity
+	}{
+		{"unsigned", nil},
+		{"signed", other},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sig := object.Signature{Name: "Author", Email: "author@example.com", When: time.Unix(1600000000, 0)}
+			commit := &object.Commit{
+				Author:    sig,
+				Committer: sig,
+				Message:   "signed commit\n",
+				TreeHash:  plumbing.NewHash("4b825dc642cb6eb9a060e54bf8d69288fbe4904c"),
+			}
+			if c.signer != nil {
+				assert.NoError(t, SignCommit(commit, c.signer))
+			}
+
+			if !assert.NoError(t, SignCommit(commit, key)) {
+				return
+			}
+
+			_, err := commit.Verify(testArmoredPublicKey(t, key))
+			assert.NoError(t, err)
+			_, err = commit.Verify(testArmoredPublicKey(t, other))
+			assert.Error(t, err)
+
+			parsed, err := ParseSignature(commit.PGPSignature)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "gpg", parsed.Type)
+			assert.Equal(t, fmt.Sprintf("%016X", key.PrimaryKey.KeyId), parsed.KeyID)
+		})
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	cases := []struct {
+		name     string
+		armored  string
+		expected string
+		err      bool
+	}{
+		{"empty", "", "unknown", false},
+		{"unknown", "signature", "unknown", false},
+		{"x509", "-----BEGIN SIGNED MESSAGE-----\nMIAG\n-----END SIGNED MESSAGE-----\n", "x509", false},
+		{"invalid pgp", "-----BEGIN PGP SIGNATURE-----\n\n!!!\n-----END PGP SIGNATURE-----\n", "", true},
+		{"invalid ssh", "-----BEGIN SSH SIGNATURE-----\nU1NI\n-----END SSH SIGNATURE-----\n", "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sig, err := ParseSignature(c.armored)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.expected, sig.Type)
+			}
+		})
+	}
+}
+
+// testArmoredPublicKey returns the armored public key of key.
+func testArmoredPublicKey(t *testing.T, key *openpgp.Entity) string {
+	var b bytes.Buffer
+	w, err := armor.Encode(&b, openpgp.PublicKeyType, nil)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if !assert.NoError(t, key.Serialize(w)) {
+		t.FailNow()
+	}
+	assert.NoError(t, w.Close())
+
+	return b.String()
+}
@@ -0,0 +1,36 @@
+package git
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+// slugHashLength is the number of hexadecimal digits of the hash suffix
+// appended to truncated slugs.
+const slugHashLength = 8
+
+var slugInvalidRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify converts a branch name into a lowercase DNS label safe slug of at
+// most maxLength characters (ie. `feature/JIRA-12_login` becomes
+// `feature-jira-12-login`), reporting whether it had to be truncated.
+// Truncated slugs end with a hash of the name so branches sharing a long
+// prefix do not collide.
+func Slugify(name string, maxLength int) (string, bool) {
+	name = strings.TrimPrefix(name, "refs/heads/")
+
+	slug := strings.Trim(slugInvalidRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(slug) <= maxLength {
+		return slug, false
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(sum[:])[:slugHashLength]
+	if maxLength <= slugHashLength {
+		return suffix[:maxLength], true
+	}
+
+	return strings.TrimRight(slug[:maxLength-slugHashLength-1], "-") + "-" + suffix, true
+}
@@ -0,0 +1,427 @@
+package git
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+)
+
+// SparseCheckoutFile returns the content of the sparse-checkout file for
+// patterns, like `git sparse-checkout set` writes it. In cone mode patterns are
+// directories checked out recursively, along with the files directly in their
+// parent directories and at the root, otherwise they are gitignore style
+// patterns of the paths to check out.
+func SparseCheckoutFile(cone bool, patterns []string) string {
+	if !cone {
+		return strings.Join(patterns, "\n") + "\n"
+	}
+
+	recursive := map[string]bool{}
+	for _, pattern := range patterns {
+		if dir := strings.Trim(path.Clean("/"+pattern), "/"); dir != "" {
+			recursive[dir] = true
+		}
+	}
+
+	// Directories inside a recursive one are already checked out.
+	dirs := map[string]bool{}
+	for dir := range recursive {
+		covered := false
+		for parent := path.Dir(dir); parent != "."; parent = path.Dir(parent) {
+			if recursive[parent] {
+				covered = true
+				break
+			}
+		}
+		if covered {
+			continue
+		}
+
+		dirs[dir] = true
+		for parent := path.Dir(dir); parent != "."; parent = path.Dir(parent) {
+			if _, ok := dirs[parent]; !ok {
+				dirs[parent] = false
+			}
+		}
+	}
+
+	names := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		names = append(names, dir)
+	}
+	sort.Strings(names)
+
+	lines := []string{"/*", "!/*/"}
+	for _, dir := range names {
+		lines = append(lines, "/"+dir+"/")
+		if !dirs[dir] {
+			lines = append(lines, "!/"+dir+"/*/")
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// ParseSparseCheckoutFile returns the patterns of the sparse-checkout file
+// content, in cone mode the directories checked out recursively.
+func ParseSparseCheckoutFile(cone bool, content string) []string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if !cone {
+		return lines
+	}
+
+	parents := map[string]bool{}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "!/") && strings.HasSuffix(line, "/*/") {
+			parents[strings.TrimSuffix(line[1:], "*/")] = true
+		}
+	}
+
+	var dirs []string
+	for _, line := range lines {
+		if line == "/*" || !strings.HasPrefix(line, "/") || !strings.HasSuffix(line, "/") || parents[line] {
+			continue
+		}
+		dirs = append(dirs, strings.Trim(line, "/"))
+	}
+
+	return dirs
+}
+
+// SparseCheckoutMatcher returns whether a slash separated path of a file is
+// checked out according to the sparse-checkout file content. Like git, the
+// last pattern matching the path, or one of its directories, decides.
+func SparseCheckoutMatcher(content string) func(name string) bool {
+	var patterns []gitignore.Pattern
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, nil))
+	}
+
+	return func(name string) bool {
+		parts := strings.Split(name, "/")
+		for i := len(patterns) - 1; i >= 0; i-- {
+			switch patterns[i].Match(parts, false) {
+			case gitignore.Exclude:
+				return true
+			case gitignore.Include:
+				return false
+			}
+		}
+		return false
+	}
+}
+
+// SparseCheckoutApplied returns whether the working tree of repo only holds
+// the files match checks out, like `git sparse-checkout reapply` leaves it.
+func SparseCheckoutApplied(repo *git.Repository, match func(name string) bool) (bool, error) {
+	changed, err := sparseCheckout(repo, match, false)
+	return !changed, err
+}
+
+// ApplySparseCheckout updates the working tree of repo to only hold the files
+// match checks out, marking the others skip-worktree in the index and
+// removing them unless they have local changes. Files checked out again are
+// restored from the index.
+func ApplySparseCheckout(repo *git.Repository, match func(name string) bool) error {
+	_, err := sparseCheckout(repo, match, true)
+	return err
+}
+
+// sparseCheckout returns whether the working tree of repo, or its index,
+// differs from the files match checks out, updating both when apply is set.
+func sparseCheckout(repo *git.Repository, match func(name string) bool, apply bool) (bool, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return false, err
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	removed := map[string]bool{}
+	for _, entry := range idx.Entries {
+		// Conflicts are left for the user to resolve, go-git defines Merged as
+		// stage 1 like AncestorMode while merged entries are stage 0.
+		if entry.Stage != 0 {
+			continue
+		}
+
+		_, err := wt.Filesystem.Lstat(entry.Name)
+		exists := err == nil
+		if err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+
+		if match(entry.Name) {
+			if !entry.SkipWorktree && exists {
+				continue
+			}
+
+			changed = true
+			if !apply {
+				continue
+			}
+
+			entry.SkipWorktree = false
+			if !exists {
+				if err := checkoutEntry(repo, wt.Filesystem, entry); err != nil {
+					return false, fmt.Errorf("unable to check out %s: %v", entry.Name, err)
+				}
+			}
+			continue
+		}
+
+		if entry.SkipWorktree && !exists {
+			continue
+		}
+
+		modified := false
+		if exists {
+			if modified, err = entryModified(wt.Filesystem, entry); err != nil {
+				return false, err
+			}
+		}
+
+		// Like git, files with local changes are left in place.
+		if modified {
+			continue
+		}
+
+		changed = true
+		if !apply {
+			continue
+		}
+
+		entry.SkipWorktree = true
+		if exists {
+			if err := wt.Filesystem.Remove(entry.Name); err != nil {
+				return false, err
+			}
+			removed[path.Dir(entry.Name)] = true
+		}
+	}
+
+	if !apply || !changed {
+		return changed, nil
+	}
+
+	// Directories left empty are removed, deepest first.
+	dirs := make([]string, 0, len(removed))
+	for dir := range removed {
+		for ; dir != "."; dir = path.Dir(dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Slice(dirs, func(i, j int) bool { return len(dirs[i]) > len(dirs[j]) })
+	for _, dir := range dirs {
+		if entries, err := wt.Filesystem.ReadDir(dir); err == nil && len(entries) == 0 {
+			if err := wt.Filesystem.Remove(dir); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	gitDir, err := GitDir(repo)
+	if err != nil {
+		return false, err
+	}
+
+	return changed, writeIndex(filepath.Join(gitDir, "index"), idx)
+}
+
+// entryModified returns whether the file of entry in fs differs from the
+// content of the index.
+func entryModified(fs billy.Filesystem, entry *index.Entry) (bool, error) {
+	var content []byte
+
+	if entry.Mode == filemode.Symlink {
+		target, err := fs.Readlink(entry.Name)
+		if err != nil {
+			return false, err
+		}
+		content = []byte(target)
+	} else {
+		info, err := fs.Lstat(entry.Name)
+		if err != nil {
+			return false, err
+		}
+		if !info.Mode().IsRegular() {
+			return true, nil
+		}
+
+		f, err := fs.Open(entry.Name)
+		if err != nil {
+			return false, err
+		}
+		defer f.Close()
+
+		if content, err = io.ReadAll(f); err != nil {
+			return false, err
+		}
+	}
+
+	return plumbing.ComputeHash(plumbing.BlobObject, content) != entry.Hash, nil
+}
+
+// checkoutEntry writes the content of entry to fs.
+func checkoutEntry(repo *git.Repository, fs billy.Filesystem, entry *index.Entry) error {
+	if entry.Mode == filemode.Submodule {
+		return fs.MkdirAll(entry.Name, 0755)
+	}
+
+	blob, err := repo.BlobObject(entry.Hash)
+	if err != nil {
+		return err
+	}
+
+	r, err := blob.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if entry.Mode == filemode.Symlink {
+		target, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return fs.Symlink(string(target), entry.Name)
+	}
+
+	perm := os.FileMode(0644)
+	if entry.Mode == filemode.Executable {
+		perm = 0755
+	}
+
+	f, err := fs.OpenFile(entry.Name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+const (
+	indexEntryExtended     = 0x4000
+	indexSkipWorktree      = 0x4000
+	indexIntentToAdd       = 0x2000
+	indexNameMask          = 0xfff
+	indexEntryHeaderLength = 62
+)
+
+// writeIndex writes idx to the index file name, in version 3 when entries
+// have skip-worktree or intent-to-add set, which go-git is unable to encode.
+// Like go-git, extensions are left out, git rebuilds them as needed.
+func writeIndex(name string, idx *index.Index) error {
+	version := uint32(2)
+	for _, entry := range idx.Entries {
+		if entry.SkipWorktree || entry.IntentToAdd {
+			version = 3
+		}
+	}
+
+	entries := append([]*index.Entry{}, idx.Entries...)
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Name == entries[j].Name {
+			return entries[i].Stage < entries[j].Stage
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("DIRC")
+	_ = binary.Write(&buf, binary.BigEndian, []uint32{version, uint32(len(entries))})
+
+	for _, entry := range entries {
+		if (!entry.CreatedAt.IsZero() && entry.CreatedAt.Unix() < 0) || (!entry.ModifiedAt.IsZero() && entry.ModifiedAt.Unix() < 0) {
+			return errors.New("negative timestamps are not allowed")
+		}
+
+		flags := uint16(entry.Stage&0x3) << 12
+		if l := len(entry.Name); l < indexNameMask {
+			flags |= uint16(l)
+		} else {
+			flags |= indexNameMask
+		}
+
+		var extended uint16
+		if entry.SkipWorktree {
+			extended |= indexSkipWorktree
+		}
+		if entry.IntentToAdd {
+			extended |= indexIntentToAdd
+		}
+		if extended != 0 {
+			flags |= indexEntryExtended
+		}
+
+		_ = binary.Write(&buf, binary.BigEndian, []uint32{
+			indexTime(entry.CreatedAt.Unix(), entry.CreatedAt.IsZero()),
+			indexTime(int64(entry.CreatedAt.Nanosecond()), entry.CreatedAt.IsZero()),
+			indexTime(entry.ModifiedAt.Unix(), entry.ModifiedAt.IsZero()),
+			indexTime(int64(entry.ModifiedAt.Nanosecond()), entry.ModifiedAt.IsZero()),
+			entry.Dev,
+			entry.Inode,
+			uint32(entry.Mode),
+			entry.UID,
+			entry.GID,
+			entry.Size,
+		})
+		buf.Write(entry.Hash[:])
+		_ = binary.Write(&buf, binary.BigEndian, flags)
+
+		wrote := indexEntryHeaderLength + len(entry.Name)
+		if extended != 0 {
+			_ = binary.Write(&buf, binary.BigEndian, extended)
+			wrote += 2
+		}
+		buf.WriteString(entry.Name)
+		buf.Write(make([]byte, 8-wrote%8))
+	}
+
+	sum := sha1.Sum(buf.Bytes())
+	buf.Write(sum[:])
+
+	return writeFileAtomic(name, buf.Bytes())
+}
+
+// indexTime returns t as stored in the index, 0 for zero times.
+func indexTime(t int64, zero bool) uint32 {
+	if zero {
+		return 0
+	}
+	return uint32(t)
+}
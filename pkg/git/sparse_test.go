@@ -0,0 +1,161 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseCheckoutFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		cone     bool
+		patterns []string
+		expected string
+		parsed   []string
+	}{
+		{"root only", true, nil, "/*\n!/*/\n", nil},
+		{"directory", true, []string{"docs"}, "/*\n!/*/\n/docs/\n", []string{"docs"}},
+		{"nested directory", true, []string{"src/app/"}, "/*\n!/*/\n/src/\n!/src/*/\n/src/app/\n", []string{"src/app"}},
+		{"covered directory", true, []string{"src", "src/app"}, "/*\n!/*/\n/src/\n", []string{"src"}},
+		{"siblings", true, []string{"src/b", "/src/a"}, "/*\n!/*/\n/src/\n!/src/*/\n/src/a/\n/src/b/\n", []string{"src/a", "src/b"}},
+		{"patterns", false, []string{"/*", "!/vendor/"}, "/*\n!/vendor/\n", []string{"/*", "!/vendor/"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			content := SparseCheckoutFile(c.cone, c.patterns)
+			assert.Equal(t, c.expected, content)
+			assert.Equal(t, c.parsed, ParseSparseCheckoutFile(c.cone, content))
+		})
+	}
+}
+
+func TestSparseCheckoutMatcher(t *testing.T) {
+	cone := SparseCheckoutFile(true, []string{"src/app"})
+
+	cases := []struct {
+		name     string
+		content  string
+		path     string
+		expected bool
+	}{
+		{"cone root file", cone, "README.md", true},
+		{"cone other directory", cone, "docs/index.md", false},
+		{"cone parent file", cone, "src/main.go", true},
+		{"cone parent directory", cone, "src/lib/lib.go", false},
+		{"cone directory", cone, "src/app/app.go", true},
+		{"cone nested", cone, "src/app/internal/x.go", true},
+		{"last pattern wins", "/*\n!/vendor/\n", "vendor/lib.go", false},
+		{"last pattern wins include", "/*\n!/vendor/\n", "main.go", true},
+		{"comments and blank lines", "# comment\n\n/docs/\n", "docs/index.md", true},
+		{"no match", "/docs/\n", "main.go", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, SparseCheckoutMatcher(c.content)(c.path))
+		})
+	}
+}
+
+func TestWriteIndex(t *testing.T) {
+	hash := plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")
+	when := time.Unix(1600000000, 123456789)
+
+	entry := func(name string, stage index.Stage) *index.Entry {
+		return &index.Entry{
+			Hash:       hash,
+			Name:       name,
+			CreatedAt:  when,
+			ModifiedAt: when.Add(time.Second),
+			Dev:        1,
+			Inode:      2,
+			Mode:       filemode.Regular,
+			UID:        1000,
+			GID:        1000,
+			Size:       42,
+			Stage:      stage,
+		}
+	}
+
+	cases := []struct {
+		name    string
+		entries []*index.Entry
+		version uint32
+	}{
+		{"empty", nil, 2},
+		{"entries", []*index.Entry{entry("a.txt", 0), entry("dir/b.txt", 0)}, 2},
+		{"sorted", []*index.Entry{entry("b.txt", 0), entry("a.txt", 0)}, 2},
+		{"stages", []*index.Entry{entry("a.txt", index.TheirMode), entry("a.txt", index.AncestorMode), entry("a.txt", index.OurMode)}, 2},
+		{"padding", []*index.Entry{entry("a", 0), entry("abcdefgh", 0), entry("abcdefghi", 0)}, 2},
+		{"skip worktree", []*index.Entry{entry("a.txt", 0), func() *index.Entry {
+			e := entry("b.txt", 0)
+			e.SkipWorktree = true
+			return e
+		}()}, 3},
+		{"zero times", []*index.Entry{{Name: "a.txt", Hash: hash, Mode: filemode.Regular}}, 2},
+		{"intent to add", []*index.Entry{func() *index.Entry {
+			e := entry("a.txt", 0)
+			e.IntentToAdd = true
+			return e
+		}()}, 3},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			name := filepath.Join(t.TempDir(), "index")
+			if !assert.NoError(t, writeIndex(name, &index.Index{Version: 2, Entries: c.entries})) {
+				return
+			}
+
+			f, err := os.Open(name)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer f.Close()
+
+			idx := &index.Index{}
+			if !assert.NoError(t, index.NewDecoder(f).Decode(idx)) {
+				return
+			}
+			assert.Equal(t, c.version, idx.Version)
+
+			type key struct {
+				name  string
+				stage index.Stage
+			}
+			expected := map[key]*index.Entry{}
+			for _, e := range c.entries {
+				expected[key{e.Name, e.Stage}] = e
+			}
+			if !assert.Len(t, idx.Entries, len(c.entries)) {
+				return
+			}
+			for i, e := range idx.Entries {
+				if i > 0 {
+					previous := idx.Entries[i-1]
+					assert.True(t, previous.Name < e.Name || previous.Name == e.Name && previous.Stage < e.Stage, "entries are sorted")
+				}
+
+				want := expected[key{e.Name, e.Stage}]
+				if !assert.NotNil(t, want, e.Name) {
+					continue
+				}
+				assert.Equal(t, want.Hash, e.Hash)
+				assert.True(t, want.CreatedAt.Equal(e.CreatedAt), "created at")
+				assert.True(t, want.ModifiedAt.Equal(e.ModifiedAt), "modified at")
+				assert.Equal(t, []uint32{want.Dev, want.Inode, want.UID, want.GID, want.Size}, []uint32{e.Dev, e.Inode, e.UID, e.GID, e.Size})
+				assert.Equal(t, want.Mode, e.Mode)
+				assert.Equal(t, want.SkipWorktree, e.SkipWorktree)
+				assert.Equal(t, want.IntentToAdd, e.IntentToAdd)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Trailer is a `Key: value` line of the trailer block ending a commit message,
+// ie. `Signed-off-by: Jane <jane@example.com>`.
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+var trailerRegexp = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)\s*:\s*(.*)$`)
+
+// ParseTrailers parses the trailers of a commit message like
+// `git interpret-trailers --parse`. Trailers are read from the last paragraph
+// of the message, which must not be the subject and must only hold trailers,
+// lines starting with whitespace continuing the value of the previous one.
+// Git generated `(cherry picked from commit ...)` lines are skipped.
+func ParseTrailers(message string) []Trailer {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(message, "\r\n", "\n"), "\n \t"), "\n")
+
+	start := len(lines)
+	for start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+	// The subject is never a trailer.
+	if start == 0 {
+		return nil
+	}
+
+	var trailers []Trailer
+	for _, line := range lines[start:] {
+		switch {
+		case strings.HasPrefix(line, "(cherry picked from commit "):
+			continue
+		case line[0] == ' ' || line[0] == '\t':
+			if len(trailers) == 0 {
+				return nil
+			}
+			last := &trailers[len(trailers)-1]
+			last.Value = strings.TrimSpace(last.Value + " " + strings.TrimSpace(line))
+			continue
+		}
+
+		match := trailerRegexp.FindStringSubmatch(line)
+		if match == nil {
+			return nil
+		}
+		trailers = append(trailers, Trailer{Key: match[1], Value: strings.TrimSpace(match[2])})
+	}
+
+	return trailers
+}
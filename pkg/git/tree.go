@@ -0,0 +1,153 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// TreeChange is the new content of a file written by WriteTree, a nil
+// Content deleting the file. Setting Symlink writes a symbolic link to Content
+// and setting Submodule a gitlink to that commit instead of a file.
+type TreeChange struct {
+	Content    []byte
+	Executable bool
+	Symlink    bool
+	Submodule  plumbing.Hash
+}
+
+// WriteTree writes the tree resulting from applying changes, keyed by slash
+// separated path, to base, which may be nil to start from an empty tree, and
+// returns its hash. Directories left empty are removed, like git does.
+func WriteTree(s storer.EncodedObjectStorer, base *object.Tree, changes map[string]*TreeChange) (plumbing.Hash, error) {
+	entries := map[string]object.TreeEntry{}
+	if base != nil {
+		for _, entry := range base.Entries {
+			entries[entry.Name] = entry
+		}
+	}
+
+	nested := map[string]map[string]*TreeChange{}
+	for name, change := range changes {
+		dir, rest, ok := strings.Cut(name, "/")
+		if ok {
+			if nested[dir] == nil {
+				nested[dir] = map[string]*TreeChange{}
+			}
+			nested[dir][rest] = change
+			continue
+		}
+
+		if !change.Submodule.IsZero() {
+			entries[name] = object.TreeEntry{Name: name, Mode: filemode.Submodule, Hash: change.Submodule}
+			continue
+		}
+
+		if change.Content == nil {
+			delete(entries, name)
+			continue
+		}
+
+		hash, err := writeBlob(s, change.Content)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		mode := filemode.Regular
+		if change.Symlink {
+			mode = filemode.Symlink
+		} else if change.Executable {
+			mode = filemode.Executable
+		}
+		entries[name] = object.TreeEntry{Name: name, Mode: mode, Hash: hash}
+	}
+
+	for dir, dirChanges := range nested {
+		var subtree *object.Tree
+		if entry, ok := entries[dir]; ok && entry.Mode == filemode.Dir {
+			tree, err := object.GetTree(s, entry.Hash)
+			if err != nil {
+				return plumbing.ZeroHash, err
+			}
+			subtree = tree
+		}
+
+		hash, err := WriteTree(s, subtree, dirChanges)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		if hash == emptyTreeHash {
+			delete(entries, dir)
+			continue
+		}
+		entries[dir] = object.TreeEntry{Name: dir, Mode: filemode.Dir, Hash: hash}
+	}
+
+	tree := &object.Tree{}
+	for _, entry := range entries {
+		tree.Entries = append(tree.Entries, entry)
+	}
+	// Git sorts entries by name, directories as if they ended with a slash.
+	sort.Slice(tree.Entries, func(i, j int) bool {
+		return treeEntrySortName(tree.Entries[i]) < treeEntrySortName(tree.Entries[j])
+	})
+
+	obj := s.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return s.SetEncodedObject(obj)
+}
+
+// ValidTreePath returns an error unless name is a clean, relative, slash
+// separated path to a file outside of `.git`, as accepted by WriteTree.
+func ValidTreePath(name string) error {
+	if name == "" || strings.HasPrefix(name, "/") || path.Clean(name) != name {
+		return fmt.Errorf("%q must be a clean relative path", name)
+	}
+
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." || strings.EqualFold(part, ".git") {
+			return fmt.Errorf("%q must not contain %q", name, part)
+		}
+	}
+
+	return nil
+}
+
+// emptyTreeHash is the hash of the tree without any entry.
+var emptyTreeHash = plumbing.NewHash("4b825dc642cb6eb9a060e54bf8d69288fbee4904")
+
+func writeBlob(s storer.EncodedObjectStorer, content []byte) (plumbing.Hash, error) {
+	obj := s.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+
+	w, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if _, err := bytes.NewReader(content).WriteTo(w); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if err := w.Close(); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return s.SetEncodedObject(obj)
+}
+
+func treeEntrySortName(entry object.TreeEntry) string {
+	if entry.Mode == filemode.Dir {
+		return entry.Name + "/"
+	}
+	return entry.Name
+}
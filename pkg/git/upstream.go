@@ -0,0 +1,66 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Upstream is the branch a local branch tracks, as configured by
+// `branch.<name>.remote` and `branch.<name>.merge`.
+type Upstream struct {
+	// Remote is the name of the remote, "." when tracking a local branch.
+	Remote string
+	// Merge is the full name of the branch on the remote, ie. refs/heads/main.
+	Merge plumbing.ReferenceName
+}
+
+// Reference returns the full name of the reference the upstream is tracked
+// at in the repository, ie. refs/remotes/origin/main.
+func (u *Upstream) Reference() plumbing.ReferenceName {
+	if u.Remote == "." {
+		return u.Merge
+	}
+	return plumbing.NewRemoteReferenceName(u.Remote, u.Merge.Short())
+}
+
+// CurrentBranch returns the short name of the branch HEAD points at, an error
+// when HEAD is detached.
+func CurrentBranch(repo *git.Repository) (string, error) {
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return "", err
+	}
+	if head.Type() != plumbing.SymbolicReference || !head.Target().IsBranch() {
+		return "", fmt.Errorf("HEAD is detached, it does not point at a branch")
+	}
+
+	return head.Target().Short(), nil
+}
+
+// BranchUpstream returns the upstream of branch, nil when it has none. An
+// empty branch, or HEAD, is the branch HEAD points at.
+func BranchUpstream(repo *git.Repository, branch string) (*Upstream, error) {
+	if branch == "" || branch == "HEAD" {
+		current, err := CurrentBranch(repo)
+		if err != nil {
+			return nil, err
+		}
+		branch = current
+	}
+
+	b, err := repo.Branch(strings.TrimPrefix(branch, "refs/heads/"))
+	if err == git.ErrBranchNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if b.Remote == "" || b.Merge == "" {
+		return nil, nil
+	}
+
+	return &Upstream{Remote: b.Remote, Merge: b.Merge}, nil
+}
@@ -0,0 +1,155 @@
+package git
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"golang.org/x/crypto/ssh"
+)
+
+// sshSignatureNamespace is the namespace git signs commits and tags in.
+const sshSignatureNamespace = "git"
+
+// AllowedSigner is an entry of an ssh allowed signers file, see the ALLOWED
+// SIGNERS section of ssh-keygen(1).
+type AllowedSigner struct {
+	Principals []string
+	// Namespaces restricts which signatures the key is trusted for, empty
+	// trusts it for any namespace.
+	Namespaces []string
+	PublicKey  ssh.PublicKey
+}
+
+// ParseAllowedSigners parses the content of an ssh allowed signers file.
+// Certificate authorities are not supported and are skipped.
+func ParseAllowedSigners(content string) ([]AllowedSigner, error) {
+	var signers []AllowedSigner
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		principals, rest := splitAllowedSignersField(line)
+
+		publicKey, _, options, _, err := ssh.ParseAuthorizedKey([]byte(rest))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: unable to parse public key: %v", i+1, err)
+		}
+
+		signer := AllowedSigner{
+			Principals: strings.Split(strings.Trim(principals, `"`), ","),
+			PublicKey:  publicKey,
+		}
+
+		skip := false
+		for _, option := range options {
+			name, value, _ := strings.Cut(option, "=")
+			switch strings.ToLower(name) {
+			case "cert-authority":
+				skip = true
+			case "namespaces":
+				signer.Namespaces = strings.Split(strings.Trim(value, `"`), ",")
+			}
+		}
+		if skip {
+			continue
+		}
+
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
+// splitAllowedSignersField splits the first, possibly quoted, field off line.
+func splitAllowedSignersField(line string) (string, string) {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case (c == ' ' || c == '\t') && !quoted:
+			return line[:i], strings.TrimSpace(line[i:])
+		}
+	}
+	return line, ""
+}
+
+// VerifyPGPSignature checks the armored gpg signature of message against the
+// keys of keyring, returning the key's entity.
+func VerifyPGPSignature(keyring openpgp.KeyRing, message []byte, armored string) (*openpgp.Entity, error) {
+	return openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(message), strings.NewReader(armored), nil)
+}
+
+// VerifySSHSignature checks the armored ssh signature of message against the
+// allowed signers, returning the signer whose key made the signature.
+func VerifySSHSignature(signers []AllowedSigner, message []byte, armored string) (*AllowedSigner, error) {
+	sig, err := decodeSSHSignature(armored)
+	if err != nil {
+		return nil, err
+	}
+
+	if sig.namespace != sshSignatureNamespace {
+		return nil, fmt.Errorf("unexpected ssh signature namespace %q", sig.namespace)
+	}
+
+	var digest []byte
+	switch sig.hashAlgorithm {
+	case "sha256":
+		sum := sha256.Sum256(message)
+		digest = sum[:]
+	case "sha512":
+		sum := sha512.Sum512(message)
+		digest = sum[:]
+	default:
+		return nil, fmt.Errorf("unsupported ssh signature hash algorithm %q", sig.hashAlgorithm)
+	}
+
+	signature := new(ssh.Signature)
+	if err := ssh.Unmarshal(sig.signature, signature); err != nil {
+		return nil, fmt.Errorf("unable to parse ssh signature: %v", err)
+	}
+
+	var signer *AllowedSigner
+	for i := range signers {
+		if bytes.Equal(signers[i].PublicKey.Marshal(), sig.publicKey.Marshal()) && allowsNamespace(signers[i].Namespaces, sig.namespace) {
+			signer = &signers[i]
+			break
+		}
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("no allowed signer for key %s", ssh.FingerprintSHA256(sig.publicKey))
+	}
+
+	// The signed data wraps the digest of the message, see PROTOCOL.sshsig
+	signed := []byte("SSHSIG")
+	for _, field := range [][]byte{[]byte(sig.namespace), {}, []byte(sig.hashAlgorithm), digest} {
+		signed = binary.BigEndian.AppendUint32(signed, uint32(len(field)))
+		signed = append(signed, field...)
+	}
+
+	if err := sig.publicKey.Verify(signed, signature); err != nil {
+		return nil, fmt.Errorf("invalid ssh signature: %v", err)
+	}
+
+	return signer, nil
+}
+
+func allowsNamespace(namespaces []string, namespace string) bool {
+	if len(namespaces) == 0 {
+		return true
+	}
+	for _, n := range namespaces {
+		if n == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// LinkedWorktree is a working tree added to a repository like `git worktree
+// add` does, administered from `worktrees/<ID>` of the common git directory.
+type LinkedWorktree struct {
+	ID string
+	// Path is the root of the working tree.
+	Path string
+	// Head is the content of its HEAD, a hash or `ref: <name>`.
+	Head string
+}
+
+// CommonDir returns the git directory shared by all the working trees of
+// repo, which differs from GitDir for linked working trees.
+func CommonDir(repo *git.Repository) (string, error) {
+	gitDir, err := GitDir(repo)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(filepath.Join(gitDir, "commondir"))
+	if errors.Is(err, os.ErrNotExist) {
+		return gitDir, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	commonDir := filepath.FromSlash(strings.TrimSpace(string(content)))
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(gitDir, commonDir)
+	}
+
+	return filepath.Clean(commonDir), nil
+}
+
+// LinkedWorktrees returns the working trees linked to the common git directory
+// commonDir, including the ones whose working tree no longer exists.
+func LinkedWorktrees(commonDir string) ([]LinkedWorktree, error) {
+	entries, err := os.ReadDir(filepath.Join(commonDir, "worktrees"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var worktrees []LinkedWorktree
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		adminDir := filepath.Join(commonDir, "worktrees", entry.Name())
+
+		gitFile, err := os.ReadFile(filepath.Join(adminDir, "gitdir"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		head, err := os.ReadFile(filepath.Join(adminDir, "HEAD"))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		worktrees = append(worktrees, LinkedWorktree{
+			ID:   entry.Name(),
+			Path: filepath.Dir(filepath.FromSlash(strings.TrimSpace(string(gitFile)))),
+			Head: strings.TrimSpace(string(head)),
+		})
+	}
+
+	return worktrees, nil
+}
+
+// AddWorktree links the working tree at path, which must be empty or not exist
+// yet, to the common git directory commonDir with head as its HEAD, and
+// returns its ID. The working tree is left empty, to be checked out.
+func AddWorktree(commonDir string, path string, head string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	if entries, err := os.ReadDir(path); err == nil && len(entries) > 0 {
+		return "", fmt.Errorf("%s already exists and is not empty", path)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	// Like git, IDs are the name of the working tree, numbered when taken.
+	id := filepath.Base(path)
+	adminDir := filepath.Join(commonDir, "worktrees", id)
+	for i := 1; ; i++ {
+		if _, err := os.Stat(adminDir); errors.Is(err, os.ErrNotExist) {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		id = filepath.Base(path) + strconv.Itoa(i)
+		adminDir = filepath.Join(commonDir, "worktrees", id)
+	}
+
+	if err := os.MkdirAll(adminDir, 0755); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return "", err
+	}
+
+	files := map[string]string{
+		filepath.Join(adminDir, "commondir"): "../..",
+		filepath.Join(adminDir, "gitdir"):    filepath.ToSlash(filepath.Join(path, ".git")),
+		filepath.Join(adminDir, "HEAD"):      head,
+		filepath.Join(path, ".git"):          "gitdir: " + filepath.ToSlash(adminDir),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content+"\n"), 0644); err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// PruneWorktree removes the administrative files of the working tree id from
+// the common git directory commonDir, like `git worktree prune` does once the
+// working tree is gone.
+func PruneWorktree(commonDir string, id string) error {
+	if err := os.RemoveAll(filepath.Join(commonDir, "worktrees", id)); err != nil {
+		return err
+	}
+
+	// git removes the directory along with the last working tree, removing it
+	// fails as long as others are left.
+	_ = os.Remove(filepath.Join(commonDir, "worktrees"))
+
+	return nil
+}